@@ -0,0 +1,551 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: audit/v1/audit.proto
+
+package auditv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetAuditMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditMetricsRequest) Reset() {
+	*x = GetAuditMetricsRequest{}
+	mi := &file_audit_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditMetricsRequest) ProtoMessage() {}
+
+func (x *GetAuditMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+type GetAuditMetricsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TotalEvents      int64                  `protobuf:"varint,1,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	CorrelatedEvents int64                  `protobuf:"varint,2,opt,name=correlated_events,json=correlatedEvents,proto3" json:"correlated_events,omitempty"`
+	LastUpdated      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetAuditMetricsResponse) Reset() {
+	*x = GetAuditMetricsResponse{}
+	mi := &file_audit_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditMetricsResponse) ProtoMessage() {}
+
+func (x *GetAuditMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAuditMetricsResponse) GetTotalEvents() int64 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
+func (x *GetAuditMetricsResponse) GetCorrelatedEvents() int64 {
+	if x != nil {
+		return x.CorrelatedEvents
+	}
+	return 0
+}
+
+func (x *GetAuditMetricsResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+// AuditBalance is a position before and after the audited operation
+type AuditBalance struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId         string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	BalanceBefore   float64                `protobuf:"fixed64,3,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter    float64                `protobuf:"fixed64,4,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	AvailableBefore float64                `protobuf:"fixed64,5,opt,name=available_before,json=availableBefore,proto3" json:"available_before,omitempty"`
+	AvailableAfter  float64                `protobuf:"fixed64,6,opt,name=available_after,json=availableAfter,proto3" json:"available_after,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AuditBalance) Reset() {
+	*x = AuditBalance{}
+	mi := &file_audit_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditBalance) ProtoMessage() {}
+
+func (x *AuditBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditBalance.ProtoReflect.Descriptor instead.
+func (*AuditBalance) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuditBalance) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AuditBalance) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *AuditBalance) GetBalanceBefore() float64 {
+	if x != nil {
+		return x.BalanceBefore
+	}
+	return 0
+}
+
+func (x *AuditBalance) GetBalanceAfter() float64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *AuditBalance) GetAvailableBefore() float64 {
+	if x != nil {
+		return x.AvailableBefore
+	}
+	return 0
+}
+
+func (x *AuditBalance) GetAvailableAfter() float64 {
+	if x != nil {
+		return x.AvailableAfter
+	}
+	return 0
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Instance      string                 `protobuf:"bytes,4,opt,name=instance,proto3" json:"instance,omitempty"`
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	ActorVerified bool                   `protobuf:"varint,6,opt,name=actor_verified,json=actorVerified,proto3" json:"actor_verified,omitempty"`
+	CorrelationId string                 `protobuf:"bytes,7,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	TraceId       string                 `protobuf:"bytes,8,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	Action        string                 `protobuf:"bytes,9,opt,name=action,proto3" json:"action,omitempty"`
+	Outcome       string                 `protobuf:"bytes,10,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,11,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,12,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Balances      []*AuditBalance        `protobuf:"bytes,13,rep,name=balances,proto3" json:"balances,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,14,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Error         string                 `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_audit_v1_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuditEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorVerified() bool {
+	if x != nil {
+		return x.ActorVerified
+	}
+	return false
+}
+
+func (x *AuditEvent) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBalances() []*AuditBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RecordAuditEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *AuditEvent            `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAuditEventRequest) Reset() {
+	*x = RecordAuditEventRequest{}
+	mi := &file_audit_v1_audit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAuditEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAuditEventRequest) ProtoMessage() {}
+
+func (x *RecordAuditEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAuditEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordAuditEventRequest) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecordAuditEventRequest) GetEvent() *AuditEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type RecordAuditEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAuditEventResponse) Reset() {
+	*x = RecordAuditEventResponse{}
+	mi := &file_audit_v1_audit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAuditEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAuditEventResponse) ProtoMessage() {}
+
+func (x *RecordAuditEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAuditEventResponse.ProtoReflect.Descriptor instead.
+func (*RecordAuditEventResponse) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{5}
+}
+
+var File_audit_v1_audit_proto protoreflect.FileDescriptor
+
+const file_audit_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x14audit/v1/audit.proto\x12\baudit.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x18\n" +
+	"\x16GetAuditMetricsRequest\"\xa8\x01\n" +
+	"\x17GetAuditMetricsResponse\x12!\n" +
+	"\ftotal_events\x18\x01 \x01(\x03R\vtotalEvents\x12+\n" +
+	"\x11correlated_events\x18\x02 \x01(\x03R\x10correlatedEvents\x12=\n" +
+	"\flast_updated\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\"\xe8\x01\n" +
+	"\fAuditBalance\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12%\n" +
+	"\x0ebalance_before\x18\x03 \x01(\x01R\rbalanceBefore\x12#\n" +
+	"\rbalance_after\x18\x04 \x01(\x01R\fbalanceAfter\x12)\n" +
+	"\x10available_before\x18\x05 \x01(\x01R\x0favailableBefore\x12'\n" +
+	"\x0favailable_after\x18\x06 \x01(\x01R\x0eavailableAfter\"\xd1\x04\n" +
+	"\n" +
+	"AuditEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x1a\n" +
+	"\binstance\x18\x04 \x01(\tR\binstance\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12%\n" +
+	"\x0eactor_verified\x18\x06 \x01(\bR\ractorVerified\x12%\n" +
+	"\x0ecorrelation_id\x18\a \x01(\tR\rcorrelationId\x12\x19\n" +
+	"\btrace_id\x18\b \x01(\tR\atraceId\x12\x16\n" +
+	"\x06action\x18\t \x01(\tR\x06action\x12\x18\n" +
+	"\aoutcome\x18\n" +
+	" \x01(\tR\aoutcome\x12#\n" +
+	"\rresource_type\x18\v \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\f \x01(\tR\n" +
+	"resourceId\x122\n" +
+	"\bbalances\x18\r \x03(\v2\x16.audit.v1.AuditBalanceR\bbalances\x12;\n" +
+	"\adetails\x18\x0e \x03(\v2!.audit.v1.AuditEvent.DetailsEntryR\adetails\x12\x14\n" +
+	"\x05error\x18\x0f \x01(\tR\x05error\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\x17RecordAuditEventRequest\x12*\n" +
+	"\x05event\x18\x01 \x01(\v2\x14.audit.v1.AuditEventR\x05event\"\x1a\n" +
+	"\x18RecordAuditEventResponse2\xcb\x01\n" +
+	"\x16AuditCorrelatorService\x12V\n" +
+	"\x0fGetAuditMetrics\x12 .audit.v1.GetAuditMetricsRequest\x1a!.audit.v1.GetAuditMetricsResponse\x12Y\n" +
+	"\x10RecordAuditEvent\x12!.audit.v1.RecordAuditEventRequest\x1a\".audit.v1.RecordAuditEventResponseBYZWgithub.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/audit/v1;auditv1b\x06proto3"
+
+var (
+	file_audit_v1_audit_proto_rawDescOnce sync.Once
+	file_audit_v1_audit_proto_rawDescData []byte
+)
+
+func file_audit_v1_audit_proto_rawDescGZIP() []byte {
+	file_audit_v1_audit_proto_rawDescOnce.Do(func() {
+		file_audit_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_audit_v1_audit_proto_rawDesc), len(file_audit_v1_audit_proto_rawDesc)))
+	})
+	return file_audit_v1_audit_proto_rawDescData
+}
+
+var file_audit_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_audit_v1_audit_proto_goTypes = []any{
+	(*GetAuditMetricsRequest)(nil),   // 0: audit.v1.GetAuditMetricsRequest
+	(*GetAuditMetricsResponse)(nil),  // 1: audit.v1.GetAuditMetricsResponse
+	(*AuditBalance)(nil),             // 2: audit.v1.AuditBalance
+	(*AuditEvent)(nil),               // 3: audit.v1.AuditEvent
+	(*RecordAuditEventRequest)(nil),  // 4: audit.v1.RecordAuditEventRequest
+	(*RecordAuditEventResponse)(nil), // 5: audit.v1.RecordAuditEventResponse
+	nil,                              // 6: audit.v1.AuditEvent.DetailsEntry
+	(*timestamppb.Timestamp)(nil),    // 7: google.protobuf.Timestamp
+}
+var file_audit_v1_audit_proto_depIdxs = []int32{
+	7, // 0: audit.v1.GetAuditMetricsResponse.last_updated:type_name -> google.protobuf.Timestamp
+	7, // 1: audit.v1.AuditEvent.timestamp:type_name -> google.protobuf.Timestamp
+	2, // 2: audit.v1.AuditEvent.balances:type_name -> audit.v1.AuditBalance
+	6, // 3: audit.v1.AuditEvent.details:type_name -> audit.v1.AuditEvent.DetailsEntry
+	3, // 4: audit.v1.RecordAuditEventRequest.event:type_name -> audit.v1.AuditEvent
+	0, // 5: audit.v1.AuditCorrelatorService.GetAuditMetrics:input_type -> audit.v1.GetAuditMetricsRequest
+	4, // 6: audit.v1.AuditCorrelatorService.RecordAuditEvent:input_type -> audit.v1.RecordAuditEventRequest
+	1, // 7: audit.v1.AuditCorrelatorService.GetAuditMetrics:output_type -> audit.v1.GetAuditMetricsResponse
+	5, // 8: audit.v1.AuditCorrelatorService.RecordAuditEvent:output_type -> audit.v1.RecordAuditEventResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_audit_v1_audit_proto_init() }
+func file_audit_v1_audit_proto_init() {
+	if File_audit_v1_audit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_audit_v1_audit_proto_rawDesc), len(file_audit_v1_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_audit_v1_audit_proto_goTypes,
+		DependencyIndexes: file_audit_v1_audit_proto_depIdxs,
+		MessageInfos:      file_audit_v1_audit_proto_msgTypes,
+	}.Build()
+	File_audit_v1_audit_proto = out.File
+	file_audit_v1_audit_proto_goTypes = nil
+	file_audit_v1_audit_proto_depIdxs = nil
+}
@@ -0,0 +1,151 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: audit/v1/audit.proto
+
+package auditv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AuditCorrelatorService_GetAuditMetrics_FullMethodName  = "/audit.v1.AuditCorrelatorService/GetAuditMetrics"
+	AuditCorrelatorService_RecordAuditEvent_FullMethodName = "/audit.v1.AuditCorrelatorService/RecordAuditEvent"
+)
+
+// AuditCorrelatorServiceClient is the client API for AuditCorrelatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditCorrelatorServiceClient interface {
+	// GetAuditMetrics reports how many events the correlator has ingested and linked
+	GetAuditMetrics(ctx context.Context, in *GetAuditMetricsRequest, opts ...grpc.CallOption) (*GetAuditMetricsResponse, error)
+	// RecordAuditEvent ingests one audit event; the correlator deduplicates on event_id
+	RecordAuditEvent(ctx context.Context, in *RecordAuditEventRequest, opts ...grpc.CallOption) (*RecordAuditEventResponse, error)
+}
+
+type auditCorrelatorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditCorrelatorServiceClient(cc grpc.ClientConnInterface) AuditCorrelatorServiceClient {
+	return &auditCorrelatorServiceClient{cc}
+}
+
+func (c *auditCorrelatorServiceClient) GetAuditMetrics(ctx context.Context, in *GetAuditMetricsRequest, opts ...grpc.CallOption) (*GetAuditMetricsResponse, error) {
+	out := new(GetAuditMetricsResponse)
+	err := c.cc.Invoke(ctx, AuditCorrelatorService_GetAuditMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditCorrelatorServiceClient) RecordAuditEvent(ctx context.Context, in *RecordAuditEventRequest, opts ...grpc.CallOption) (*RecordAuditEventResponse, error) {
+	out := new(RecordAuditEventResponse)
+	err := c.cc.Invoke(ctx, AuditCorrelatorService_RecordAuditEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditCorrelatorServiceServer is the server API for AuditCorrelatorService service.
+// All implementations must embed UnimplementedAuditCorrelatorServiceServer
+// for forward compatibility
+type AuditCorrelatorServiceServer interface {
+	// GetAuditMetrics reports how many events the correlator has ingested and linked
+	GetAuditMetrics(context.Context, *GetAuditMetricsRequest) (*GetAuditMetricsResponse, error)
+	// RecordAuditEvent ingests one audit event; the correlator deduplicates on event_id
+	RecordAuditEvent(context.Context, *RecordAuditEventRequest) (*RecordAuditEventResponse, error)
+	mustEmbedUnimplementedAuditCorrelatorServiceServer()
+}
+
+// UnimplementedAuditCorrelatorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAuditCorrelatorServiceServer struct {
+}
+
+func (UnimplementedAuditCorrelatorServiceServer) GetAuditMetrics(context.Context, *GetAuditMetricsRequest) (*GetAuditMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditMetrics not implemented")
+}
+func (UnimplementedAuditCorrelatorServiceServer) RecordAuditEvent(context.Context, *RecordAuditEventRequest) (*RecordAuditEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordAuditEvent not implemented")
+}
+func (UnimplementedAuditCorrelatorServiceServer) mustEmbedUnimplementedAuditCorrelatorServiceServer() {
+}
+
+// UnsafeAuditCorrelatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditCorrelatorServiceServer will
+// result in compilation errors.
+type UnsafeAuditCorrelatorServiceServer interface {
+	mustEmbedUnimplementedAuditCorrelatorServiceServer()
+}
+
+func RegisterAuditCorrelatorServiceServer(s grpc.ServiceRegistrar, srv AuditCorrelatorServiceServer) {
+	s.RegisterService(&AuditCorrelatorService_ServiceDesc, srv)
+}
+
+func _AuditCorrelatorService_GetAuditMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditCorrelatorServiceServer).GetAuditMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditCorrelatorService_GetAuditMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditCorrelatorServiceServer).GetAuditMetrics(ctx, req.(*GetAuditMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditCorrelatorService_RecordAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAuditEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditCorrelatorServiceServer).RecordAuditEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditCorrelatorService_RecordAuditEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditCorrelatorServiceServer).RecordAuditEvent(ctx, req.(*RecordAuditEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditCorrelatorService_ServiceDesc is the grpc.ServiceDesc for AuditCorrelatorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditCorrelatorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "audit.v1.AuditCorrelatorService",
+	HandlerType: (*AuditCorrelatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAuditMetrics",
+			Handler:    _AuditCorrelatorService_GetAuditMetrics_Handler,
+		},
+		{
+			MethodName: "RecordAuditEvent",
+			Handler:    _AuditCorrelatorService_RecordAuditEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "audit/v1/audit.proto",
+}
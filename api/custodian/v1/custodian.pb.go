@@ -0,0 +1,7065 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: custodian/v1/custodian.proto
+
+package custodianv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AccountEventType int32
+
+const (
+	AccountEventType_ACCOUNT_EVENT_TYPE_UNSPECIFIED               AccountEventType = 0
+	AccountEventType_ACCOUNT_EVENT_TYPE_BALANCE_CHANGED           AccountEventType = 1
+	AccountEventType_ACCOUNT_EVENT_TYPE_SETTLEMENT_STATUS_CHANGED AccountEventType = 2
+	AccountEventType_ACCOUNT_EVENT_TYPE_HOLD_PLACED               AccountEventType = 3
+	AccountEventType_ACCOUNT_EVENT_TYPE_HOLD_RELEASED             AccountEventType = 4
+	AccountEventType_ACCOUNT_EVENT_TYPE_ACCOUNT_STATUS_CHANGED    AccountEventType = 5
+)
+
+// Enum value maps for AccountEventType.
+var (
+	AccountEventType_name = map[int32]string{
+		0: "ACCOUNT_EVENT_TYPE_UNSPECIFIED",
+		1: "ACCOUNT_EVENT_TYPE_BALANCE_CHANGED",
+		2: "ACCOUNT_EVENT_TYPE_SETTLEMENT_STATUS_CHANGED",
+		3: "ACCOUNT_EVENT_TYPE_HOLD_PLACED",
+		4: "ACCOUNT_EVENT_TYPE_HOLD_RELEASED",
+		5: "ACCOUNT_EVENT_TYPE_ACCOUNT_STATUS_CHANGED",
+	}
+	AccountEventType_value = map[string]int32{
+		"ACCOUNT_EVENT_TYPE_UNSPECIFIED":               0,
+		"ACCOUNT_EVENT_TYPE_BALANCE_CHANGED":           1,
+		"ACCOUNT_EVENT_TYPE_SETTLEMENT_STATUS_CHANGED": 2,
+		"ACCOUNT_EVENT_TYPE_HOLD_PLACED":               3,
+		"ACCOUNT_EVENT_TYPE_HOLD_RELEASED":             4,
+		"ACCOUNT_EVENT_TYPE_ACCOUNT_STATUS_CHANGED":    5,
+	}
+)
+
+func (x AccountEventType) Enum() *AccountEventType {
+	p := new(AccountEventType)
+	*p = x
+	return p
+}
+
+func (x AccountEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_custodian_v1_custodian_proto_enumTypes[0].Descriptor()
+}
+
+func (AccountEventType) Type() protoreflect.EnumType {
+	return &file_custodian_v1_custodian_proto_enumTypes[0]
+}
+
+func (x AccountEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountEventType.Descriptor instead.
+func (AccountEventType) EnumDescriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{0}
+}
+
+type Account struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Account) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Account) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Account) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountType   string                 `protobuf:"bytes,1,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAccountRequest) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type DepositRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId       string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositRequest) ProtoMessage() {}
+
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DepositRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type DepositResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       float64                `protobuf:"fixed64,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositResponse) ProtoMessage() {}
+
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DepositResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type Withdrawal struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId   string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId     string                 `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount      float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Address     string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	RequestedBy string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	// "pending_review", "pending_approval", "awaiting_hot_wallet", "broadcast",
+	// "processing", "completed", "returned", "failed", "rejected" or "expired"
+	Status      string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Reason      string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	ApprovalId  string                 `protobuf:"bytes,9,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Network     string                 `protobuf:"bytes,12,opt,name=network,proto3" json:"network,omitempty"`
+	ReviewId    string                 `protobuf:"bytes,13,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// Set on simulated networks
+	TxHash        string  `protobuf:"bytes,14,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Fee           float64 `protobuf:"fixed64,15,opt,name=fee,proto3" json:"fee,omitempty"`
+	Confirmations int32   `protobuf:"varint,16,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// Set on simulated fiat rails
+	PaymentId     string `protobuf:"bytes,17,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Reference     string `protobuf:"bytes,18,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Withdrawal) Reset() {
+	*x = Withdrawal{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Withdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Withdrawal) ProtoMessage() {}
+
+func (x *Withdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Withdrawal.ProtoReflect.Descriptor instead.
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Withdrawal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Withdrawal) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Withdrawal) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetConfirmations() int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+// A deposit or withdrawal on a simulated fiat rail
+type FiatPayment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reference string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	// "fedwire" or "ach" by default
+	Rail string `protobuf:"bytes,3,opt,name=rail,proto3" json:"rail,omitempty"`
+	// "deposit" or "withdrawal"
+	Direction string `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	// Withdrawal ID for withdrawals
+	ResourceId  string  `protobuf:"bytes,5,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	AccountId   string  `protobuf:"bytes,6,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId     string  `protobuf:"bytes,7,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount      float64 `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	BankAccount string  `protobuf:"bytes,9,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
+	// "queued", "submitted", "completed" or "returned"
+	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// ACH-style return code, e.g. "R03", when returned
+	ReturnCode    string                 `protobuf:"bytes,11,opt,name=return_code,json=returnCode,proto3" json:"return_code,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	BatchAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=batch_at,json=batchAt,proto3" json:"batch_at,omitempty"`
+	SettlesAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=settles_at,json=settlesAt,proto3" json:"settles_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FiatPayment) Reset() {
+	*x = FiatPayment{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FiatPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FiatPayment) ProtoMessage() {}
+
+func (x *FiatPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FiatPayment.ProtoReflect.Descriptor instead.
+func (*FiatPayment) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FiatPayment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FiatPayment) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *FiatPayment) GetRail() string {
+	if x != nil {
+		return x.Rail
+	}
+	return ""
+}
+
+func (x *FiatPayment) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *FiatPayment) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *FiatPayment) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *FiatPayment) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *FiatPayment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FiatPayment) GetBankAccount() string {
+	if x != nil {
+		return x.BankAccount
+	}
+	return ""
+}
+
+func (x *FiatPayment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FiatPayment) GetReturnCode() string {
+	if x != nil {
+		return x.ReturnCode
+	}
+	return ""
+}
+
+func (x *FiatPayment) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *FiatPayment) GetBatchAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BatchAt
+	}
+	return nil
+}
+
+func (x *FiatPayment) GetSettlesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettlesAt
+	}
+	return nil
+}
+
+func (x *FiatPayment) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// A deposit or withdrawal on a simulated blockchain
+type ChainTransaction struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Hash    string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Network string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	// "deposit" or "withdrawal"
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	// Withdrawal ID for withdrawals
+	ResourceId string  `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	AccountId  string  `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId    string  `protobuf:"bytes,6,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount     float64 `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee        float64 `protobuf:"fixed64,8,opt,name=fee,proto3" json:"fee,omitempty"`
+	Address    string  `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
+	// "mempool", "confirming" or "confirmed"
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	BlockHeight   int64                  `protobuf:"varint,11,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash     string                 `protobuf:"bytes,12,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Confirmations int32                  `protobuf:"varint,13,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Reorgs        int32                  `protobuf:"varint,14,opt,name=reorgs,proto3" json:"reorgs,omitempty"`
+	BroadcastAt   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=broadcast_at,json=broadcastAt,proto3" json:"broadcast_at,omitempty"`
+	ConfirmedAt   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainTransaction) Reset() {
+	*x = ChainTransaction{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainTransaction) ProtoMessage() {}
+
+func (x *ChainTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainTransaction.ProtoReflect.Descriptor instead.
+func (*ChainTransaction) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChainTransaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ChainTransaction) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ChainTransaction) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ChainTransaction) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ChainTransaction) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ChainTransaction) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *ChainTransaction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ChainTransaction) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *ChainTransaction) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ChainTransaction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChainTransaction) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *ChainTransaction) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *ChainTransaction) GetConfirmations() int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *ChainTransaction) GetReorgs() int32 {
+	if x != nil {
+		return x.Reorgs
+	}
+	return 0
+}
+
+func (x *ChainTransaction) GetBroadcastAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BroadcastAt
+	}
+	return nil
+}
+
+func (x *ChainTransaction) GetConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+// Originator and beneficiary details required by the travel rule above a threshold
+type TravelRuleData struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OriginatorName     string                 `protobuf:"bytes,1,opt,name=originator_name,json=originatorName,proto3" json:"originator_name,omitempty"`
+	OriginatorAccount  string                 `protobuf:"bytes,2,opt,name=originator_account,json=originatorAccount,proto3" json:"originator_account,omitempty"`
+	BeneficiaryName    string                 `protobuf:"bytes,3,opt,name=beneficiary_name,json=beneficiaryName,proto3" json:"beneficiary_name,omitempty"`
+	BeneficiaryAccount string                 `protobuf:"bytes,4,opt,name=beneficiary_account,json=beneficiaryAccount,proto3" json:"beneficiary_account,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TravelRuleData) Reset() {
+	*x = TravelRuleData{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TravelRuleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelRuleData) ProtoMessage() {}
+
+func (x *TravelRuleData) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelRuleData.ProtoReflect.Descriptor instead.
+func (*TravelRuleData) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TravelRuleData) GetOriginatorName() string {
+	if x != nil {
+		return x.OriginatorName
+	}
+	return ""
+}
+
+func (x *TravelRuleData) GetOriginatorAccount() string {
+	if x != nil {
+		return x.OriginatorAccount
+	}
+	return ""
+}
+
+func (x *TravelRuleData) GetBeneficiaryName() string {
+	if x != nil {
+		return x.BeneficiaryName
+	}
+	return ""
+}
+
+func (x *TravelRuleData) GetBeneficiaryAccount() string {
+	if x != nil {
+		return x.BeneficiaryAccount
+	}
+	return ""
+}
+
+type WithdrawRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId   string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount    float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Must be an active address in the account's address book
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// Who asked for the withdrawal; a verified caller identity takes precedence
+	RequestedBy string `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	// Defaults to the asset ID
+	Network       string          `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	TravelRule    *TravelRuleData `protobuf:"bytes,7,opt,name=travel_rule,json=travelRule,proto3" json:"travel_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest) ProtoMessage() {}
+
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WithdrawRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WithdrawRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetTravelRule() *TravelRuleData {
+	if x != nil {
+		return x.TravelRule
+	}
+	return nil
+}
+
+type WithdrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Withdrawal    *Withdrawal            `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawResponse) Reset() {
+	*x = WithdrawResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawResponse) ProtoMessage() {}
+
+func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WithdrawResponse) GetWithdrawal() *Withdrawal {
+	if x != nil {
+		return x.Withdrawal
+	}
+	return nil
+}
+
+type WithdrawalAddress struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId   string                 `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Network   string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	Address   string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Label     string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	AddedBy   string                 `protobuf:"bytes,7,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	// "cooling_off", "active" or "removed"
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	ActiveFrom    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
+	RemovedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawalAddress) Reset() {
+	*x = WithdrawalAddress{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawalAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawalAddress) ProtoMessage() {}
+
+func (x *WithdrawalAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawalAddress.ProtoReflect.Descriptor instead.
+func (*WithdrawalAddress) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WithdrawalAddress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WithdrawalAddress) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *WithdrawalAddress) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *WithdrawalAddress) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *WithdrawalAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WithdrawalAddress) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *WithdrawalAddress) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+func (x *WithdrawalAddress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WithdrawalAddress) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *WithdrawalAddress) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *WithdrawalAddress) GetRemovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemovedAt
+	}
+	return nil
+}
+
+type AddWithdrawalAddressRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId   string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// Defaults to the asset ID
+	Network string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Label   string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	// A verified caller identity takes precedence
+	AddedBy       string `protobuf:"bytes,6,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWithdrawalAddressRequest) Reset() {
+	*x = AddWithdrawalAddressRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWithdrawalAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWithdrawalAddressRequest) ProtoMessage() {}
+
+func (x *AddWithdrawalAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWithdrawalAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddWithdrawalAddressRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddWithdrawalAddressRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AddWithdrawalAddressRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *AddWithdrawalAddressRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *AddWithdrawalAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddWithdrawalAddressRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AddWithdrawalAddressRequest) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+type ListWithdrawalAddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWithdrawalAddressesRequest) Reset() {
+	*x = ListWithdrawalAddressesRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWithdrawalAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWithdrawalAddressesRequest) ProtoMessage() {}
+
+func (x *ListWithdrawalAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWithdrawalAddressesRequest.ProtoReflect.Descriptor instead.
+func (*ListWithdrawalAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListWithdrawalAddressesRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type ListWithdrawalAddressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []*WithdrawalAddress   `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWithdrawalAddressesResponse) Reset() {
+	*x = ListWithdrawalAddressesResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWithdrawalAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWithdrawalAddressesResponse) ProtoMessage() {}
+
+func (x *ListWithdrawalAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWithdrawalAddressesResponse.ProtoReflect.Descriptor instead.
+func (*ListWithdrawalAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListWithdrawalAddressesResponse) GetAddresses() []*WithdrawalAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type RemoveWithdrawalAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWithdrawalAddressRequest) Reset() {
+	*x = RemoveWithdrawalAddressRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWithdrawalAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWithdrawalAddressRequest) ProtoMessage() {}
+
+func (x *RemoveWithdrawalAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWithdrawalAddressRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWithdrawalAddressRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveWithdrawalAddressRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *RemoveWithdrawalAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+type WithdrawalAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *WithdrawalAddress     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawalAddressResponse) Reset() {
+	*x = WithdrawalAddressResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawalAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawalAddressResponse) ProtoMessage() {}
+
+func (x *WithdrawalAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawalAddressResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawalAddressResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WithdrawalAddressResponse) GetAddress() *WithdrawalAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type GetWithdrawalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WithdrawalId  string                 `protobuf:"bytes,1,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWithdrawalRequest) Reset() {
+	*x = GetWithdrawalRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWithdrawalRequest) ProtoMessage() {}
+
+func (x *GetWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*GetWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetWithdrawalRequest) GetWithdrawalId() string {
+	if x != nil {
+		return x.WithdrawalId
+	}
+	return ""
+}
+
+type GetWithdrawalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Withdrawal    *Withdrawal            `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWithdrawalResponse) Reset() {
+	*x = GetWithdrawalResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWithdrawalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWithdrawalResponse) ProtoMessage() {}
+
+func (x *GetWithdrawalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWithdrawalResponse.ProtoReflect.Descriptor instead.
+func (*GetWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetWithdrawalResponse) GetWithdrawal() *Withdrawal {
+	if x != nil {
+		return x.Withdrawal
+	}
+	return nil
+}
+
+type SubmitChainDepositRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId   string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount    float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Defaults to the asset ID; a network carries only the asset it is named after
+	Network       string `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	FromAddress   string `protobuf:"bytes,5,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitChainDepositRequest) Reset() {
+	*x = SubmitChainDepositRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitChainDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitChainDepositRequest) ProtoMessage() {}
+
+func (x *SubmitChainDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitChainDepositRequest.ProtoReflect.Descriptor instead.
+func (*SubmitChainDepositRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SubmitChainDepositRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SubmitChainDepositRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *SubmitChainDepositRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SubmitChainDepositRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SubmitChainDepositRequest) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+type ChainTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *ChainTransaction      `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainTransactionResponse) Reset() {
+	*x = ChainTransactionResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainTransactionResponse) ProtoMessage() {}
+
+func (x *ChainTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ChainTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChainTransactionResponse) GetTransaction() *ChainTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type GetChainStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChainStatusRequest) Reset() {
+	*x = GetChainStatusRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainStatusRequest) ProtoMessage() {}
+
+func (x *GetChainStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetChainStatusRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetChainStatusRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type GetChainStatusResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Network              string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Height               int64                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	TipHash              string                 `protobuf:"bytes,3,opt,name=tip_hash,json=tipHash,proto3" json:"tip_hash,omitempty"`
+	TipMinedAt           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=tip_mined_at,json=tipMinedAt,proto3" json:"tip_mined_at,omitempty"`
+	NextBlockAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_block_at,json=nextBlockAt,proto3" json:"next_block_at,omitempty"`
+	MempoolSize          int32                  `protobuf:"varint,6,opt,name=mempool_size,json=mempoolSize,proto3" json:"mempool_size,omitempty"`
+	BlockIntervalSeconds int64                  `protobuf:"varint,7,opt,name=block_interval_seconds,json=blockIntervalSeconds,proto3" json:"block_interval_seconds,omitempty"`
+	Confirmations        int32                  `protobuf:"varint,8,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Fee                  float64                `protobuf:"fixed64,9,opt,name=fee,proto3" json:"fee,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetChainStatusResponse) Reset() {
+	*x = GetChainStatusResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainStatusResponse) ProtoMessage() {}
+
+func (x *GetChainStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetChainStatusResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetChainStatusResponse) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *GetChainStatusResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetChainStatusResponse) GetTipHash() string {
+	if x != nil {
+		return x.TipHash
+	}
+	return ""
+}
+
+func (x *GetChainStatusResponse) GetTipMinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TipMinedAt
+	}
+	return nil
+}
+
+func (x *GetChainStatusResponse) GetNextBlockAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextBlockAt
+	}
+	return nil
+}
+
+func (x *GetChainStatusResponse) GetMempoolSize() int32 {
+	if x != nil {
+		return x.MempoolSize
+	}
+	return 0
+}
+
+func (x *GetChainStatusResponse) GetBlockIntervalSeconds() int64 {
+	if x != nil {
+		return x.BlockIntervalSeconds
+	}
+	return 0
+}
+
+func (x *GetChainStatusResponse) GetConfirmations() int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *GetChainStatusResponse) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+type GetChainTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Hash          string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChainTransactionRequest) Reset() {
+	*x = GetChainTransactionRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainTransactionRequest) ProtoMessage() {}
+
+func (x *GetChainTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetChainTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetChainTransactionRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *GetChainTransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type SubmitFiatDepositRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId   string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount    float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Defaults to the default rail
+	Rail string `protobuf:"bytes,4,opt,name=rail,proto3" json:"rail,omitempty"`
+	// Originating bank account
+	BankAccount   string `protobuf:"bytes,5,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitFiatDepositRequest) Reset() {
+	*x = SubmitFiatDepositRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitFiatDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitFiatDepositRequest) ProtoMessage() {}
+
+func (x *SubmitFiatDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitFiatDepositRequest.ProtoReflect.Descriptor instead.
+func (*SubmitFiatDepositRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SubmitFiatDepositRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SubmitFiatDepositRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *SubmitFiatDepositRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SubmitFiatDepositRequest) GetRail() string {
+	if x != nil {
+		return x.Rail
+	}
+	return ""
+}
+
+func (x *SubmitFiatDepositRequest) GetBankAccount() string {
+	if x != nil {
+		return x.BankAccount
+	}
+	return ""
+}
+
+type FiatPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *FiatPayment           `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FiatPaymentResponse) Reset() {
+	*x = FiatPaymentResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FiatPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FiatPaymentResponse) ProtoMessage() {}
+
+func (x *FiatPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FiatPaymentResponse.ProtoReflect.Descriptor instead.
+func (*FiatPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FiatPaymentResponse) GetPayment() *FiatPayment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type GetFiatPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFiatPaymentRequest) Reset() {
+	*x = GetFiatPaymentRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFiatPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFiatPaymentRequest) ProtoMessage() {}
+
+func (x *GetFiatPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFiatPaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetFiatPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetFiatPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+type GetWalletTiersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletTiersRequest) Reset() {
+	*x = GetWalletTiersRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletTiersRequest) ProtoMessage() {}
+
+func (x *GetWalletTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletTiersRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletTiersRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetWalletTiersRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+// A move of an asset between wallet tiers
+type RebalanceOperation struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssetId string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// "hot", "warm" or "cold"
+	FromTier string  `protobuf:"bytes,3,opt,name=from_tier,json=fromTier,proto3" json:"from_tier,omitempty"`
+	ToTier   string  `protobuf:"bytes,4,opt,name=to_tier,json=toTier,proto3" json:"to_tier,omitempty"`
+	Amount   float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// "drift" or "hot_wallet_top_up"
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// "in_transit" or "completed"
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletesAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completes_at,json=completesAt,proto3" json:"completes_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalanceOperation) Reset() {
+	*x = RebalanceOperation{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebalanceOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceOperation) ProtoMessage() {}
+
+func (x *RebalanceOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceOperation.ProtoReflect.Descriptor instead.
+func (*RebalanceOperation) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RebalanceOperation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RebalanceOperation) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *RebalanceOperation) GetFromTier() string {
+	if x != nil {
+		return x.FromTier
+	}
+	return ""
+}
+
+func (x *RebalanceOperation) GetToTier() string {
+	if x != nil {
+		return x.ToTier
+	}
+	return ""
+}
+
+func (x *RebalanceOperation) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RebalanceOperation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RebalanceOperation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RebalanceOperation) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RebalanceOperation) GetCompletesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletesAt
+	}
+	return nil
+}
+
+type GetWalletTiersResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AssetId string                 `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Hot     float64                `protobuf:"fixed64,2,opt,name=hot,proto3" json:"hot,omitempty"`
+	Warm    float64                `protobuf:"fixed64,3,opt,name=warm,proto3" json:"warm,omitempty"`
+	Cold    float64                `protobuf:"fixed64,4,opt,name=cold,proto3" json:"cold,omitempty"`
+	// Target fractions of holdings per tier
+	TargetHot           float64               `protobuf:"fixed64,5,opt,name=target_hot,json=targetHot,proto3" json:"target_hot,omitempty"`
+	TargetWarm          float64               `protobuf:"fixed64,6,opt,name=target_warm,json=targetWarm,proto3" json:"target_warm,omitempty"`
+	TargetCold          float64               `protobuf:"fixed64,7,opt,name=target_cold,json=targetCold,proto3" json:"target_cold,omitempty"`
+	InTransit           float64               `protobuf:"fixed64,8,opt,name=in_transit,json=inTransit,proto3" json:"in_transit,omitempty"`
+	AwaitingWithdrawals int32                 `protobuf:"varint,9,opt,name=awaiting_withdrawals,json=awaitingWithdrawals,proto3" json:"awaiting_withdrawals,omitempty"`
+	Operations          []*RebalanceOperation `protobuf:"bytes,10,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetWalletTiersResponse) Reset() {
+	*x = GetWalletTiersResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletTiersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletTiersResponse) ProtoMessage() {}
+
+func (x *GetWalletTiersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletTiersResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletTiersResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetWalletTiersResponse) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *GetWalletTiersResponse) GetHot() float64 {
+	if x != nil {
+		return x.Hot
+	}
+	return 0
+}
+
+func (x *GetWalletTiersResponse) GetWarm() float64 {
+	if x != nil {
+		return x.Warm
+	}
+	return 0
+}
+
+func (x *GetWalletTiersResponse) GetCold() float64 {
+	if x != nil {
+		return x.Cold
+	}
+	return 0
+}
+
+func (x *GetWalletTiersResponse) GetTargetHot() float64 {
+	if x != nil {
+		return x.TargetHot
+	}
+	return 0
+}
+
+func (x *GetWalletTiersResponse) GetTargetWarm() float64 {
+	if x != nil {
+		return x.TargetWarm
+	}
+	return 0
+}
+
+func (x *GetWalletTiersResponse) GetTargetCold() float64 {
+	if x != nil {
+		return x.TargetCold
+	}
+	return 0
+}
+
+func (x *GetWalletTiersResponse) GetInTransit() float64 {
+	if x != nil {
+		return x.InTransit
+	}
+	return 0
+}
+
+func (x *GetWalletTiersResponse) GetAwaitingWithdrawals() int32 {
+	if x != nil {
+		return x.AwaitingWithdrawals
+	}
+	return 0
+}
+
+func (x *GetWalletTiersResponse) GetOperations() []*RebalanceOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId       string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetBalanceRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetBalanceRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+type GetBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId       string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Balance       float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Available     float64                `protobuf:"fixed64,4,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetBalanceResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetBalanceResponse) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *GetBalanceResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetAvailable() float64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+type GetTransferHeadroomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId       string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferHeadroomRequest) Reset() {
+	*x = GetTransferHeadroomRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferHeadroomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferHeadroomRequest) ProtoMessage() {}
+
+func (x *GetTransferHeadroomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferHeadroomRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferHeadroomRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTransferHeadroomRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetTransferHeadroomRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+type LimitUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         float64                `protobuf:"fixed64,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used          float64                `protobuf:"fixed64,2,opt,name=used,proto3" json:"used,omitempty"`
+	Remaining     float64                `protobuf:"fixed64,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LimitUsage) Reset() {
+	*x = LimitUsage{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitUsage) ProtoMessage() {}
+
+func (x *LimitUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitUsage.ProtoReflect.Descriptor instead.
+func (*LimitUsage) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LimitUsage) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LimitUsage) GetUsed() float64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *LimitUsage) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// Unset limits do not apply
+type GetTransferHeadroomResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AccountId            string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId              string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	PerTransaction       *float64               `protobuf:"fixed64,3,opt,name=per_transaction,json=perTransaction,proto3,oneof" json:"per_transaction,omitempty"`
+	Daily                *LimitUsage            `protobuf:"bytes,4,opt,name=daily,proto3" json:"daily,omitempty"`
+	Rolling              *LimitUsage            `protobuf:"bytes,5,opt,name=rolling,proto3" json:"rolling,omitempty"`
+	RollingWindowSeconds int64                  `protobuf:"varint,6,opt,name=rolling_window_seconds,json=rollingWindowSeconds,proto3" json:"rolling_window_seconds,omitempty"`
+	// Largest transfer allowed now
+	Remaining     *float64 `protobuf:"fixed64,7,opt,name=remaining,proto3,oneof" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferHeadroomResponse) Reset() {
+	*x = GetTransferHeadroomResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferHeadroomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferHeadroomResponse) ProtoMessage() {}
+
+func (x *GetTransferHeadroomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferHeadroomResponse.ProtoReflect.Descriptor instead.
+func (*GetTransferHeadroomResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTransferHeadroomResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetTransferHeadroomResponse) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *GetTransferHeadroomResponse) GetPerTransaction() float64 {
+	if x != nil && x.PerTransaction != nil {
+		return *x.PerTransaction
+	}
+	return 0
+}
+
+func (x *GetTransferHeadroomResponse) GetDaily() *LimitUsage {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *GetTransferHeadroomResponse) GetRolling() *LimitUsage {
+	if x != nil {
+		return x.Rolling
+	}
+	return nil
+}
+
+func (x *GetTransferHeadroomResponse) GetRollingWindowSeconds() int64 {
+	if x != nil {
+		return x.RollingWindowSeconds
+	}
+	return 0
+}
+
+func (x *GetTransferHeadroomResponse) GetRemaining() float64 {
+	if x != nil && x.Remaining != nil {
+		return *x.Remaining
+	}
+	return 0
+}
+
+type SubmitSettlementRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FromAccount string                 `protobuf:"bytes,1,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	ToAccount   string                 `protobuf:"bytes,2,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
+	AssetId     string                 `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount      float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Settle between counterparties using their SSIs; set both instead of the accounts
+	FromCounterparty string `protobuf:"bytes,5,opt,name=from_counterparty,json=fromCounterparty,proto3" json:"from_counterparty,omitempty"`
+	ToCounterparty   string `protobuf:"bytes,6,opt,name=to_counterparty,json=toCounterparty,proto3" json:"to_counterparty,omitempty"`
+	// Settle whatever is available when the full amount is not, retrying the rest
+	// (account settlements only)
+	AllowPartial bool `protobuf:"varint,7,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	// Who asked for the settlement; a verified caller identity takes precedence
+	RequestedBy string `protobuf:"bytes,8,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	// Required for compliance screening above the travel rule threshold
+	// (account settlements only)
+	TravelRule    *TravelRuleData `protobuf:"bytes,9,opt,name=travel_rule,json=travelRule,proto3" json:"travel_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitSettlementRequest) Reset() {
+	*x = SubmitSettlementRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSettlementRequest) ProtoMessage() {}
+
+func (x *SubmitSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSettlementRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SubmitSettlementRequest) GetFromAccount() string {
+	if x != nil {
+		return x.FromAccount
+	}
+	return ""
+}
+
+func (x *SubmitSettlementRequest) GetToAccount() string {
+	if x != nil {
+		return x.ToAccount
+	}
+	return ""
+}
+
+func (x *SubmitSettlementRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *SubmitSettlementRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SubmitSettlementRequest) GetFromCounterparty() string {
+	if x != nil {
+		return x.FromCounterparty
+	}
+	return ""
+}
+
+func (x *SubmitSettlementRequest) GetToCounterparty() string {
+	if x != nil {
+		return x.ToCounterparty
+	}
+	return ""
+}
+
+func (x *SubmitSettlementRequest) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+func (x *SubmitSettlementRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *SubmitSettlementRequest) GetTravelRule() *TravelRuleData {
+	if x != nil {
+		return x.TravelRule
+	}
+	return nil
+}
+
+type SubmitSettlementResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId   string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	SettlementDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=settlement_date,json=settlementDate,proto3" json:"settlement_date,omitempty"`
+	// Set when the settlement waits in "pending_approval"
+	ApprovalId string `protobuf:"bytes,4,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	// Set when compliance screening held the settlement for review
+	ReviewId string `protobuf:"bytes,5,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// Set when the settlement "failed"; retryable failures are retried until the
+	// fail deadline, so the settlement must not be submitted again
+	ReasonCode    string                 `protobuf:"bytes,6,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	FailDeadline  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=fail_deadline,json=failDeadline,proto3" json:"fail_deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitSettlementResponse) Reset() {
+	*x = SubmitSettlementResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSettlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSettlementResponse) ProtoMessage() {}
+
+func (x *SubmitSettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSettlementResponse.ProtoReflect.Descriptor instead.
+func (*SubmitSettlementResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SubmitSettlementResponse) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *SubmitSettlementResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SubmitSettlementResponse) GetSettlementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettlementDate
+	}
+	return nil
+}
+
+func (x *SubmitSettlementResponse) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *SubmitSettlementResponse) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *SubmitSettlementResponse) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *SubmitSettlementResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubmitSettlementResponse) GetFailDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailDeadline
+	}
+	return nil
+}
+
+type Settlement struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromAccount      string                 `protobuf:"bytes,2,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	ToAccount        string                 `protobuf:"bytes,3,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
+	AssetId          string                 `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount           float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	SettledAmount    float64                `protobuf:"fixed64,6,opt,name=settled_amount,json=settledAmount,proto3" json:"settled_amount,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ReasonCode       string                 `protobuf:"bytes,8,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Reason           string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	SettlementDate   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=settlement_date,json=settlementDate,proto3" json:"settlement_date,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FromCounterparty string                 `protobuf:"bytes,12,opt,name=from_counterparty,json=fromCounterparty,proto3" json:"from_counterparty,omitempty"`
+	ToCounterparty   string                 `protobuf:"bytes,13,opt,name=to_counterparty,json=toCounterparty,proto3" json:"to_counterparty,omitempty"`
+	TradeId          string                 `protobuf:"bytes,14,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Amendments       []*SettlementAmendment `protobuf:"bytes,15,rep,name=amendments,proto3" json:"amendments,omitempty"`
+	InitiatedBy      string                 `protobuf:"bytes,16,opt,name=initiated_by,json=initiatedBy,proto3" json:"initiated_by,omitempty"`
+	ApprovalId       string                 `protobuf:"bytes,17,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	ReviewId         string                 `protobuf:"bytes,18,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settlement) ProtoMessage() {}
+
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Settlement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Settlement) GetFromAccount() string {
+	if x != nil {
+		return x.FromAccount
+	}
+	return ""
+}
+
+func (x *Settlement) GetToAccount() string {
+	if x != nil {
+		return x.ToAccount
+	}
+	return ""
+}
+
+func (x *Settlement) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *Settlement) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Settlement) GetSettledAmount() float64 {
+	if x != nil {
+		return x.SettledAmount
+	}
+	return 0
+}
+
+func (x *Settlement) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Settlement) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *Settlement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Settlement) GetSettlementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettlementDate
+	}
+	return nil
+}
+
+func (x *Settlement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Settlement) GetFromCounterparty() string {
+	if x != nil {
+		return x.FromCounterparty
+	}
+	return ""
+}
+
+func (x *Settlement) GetToCounterparty() string {
+	if x != nil {
+		return x.ToCounterparty
+	}
+	return ""
+}
+
+func (x *Settlement) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *Settlement) GetAmendments() []*SettlementAmendment {
+	if x != nil {
+		return x.Amendments
+	}
+	return nil
+}
+
+func (x *Settlement) GetInitiatedBy() string {
+	if x != nil {
+		return x.InitiatedBy
+	}
+	return ""
+}
+
+func (x *Settlement) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *Settlement) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+type SettlementAmendment struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence int32                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// "amend" or "cancel"
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// "pending_approval", "applied" or "rejected"
+	Status                 string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	RequestedBy            string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	ResolvedBy             string                 `protobuf:"bytes,5,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	Reason                 string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	PreviousAmount         float64                `protobuf:"fixed64,7,opt,name=previous_amount,json=previousAmount,proto3" json:"previous_amount,omitempty"`
+	Amount                 float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	PreviousSettlementDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=previous_settlement_date,json=previousSettlementDate,proto3" json:"previous_settlement_date,omitempty"`
+	SettlementDate         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=settlement_date,json=settlementDate,proto3" json:"settlement_date,omitempty"`
+	RequestedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ResolvedAt             *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SettlementAmendment) Reset() {
+	*x = SettlementAmendment{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementAmendment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementAmendment) ProtoMessage() {}
+
+func (x *SettlementAmendment) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementAmendment.ProtoReflect.Descriptor instead.
+func (*SettlementAmendment) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SettlementAmendment) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SettlementAmendment) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SettlementAmendment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SettlementAmendment) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *SettlementAmendment) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *SettlementAmendment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SettlementAmendment) GetPreviousAmount() float64 {
+	if x != nil {
+		return x.PreviousAmount
+	}
+	return 0
+}
+
+func (x *SettlementAmendment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SettlementAmendment) GetPreviousSettlementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousSettlementDate
+	}
+	return nil
+}
+
+func (x *SettlementAmendment) GetSettlementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettlementDate
+	}
+	return nil
+}
+
+func (x *SettlementAmendment) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *SettlementAmendment) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type GetSettlementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettlementRequest) Reset() {
+	*x = GetSettlementRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettlementRequest) ProtoMessage() {}
+
+func (x *GetSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettlementRequest.ProtoReflect.Descriptor instead.
+func (*GetSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetSettlementRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+type GetSettlementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settlement    *Settlement            `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettlementResponse) Reset() {
+	*x = GetSettlementResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettlementResponse) ProtoMessage() {}
+
+func (x *GetSettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettlementResponse.ProtoReflect.Descriptor instead.
+func (*GetSettlementResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetSettlementResponse) GetSettlement() *Settlement {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+type AmendSettlementRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// Counterparty (or account, for settlements between accounts) asking for the change;
+	// a verified client certificate identity takes its place
+	RequestedBy string `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Unset fields are left unchanged
+	Amount         *float64               `protobuf:"fixed64,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	SettlementDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=settlement_date,json=settlementDate,proto3" json:"settlement_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AmendSettlementRequest) Reset() {
+	*x = AmendSettlementRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmendSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendSettlementRequest) ProtoMessage() {}
+
+func (x *AmendSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendSettlementRequest.ProtoReflect.Descriptor instead.
+func (*AmendSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AmendSettlementRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *AmendSettlementRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *AmendSettlementRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AmendSettlementRequest) GetAmount() float64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *AmendSettlementRequest) GetSettlementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettlementDate
+	}
+	return nil
+}
+
+type CancelSettlementRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// A verified client certificate identity takes its place
+	RequestedBy   string `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSettlementRequest) Reset() {
+	*x = CancelSettlementRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSettlementRequest) ProtoMessage() {}
+
+func (x *CancelSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSettlementRequest.ProtoReflect.Descriptor instead.
+func (*CancelSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CancelSettlementRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *CancelSettlementRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CancelSettlementRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApproveSettlementChangeRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// A verified client certificate identity takes its place
+	ApprovedBy    string `protobuf:"bytes,2,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveSettlementChangeRequest) Reset() {
+	*x = ApproveSettlementChangeRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveSettlementChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSettlementChangeRequest) ProtoMessage() {}
+
+func (x *ApproveSettlementChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSettlementChangeRequest.ProtoReflect.Descriptor instead.
+func (*ApproveSettlementChangeRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ApproveSettlementChangeRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *ApproveSettlementChangeRequest) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+type RejectSettlementChangeRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// A verified client certificate identity takes its place
+	RejectedBy    string `protobuf:"bytes,2,opt,name=rejected_by,json=rejectedBy,proto3" json:"rejected_by,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectSettlementChangeRequest) Reset() {
+	*x = RejectSettlementChangeRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectSettlementChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectSettlementChangeRequest) ProtoMessage() {}
+
+func (x *RejectSettlementChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectSettlementChangeRequest.ProtoReflect.Descriptor instead.
+func (*RejectSettlementChangeRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RejectSettlementChangeRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *RejectSettlementChangeRequest) GetRejectedBy() string {
+	if x != nil {
+		return x.RejectedBy
+	}
+	return ""
+}
+
+func (x *RejectSettlementChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SettlementChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settlement    *Settlement            `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettlementChangeResponse) Reset() {
+	*x = SettlementChangeResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementChangeResponse) ProtoMessage() {}
+
+func (x *SettlementChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementChangeResponse.ProtoReflect.Descriptor instead.
+func (*SettlementChangeResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SettlementChangeResponse) GetSettlement() *Settlement {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+type ApprovalDecision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approver      string                 `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+	Approved      bool                   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalDecision) Reset() {
+	*x = ApprovalDecision{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalDecision) ProtoMessage() {}
+
+func (x *ApprovalDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalDecision.ProtoReflect.Descriptor instead.
+func (*ApprovalDecision) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ApprovalDecision) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *ApprovalDecision) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *ApprovalDecision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ApprovalDecision) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type Approval struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "transfer" or "withdrawal"
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Settlement or withdrawal ID
+	ResourceId  string              `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	AccountId   string              `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId     string              `protobuf:"bytes,5,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount      float64             `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	RequestedBy string              `protobuf:"bytes,7,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Quorum      int32               `protobuf:"varint,8,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	Approvers   []string            `protobuf:"bytes,9,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	Decisions   []*ApprovalDecision `protobuf:"bytes,10,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	// "pending", "approved", "rejected", "expired" or "cancelled"
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Approval) Reset() {
+	*x = Approval{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Approval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Approval) ProtoMessage() {}
+
+func (x *Approval) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Approval.ProtoReflect.Descriptor instead.
+func (*Approval) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Approval) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Approval) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *Approval) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *Approval) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Approval) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *Approval) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Approval) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *Approval) GetQuorum() int32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *Approval) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *Approval) GetDecisions() []*ApprovalDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+func (x *Approval) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Approval) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Approval) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Approval) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type ListApprovalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalsRequest) Reset() {
+	*x = ListApprovalsRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalsRequest) ProtoMessage() {}
+
+func (x *ListApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListApprovalsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*Approval            `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalsResponse) Reset() {
+	*x = ListApprovalsResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalsResponse) ProtoMessage() {}
+
+func (x *ListApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListApprovalsResponse) GetApprovals() []*Approval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type GetApprovalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovalRequest) Reset() {
+	*x = GetApprovalRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovalRequest) ProtoMessage() {}
+
+func (x *GetApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovalRequest.ProtoReflect.Descriptor instead.
+func (*GetApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetApprovalRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+type ApproveOperationRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	// Ignored: decisions need a verified client certificate, whose identity is recorded
+	Approver      string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveOperationRequest) Reset() {
+	*x = ApproveOperationRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveOperationRequest) ProtoMessage() {}
+
+func (x *ApproveOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveOperationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveOperationRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ApproveOperationRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *ApproveOperationRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+type RejectOperationRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	// Ignored: decisions need a verified client certificate, whose identity is recorded
+	Approver      string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectOperationRequest) Reset() {
+	*x = RejectOperationRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectOperationRequest) ProtoMessage() {}
+
+func (x *RejectOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectOperationRequest.ProtoReflect.Descriptor instead.
+func (*RejectOperationRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RejectOperationRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *RejectOperationRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *RejectOperationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApprovalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approval      *Approval              `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalResponse) Reset() {
+	*x = ApprovalResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalResponse) ProtoMessage() {}
+
+func (x *ApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalResponse.ProtoReflect.Descriptor instead.
+func (*ApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ApprovalResponse) GetApproval() *Approval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+type ComplianceReview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "transfer" or "withdrawal"
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Settlement or withdrawal ID
+	ResourceId string   `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	AccountId  string   `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AssetId    string   `protobuf:"bytes,5,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount     float64  `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reasons    []string `protobuf:"bytes,7,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// "pending", "released", "rejected" or "cancelled"
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Reviewer      string                 `protobuf:"bytes,9,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Notes         string                 `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComplianceReview) Reset() {
+	*x = ComplianceReview{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComplianceReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplianceReview) ProtoMessage() {}
+
+func (x *ComplianceReview) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplianceReview.ProtoReflect.Descriptor instead.
+func (*ComplianceReview) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ComplianceReview) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ComplianceReview) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ComplianceReview) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ComplianceReview) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ComplianceReview) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *ComplianceReview) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ComplianceReview) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *ComplianceReview) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ComplianceReview) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *ComplianceReview) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ComplianceReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ComplianceReview) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type ListComplianceReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComplianceReviewsRequest) Reset() {
+	*x = ListComplianceReviewsRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComplianceReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComplianceReviewsRequest) ProtoMessage() {}
+
+func (x *ListComplianceReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComplianceReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListComplianceReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListComplianceReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListComplianceReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*ComplianceReview    `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComplianceReviewsResponse) Reset() {
+	*x = ListComplianceReviewsResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComplianceReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComplianceReviewsResponse) ProtoMessage() {}
+
+func (x *ListComplianceReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComplianceReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListComplianceReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListComplianceReviewsResponse) GetReviews() []*ComplianceReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type GetComplianceReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComplianceReviewRequest) Reset() {
+	*x = GetComplianceReviewRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComplianceReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComplianceReviewRequest) ProtoMessage() {}
+
+func (x *GetComplianceReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComplianceReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetComplianceReviewRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetComplianceReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+type ResolveComplianceReviewRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// A verified caller identity takes precedence
+	Reviewer      string `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Notes         string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveComplianceReviewRequest) Reset() {
+	*x = ResolveComplianceReviewRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveComplianceReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveComplianceReviewRequest) ProtoMessage() {}
+
+func (x *ResolveComplianceReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveComplianceReviewRequest.ProtoReflect.Descriptor instead.
+func (*ResolveComplianceReviewRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ResolveComplianceReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *ResolveComplianceReviewRequest) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *ResolveComplianceReviewRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type ComplianceReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *ComplianceReview      `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComplianceReviewResponse) Reset() {
+	*x = ComplianceReviewResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComplianceReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplianceReviewResponse) ProtoMessage() {}
+
+func (x *ComplianceReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplianceReviewResponse.ProtoReflect.Descriptor instead.
+func (*ComplianceReviewResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ComplianceReviewResponse) GetReview() *ComplianceReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type MatchingInstruction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "deliver" or "receive"
+	Side           string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	TradeReference string                 `protobuf:"bytes,3,opt,name=trade_reference,json=tradeReference,proto3" json:"trade_reference,omitempty"`
+	FromAccount    string                 `protobuf:"bytes,4,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	ToAccount      string                 `protobuf:"bytes,5,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
+	AssetId        string                 `protobuf:"bytes,6,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount         float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	SettlementDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=settlement_date,json=settlementDate,proto3" json:"settlement_date,omitempty"`
+	SubmittedBy    string                 `protobuf:"bytes,9,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	// "unmatched" or "matched"
+	Status          string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	MatchedWith     string                 `protobuf:"bytes,11,opt,name=matched_with,json=matchedWith,proto3" json:"matched_with,omitempty"`
+	SettlementId    string                 `protobuf:"bytes,12,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	MismatchReasons []string               `protobuf:"bytes,13,rep,name=mismatch_reasons,json=mismatchReasons,proto3" json:"mismatch_reasons,omitempty"`
+	SubmittedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	MatchedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=matched_at,json=matchedAt,proto3" json:"matched_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MatchingInstruction) Reset() {
+	*x = MatchingInstruction{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchingInstruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingInstruction) ProtoMessage() {}
+
+func (x *MatchingInstruction) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingInstruction.ProtoReflect.Descriptor instead.
+func (*MatchingInstruction) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MatchingInstruction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MatchingInstruction) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *MatchingInstruction) GetTradeReference() string {
+	if x != nil {
+		return x.TradeReference
+	}
+	return ""
+}
+
+func (x *MatchingInstruction) GetFromAccount() string {
+	if x != nil {
+		return x.FromAccount
+	}
+	return ""
+}
+
+func (x *MatchingInstruction) GetToAccount() string {
+	if x != nil {
+		return x.ToAccount
+	}
+	return ""
+}
+
+func (x *MatchingInstruction) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *MatchingInstruction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MatchingInstruction) GetSettlementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettlementDate
+	}
+	return nil
+}
+
+func (x *MatchingInstruction) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *MatchingInstruction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MatchingInstruction) GetMatchedWith() string {
+	if x != nil {
+		return x.MatchedWith
+	}
+	return ""
+}
+
+func (x *MatchingInstruction) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *MatchingInstruction) GetMismatchReasons() []string {
+	if x != nil {
+		return x.MismatchReasons
+	}
+	return nil
+}
+
+func (x *MatchingInstruction) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *MatchingInstruction) GetMatchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MatchedAt
+	}
+	return nil
+}
+
+type SubmitMatchingInstructionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Generated when empty
+	InstructionId  string  `protobuf:"bytes,1,opt,name=instruction_id,json=instructionId,proto3" json:"instruction_id,omitempty"`
+	Side           string  `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	TradeReference string  `protobuf:"bytes,3,opt,name=trade_reference,json=tradeReference,proto3" json:"trade_reference,omitempty"`
+	FromAccount    string  `protobuf:"bytes,4,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	ToAccount      string  `protobuf:"bytes,5,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
+	AssetId        string  `protobuf:"bytes,6,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount         float64 `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The asset's next settlement opportunity when unset
+	SettlementDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=settlement_date,json=settlementDate,proto3" json:"settlement_date,omitempty"`
+	// Ignored: the verified client certificate identity is recorded instead, and must
+	// be the counterparty whose SSI names this side's account
+	SubmittedBy   string `protobuf:"bytes,9,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitMatchingInstructionRequest) Reset() {
+	*x = SubmitMatchingInstructionRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitMatchingInstructionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMatchingInstructionRequest) ProtoMessage() {}
+
+func (x *SubmitMatchingInstructionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMatchingInstructionRequest.ProtoReflect.Descriptor instead.
+func (*SubmitMatchingInstructionRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SubmitMatchingInstructionRequest) GetInstructionId() string {
+	if x != nil {
+		return x.InstructionId
+	}
+	return ""
+}
+
+func (x *SubmitMatchingInstructionRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *SubmitMatchingInstructionRequest) GetTradeReference() string {
+	if x != nil {
+		return x.TradeReference
+	}
+	return ""
+}
+
+func (x *SubmitMatchingInstructionRequest) GetFromAccount() string {
+	if x != nil {
+		return x.FromAccount
+	}
+	return ""
+}
+
+func (x *SubmitMatchingInstructionRequest) GetToAccount() string {
+	if x != nil {
+		return x.ToAccount
+	}
+	return ""
+}
+
+func (x *SubmitMatchingInstructionRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *SubmitMatchingInstructionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SubmitMatchingInstructionRequest) GetSettlementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettlementDate
+	}
+	return nil
+}
+
+func (x *SubmitMatchingInstructionRequest) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+type SubmitMatchingInstructionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instruction   *MatchingInstruction   `protobuf:"bytes,1,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitMatchingInstructionResponse) Reset() {
+	*x = SubmitMatchingInstructionResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitMatchingInstructionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMatchingInstructionResponse) ProtoMessage() {}
+
+func (x *SubmitMatchingInstructionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMatchingInstructionResponse.ProtoReflect.Descriptor instead.
+func (*SubmitMatchingInstructionResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SubmitMatchingInstructionResponse) GetInstruction() *MatchingInstruction {
+	if x != nil {
+		return x.Instruction
+	}
+	return nil
+}
+
+type GetMatchingInstructionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructionId string                 `protobuf:"bytes,1,opt,name=instruction_id,json=instructionId,proto3" json:"instruction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchingInstructionRequest) Reset() {
+	*x = GetMatchingInstructionRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchingInstructionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchingInstructionRequest) ProtoMessage() {}
+
+func (x *GetMatchingInstructionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchingInstructionRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchingInstructionRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetMatchingInstructionRequest) GetInstructionId() string {
+	if x != nil {
+		return x.InstructionId
+	}
+	return ""
+}
+
+type GetMatchingInstructionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instruction   *MatchingInstruction   `protobuf:"bytes,1,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchingInstructionResponse) Reset() {
+	*x = GetMatchingInstructionResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchingInstructionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchingInstructionResponse) ProtoMessage() {}
+
+func (x *GetMatchingInstructionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchingInstructionResponse.ProtoReflect.Descriptor instead.
+func (*GetMatchingInstructionResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetMatchingInstructionResponse) GetInstruction() *MatchingInstruction {
+	if x != nil {
+		return x.Instruction
+	}
+	return nil
+}
+
+type GetMismatchReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMismatchReportRequest) Reset() {
+	*x = GetMismatchReportRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMismatchReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMismatchReportRequest) ProtoMessage() {}
+
+func (x *GetMismatchReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMismatchReportRequest.ProtoReflect.Descriptor instead.
+func (*GetMismatchReportRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{65}
+}
+
+type UnmatchedInstruction struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Instruction *MatchingInstruction   `protobuf:"bytes,1,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	AgeSeconds  int64                  `protobuf:"varint,2,opt,name=age_seconds,json=ageSeconds,proto3" json:"age_seconds,omitempty"`
+	// "0-1d", "1-3d" or "3d+"
+	AgeBucket     string `protobuf:"bytes,3,opt,name=age_bucket,json=ageBucket,proto3" json:"age_bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmatchedInstruction) Reset() {
+	*x = UnmatchedInstruction{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmatchedInstruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmatchedInstruction) ProtoMessage() {}
+
+func (x *UnmatchedInstruction) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmatchedInstruction.ProtoReflect.Descriptor instead.
+func (*UnmatchedInstruction) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UnmatchedInstruction) GetInstruction() *MatchingInstruction {
+	if x != nil {
+		return x.Instruction
+	}
+	return nil
+}
+
+func (x *UnmatchedInstruction) GetAgeSeconds() int64 {
+	if x != nil {
+		return x.AgeSeconds
+	}
+	return 0
+}
+
+func (x *UnmatchedInstruction) GetAgeBucket() string {
+	if x != nil {
+		return x.AgeBucket
+	}
+	return ""
+}
+
+type GetMismatchReportResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	GeneratedAt   *timestamppb.Timestamp  `protobuf:"bytes,1,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Unmatched     []*UnmatchedInstruction `protobuf:"bytes,2,rep,name=unmatched,proto3" json:"unmatched,omitempty"`
+	CountByAge    map[string]int32        `protobuf:"bytes,3,rep,name=count_by_age,json=countByAge,proto3" json:"count_by_age,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMismatchReportResponse) Reset() {
+	*x = GetMismatchReportResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMismatchReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMismatchReportResponse) ProtoMessage() {}
+
+func (x *GetMismatchReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMismatchReportResponse.ProtoReflect.Descriptor instead.
+func (*GetMismatchReportResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetMismatchReportResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *GetMismatchReportResponse) GetUnmatched() []*UnmatchedInstruction {
+	if x != nil {
+		return x.Unmatched
+	}
+	return nil
+}
+
+func (x *GetMismatchReportResponse) GetCountByAge() map[string]int32 {
+	if x != nil {
+		return x.CountByAge
+	}
+	return nil
+}
+
+type GetFailsReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "YYYY-MM-DD"; today (UTC) when empty
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFailsReportRequest) Reset() {
+	*x = GetFailsReportRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFailsReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFailsReportRequest) ProtoMessage() {}
+
+func (x *GetFailsReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFailsReportRequest.ProtoReflect.Descriptor instead.
+func (*GetFailsReportRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetFailsReportRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type SettlementFail struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId   string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	FromAccount    string                 `protobuf:"bytes,2,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	ToAccount      string                 `protobuf:"bytes,3,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
+	AssetId        string                 `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount         float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	SettledAmount  float64                `protobuf:"fixed64,6,opt,name=settled_amount,json=settledAmount,proto3" json:"settled_amount,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ReasonCode     string                 `protobuf:"bytes,8,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Reason         string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	Attempts       int32                  `protobuf:"varint,10,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	SettlementDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=settlement_date,json=settlementDate,proto3" json:"settlement_date,omitempty"`
+	FailedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	FailDeadline   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=fail_deadline,json=failDeadline,proto3" json:"fail_deadline,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SettlementFail) Reset() {
+	*x = SettlementFail{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementFail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementFail) ProtoMessage() {}
+
+func (x *SettlementFail) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementFail.ProtoReflect.Descriptor instead.
+func (*SettlementFail) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SettlementFail) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *SettlementFail) GetFromAccount() string {
+	if x != nil {
+		return x.FromAccount
+	}
+	return ""
+}
+
+func (x *SettlementFail) GetToAccount() string {
+	if x != nil {
+		return x.ToAccount
+	}
+	return ""
+}
+
+func (x *SettlementFail) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *SettlementFail) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SettlementFail) GetSettledAmount() float64 {
+	if x != nil {
+		return x.SettledAmount
+	}
+	return 0
+}
+
+func (x *SettlementFail) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SettlementFail) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *SettlementFail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SettlementFail) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *SettlementFail) GetSettlementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettlementDate
+	}
+	return nil
+}
+
+func (x *SettlementFail) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+func (x *SettlementFail) GetFailDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailDeadline
+	}
+	return nil
+}
+
+type GetFailsReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Fails         []*SettlementFail      `protobuf:"bytes,2,rep,name=fails,proto3" json:"fails,omitempty"`
+	CountByReason map[string]int32       `protobuf:"bytes,3,rep,name=count_by_reason,json=countByReason,proto3" json:"count_by_reason,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Amount still outstanding on fails that have not completed
+	UnsettledByAsset map[string]float64 `protobuf:"bytes,4,rep,name=unsettled_by_asset,json=unsettledByAsset,proto3" json:"unsettled_by_asset,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetFailsReportResponse) Reset() {
+	*x = GetFailsReportResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFailsReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFailsReportResponse) ProtoMessage() {}
+
+func (x *GetFailsReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFailsReportResponse.ProtoReflect.Descriptor instead.
+func (*GetFailsReportResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetFailsReportResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetFailsReportResponse) GetFails() []*SettlementFail {
+	if x != nil {
+		return x.Fails
+	}
+	return nil
+}
+
+func (x *GetFailsReportResponse) GetCountByReason() map[string]int32 {
+	if x != nil {
+		return x.CountByReason
+	}
+	return nil
+}
+
+func (x *GetFailsReportResponse) GetUnsettledByAsset() map[string]float64 {
+	if x != nil {
+		return x.UnsettledByAsset
+	}
+	return nil
+}
+
+type StandingSettlementInstruction struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Counterparty        string                 `protobuf:"bytes,1,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	AssetId             string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	AccountId           string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Network             string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	SettlementCycleDays int32                  `protobuf:"varint,5,opt,name=settlement_cycle_days,json=settlementCycleDays,proto3" json:"settlement_cycle_days,omitempty"`
+	// "HH:MM" UTC; empty means no cut-off
+	CutOff        string                 `protobuf:"bytes,6,opt,name=cut_off,json=cutOff,proto3" json:"cut_off,omitempty"`
+	Version       int32                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	SupersededAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=superseded_at,json=supersededAt,proto3" json:"superseded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandingSettlementInstruction) Reset() {
+	*x = StandingSettlementInstruction{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandingSettlementInstruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingSettlementInstruction) ProtoMessage() {}
+
+func (x *StandingSettlementInstruction) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingSettlementInstruction.ProtoReflect.Descriptor instead.
+func (*StandingSettlementInstruction) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StandingSettlementInstruction) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *StandingSettlementInstruction) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *StandingSettlementInstruction) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *StandingSettlementInstruction) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *StandingSettlementInstruction) GetSettlementCycleDays() int32 {
+	if x != nil {
+		return x.SettlementCycleDays
+	}
+	return 0
+}
+
+func (x *StandingSettlementInstruction) GetCutOff() string {
+	if x != nil {
+		return x.CutOff
+	}
+	return ""
+}
+
+func (x *StandingSettlementInstruction) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *StandingSettlementInstruction) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *StandingSettlementInstruction) GetSupersededAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SupersededAt
+	}
+	return nil
+}
+
+type PutStandingSettlementInstructionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Counterparty        string                 `protobuf:"bytes,1,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	AssetId             string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	AccountId           string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Network             string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	SettlementCycleDays int32                  `protobuf:"varint,5,opt,name=settlement_cycle_days,json=settlementCycleDays,proto3" json:"settlement_cycle_days,omitempty"`
+	CutOff              string                 `protobuf:"bytes,6,opt,name=cut_off,json=cutOff,proto3" json:"cut_off,omitempty"`
+	// When set, the update is rejected unless this is the current version
+	ExpectedVersion int32 `protobuf:"varint,7,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PutStandingSettlementInstructionRequest) Reset() {
+	*x = PutStandingSettlementInstructionRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutStandingSettlementInstructionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutStandingSettlementInstructionRequest) ProtoMessage() {}
+
+func (x *PutStandingSettlementInstructionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutStandingSettlementInstructionRequest.ProtoReflect.Descriptor instead.
+func (*PutStandingSettlementInstructionRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PutStandingSettlementInstructionRequest) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *PutStandingSettlementInstructionRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *PutStandingSettlementInstructionRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PutStandingSettlementInstructionRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *PutStandingSettlementInstructionRequest) GetSettlementCycleDays() int32 {
+	if x != nil {
+		return x.SettlementCycleDays
+	}
+	return 0
+}
+
+func (x *PutStandingSettlementInstructionRequest) GetCutOff() string {
+	if x != nil {
+		return x.CutOff
+	}
+	return ""
+}
+
+func (x *PutStandingSettlementInstructionRequest) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type PutStandingSettlementInstructionResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Ssi           *StandingSettlementInstruction `protobuf:"bytes,1,opt,name=ssi,proto3" json:"ssi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutStandingSettlementInstructionResponse) Reset() {
+	*x = PutStandingSettlementInstructionResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutStandingSettlementInstructionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutStandingSettlementInstructionResponse) ProtoMessage() {}
+
+func (x *PutStandingSettlementInstructionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutStandingSettlementInstructionResponse.ProtoReflect.Descriptor instead.
+func (*PutStandingSettlementInstructionResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PutStandingSettlementInstructionResponse) GetSsi() *StandingSettlementInstruction {
+	if x != nil {
+		return x.Ssi
+	}
+	return nil
+}
+
+type GetStandingSettlementInstructionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Counterparty   string                 `protobuf:"bytes,1,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	AssetId        string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	IncludeHistory bool                   `protobuf:"varint,3,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetStandingSettlementInstructionRequest) Reset() {
+	*x = GetStandingSettlementInstructionRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStandingSettlementInstructionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingSettlementInstructionRequest) ProtoMessage() {}
+
+func (x *GetStandingSettlementInstructionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingSettlementInstructionRequest.ProtoReflect.Descriptor instead.
+func (*GetStandingSettlementInstructionRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetStandingSettlementInstructionRequest) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *GetStandingSettlementInstructionRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *GetStandingSettlementInstructionRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+type GetStandingSettlementInstructionResponse struct {
+	state protoimpl.MessageState         `protogen:"open.v1"`
+	Ssi   *StandingSettlementInstruction `protobuf:"bytes,1,opt,name=ssi,proto3" json:"ssi,omitempty"`
+	// Every version, oldest first, when include_history is set
+	History       []*StandingSettlementInstruction `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStandingSettlementInstructionResponse) Reset() {
+	*x = GetStandingSettlementInstructionResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStandingSettlementInstructionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingSettlementInstructionResponse) ProtoMessage() {}
+
+func (x *GetStandingSettlementInstructionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingSettlementInstructionResponse.ProtoReflect.Descriptor instead.
+func (*GetStandingSettlementInstructionResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetStandingSettlementInstructionResponse) GetSsi() *StandingSettlementInstruction {
+	if x != nil {
+		return x.Ssi
+	}
+	return nil
+}
+
+func (x *GetStandingSettlementInstructionResponse) GetHistory() []*StandingSettlementInstruction {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ListStandingSettlementInstructionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only this counterparty's SSIs when set
+	Counterparty  string `protobuf:"bytes,1,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStandingSettlementInstructionsRequest) Reset() {
+	*x = ListStandingSettlementInstructionsRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStandingSettlementInstructionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStandingSettlementInstructionsRequest) ProtoMessage() {}
+
+func (x *ListStandingSettlementInstructionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStandingSettlementInstructionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStandingSettlementInstructionsRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListStandingSettlementInstructionsRequest) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+type ListStandingSettlementInstructionsResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Ssis          []*StandingSettlementInstruction `protobuf:"bytes,1,rep,name=ssis,proto3" json:"ssis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStandingSettlementInstructionsResponse) Reset() {
+	*x = ListStandingSettlementInstructionsResponse{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStandingSettlementInstructionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStandingSettlementInstructionsResponse) ProtoMessage() {}
+
+func (x *ListStandingSettlementInstructionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStandingSettlementInstructionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStandingSettlementInstructionsResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListStandingSettlementInstructionsResponse) GetSsis() []*StandingSettlementInstruction {
+	if x != nil {
+		return x.Ssis
+	}
+	return nil
+}
+
+type SubscribeAccountEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Accounts to receive events for (at least one)
+	AccountIds []string `protobuf:"bytes,1,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	// Replay buffered events with a sequence greater than this value before
+	// streaming live events. Unset means live events only.
+	ResumeAfterSequence *uint64 `protobuf:"varint,2,opt,name=resume_after_sequence,json=resumeAfterSequence,proto3,oneof" json:"resume_after_sequence,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SubscribeAccountEventsRequest) Reset() {
+	*x = SubscribeAccountEventsRequest{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeAccountEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAccountEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeAccountEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAccountEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeAccountEventsRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SubscribeAccountEventsRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *SubscribeAccountEventsRequest) GetResumeAfterSequence() uint64 {
+	if x != nil && x.ResumeAfterSequence != nil {
+		return *x.ResumeAfterSequence
+	}
+	return 0
+}
+
+type AccountEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Monotonically increasing across all accounts; use it to resume
+	Sequence  uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type      AccountEventType       `protobuf:"varint,2,opt,name=type,proto3,enum=custodian.v1.AccountEventType" json:"type,omitempty"`
+	AccountId string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are valid to be assigned to Detail:
+	//
+	//	*AccountEvent_BalanceChange
+	//	*AccountEvent_SettlementTransition
+	//	*AccountEvent_HoldChange
+	//	*AccountEvent_AccountStatusChange
+	Detail        isAccountEvent_Detail `protobuf_oneof:"detail"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountEvent) Reset() {
+	*x = AccountEvent{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEvent) ProtoMessage() {}
+
+func (x *AccountEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEvent.ProtoReflect.Descriptor instead.
+func (*AccountEvent) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AccountEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AccountEvent) GetType() AccountEventType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountEventType_ACCOUNT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *AccountEvent) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AccountEvent) GetDetail() isAccountEvent_Detail {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+func (x *AccountEvent) GetBalanceChange() *BalanceChange {
+	if x != nil {
+		if x, ok := x.Detail.(*AccountEvent_BalanceChange); ok {
+			return x.BalanceChange
+		}
+	}
+	return nil
+}
+
+func (x *AccountEvent) GetSettlementTransition() *SettlementTransition {
+	if x != nil {
+		if x, ok := x.Detail.(*AccountEvent_SettlementTransition); ok {
+			return x.SettlementTransition
+		}
+	}
+	return nil
+}
+
+func (x *AccountEvent) GetHoldChange() *HoldChange {
+	if x != nil {
+		if x, ok := x.Detail.(*AccountEvent_HoldChange); ok {
+			return x.HoldChange
+		}
+	}
+	return nil
+}
+
+func (x *AccountEvent) GetAccountStatusChange() *AccountStatusChange {
+	if x != nil {
+		if x, ok := x.Detail.(*AccountEvent_AccountStatusChange); ok {
+			return x.AccountStatusChange
+		}
+	}
+	return nil
+}
+
+type isAccountEvent_Detail interface {
+	isAccountEvent_Detail()
+}
+
+type AccountEvent_BalanceChange struct {
+	BalanceChange *BalanceChange `protobuf:"bytes,10,opt,name=balance_change,json=balanceChange,proto3,oneof"`
+}
+
+type AccountEvent_SettlementTransition struct {
+	SettlementTransition *SettlementTransition `protobuf:"bytes,11,opt,name=settlement_transition,json=settlementTransition,proto3,oneof"`
+}
+
+type AccountEvent_HoldChange struct {
+	HoldChange *HoldChange `protobuf:"bytes,12,opt,name=hold_change,json=holdChange,proto3,oneof"`
+}
+
+type AccountEvent_AccountStatusChange struct {
+	AccountStatusChange *AccountStatusChange `protobuf:"bytes,13,opt,name=account_status_change,json=accountStatusChange,proto3,oneof"`
+}
+
+func (*AccountEvent_BalanceChange) isAccountEvent_Detail() {}
+
+func (*AccountEvent_SettlementTransition) isAccountEvent_Detail() {}
+
+func (*AccountEvent_HoldChange) isAccountEvent_Detail() {}
+
+func (*AccountEvent_AccountStatusChange) isAccountEvent_Detail() {}
+
+type BalanceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Delta         float64                `protobuf:"fixed64,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	Balance       float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	SettlementId  string                 `protobuf:"bytes,4,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceChange) Reset() {
+	*x = BalanceChange{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChange) ProtoMessage() {}
+
+func (x *BalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChange.ProtoReflect.Descriptor instead.
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *BalanceChange) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+type SettlementTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettlementTransition) Reset() {
+	*x = SettlementTransition{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementTransition) ProtoMessage() {}
+
+func (x *SettlementTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementTransition.ProtoReflect.Descriptor instead.
+func (*SettlementTransition) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SettlementTransition) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *SettlementTransition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SettlementTransition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type HoldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	AssetId       string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldChange) Reset() {
+	*x = HoldChange{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldChange) ProtoMessage() {}
+
+func (x *HoldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldChange.ProtoReflect.Descriptor instead.
+func (*HoldChange) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *HoldChange) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *HoldChange) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *HoldChange) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AccountStatusChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PreviousStatus string                 `protobuf:"bytes,1,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AccountStatusChange) Reset() {
+	*x = AccountStatusChange{}
+	mi := &file_custodian_v1_custodian_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStatusChange) ProtoMessage() {}
+
+func (x *AccountStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_custodian_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStatusChange.ProtoReflect.Descriptor instead.
+func (*AccountStatusChange) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_custodian_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AccountStatusChange) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *AccountStatusChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_custodian_v1_custodian_proto protoreflect.FileDescriptor
+
+const file_custodian_v1_custodian_proto_rawDesc = "" +
+	"\n" +
+	"\x1ccustodian/v1/custodian.proto\x12\fcustodian.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbb\x01\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"9\n" +
+	"\x14CreateAccountRequest\x12!\n" +
+	"\faccount_type\x18\x01 \x01(\tR\vaccountType\"H\n" +
+	"\x15CreateAccountResponse\x12/\n" +
+	"\aaccount\x18\x01 \x01(\v2\x15.custodian.v1.AccountR\aaccount\"b\n" +
+	"\x0eDepositRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\"+\n" +
+	"\x0fDepositResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x01R\abalance\"\xbb\x04\n" +
+	"\n" +
+	"Withdrawal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x03 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x1f\n" +
+	"\vapproval_id\x18\t \x01(\tR\n" +
+	"approvalId\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\anetwork\x18\f \x01(\tR\anetwork\x12\x1b\n" +
+	"\treview_id\x18\r \x01(\tR\breviewId\x12\x17\n" +
+	"\atx_hash\x18\x0e \x01(\tR\x06txHash\x12\x10\n" +
+	"\x03fee\x18\x0f \x01(\x01R\x03fee\x12$\n" +
+	"\rconfirmations\x18\x10 \x01(\x05R\rconfirmations\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x11 \x01(\tR\tpaymentId\x12\x1c\n" +
+	"\treference\x18\x12 \x01(\tR\treference\"\xac\x04\n" +
+	"\vFiatPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\x12\x12\n" +
+	"\x04rail\x18\x03 \x01(\tR\x04rail\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\x12\x1f\n" +
+	"\vresource_id\x18\x05 \x01(\tR\n" +
+	"resourceId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x06 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\a \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12!\n" +
+	"\fbank_account\x18\t \x01(\tR\vbankAccount\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1f\n" +
+	"\vreturn_code\x18\v \x01(\tR\n" +
+	"returnCode\x12=\n" +
+	"\fsubmitted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x125\n" +
+	"\bbatch_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\abatchAt\x129\n" +
+	"\n" +
+	"settles_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tsettlesAt\x12=\n" +
+	"\fcompleted_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x93\x04\n" +
+	"\x10ChainTransaction\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x18\n" +
+	"\anetwork\x18\x02 \x01(\tR\anetwork\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x1f\n" +
+	"\vresource_id\x18\x04 \x01(\tR\n" +
+	"resourceId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x06 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12\x10\n" +
+	"\x03fee\x18\b \x01(\x01R\x03fee\x12\x18\n" +
+	"\aaddress\x18\t \x01(\tR\aaddress\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12!\n" +
+	"\fblock_height\x18\v \x01(\x03R\vblockHeight\x12\x1d\n" +
+	"\n" +
+	"block_hash\x18\f \x01(\tR\tblockHash\x12$\n" +
+	"\rconfirmations\x18\r \x01(\x05R\rconfirmations\x12\x16\n" +
+	"\x06reorgs\x18\x0e \x01(\x05R\x06reorgs\x12=\n" +
+	"\fbroadcast_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vbroadcastAt\x12=\n" +
+	"\fconfirmed_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vconfirmedAt\"\xc4\x01\n" +
+	"\x0eTravelRuleData\x12'\n" +
+	"\x0foriginator_name\x18\x01 \x01(\tR\x0eoriginatorName\x12-\n" +
+	"\x12originator_account\x18\x02 \x01(\tR\x11originatorAccount\x12)\n" +
+	"\x10beneficiary_name\x18\x03 \x01(\tR\x0fbeneficiaryName\x12/\n" +
+	"\x13beneficiary_account\x18\x04 \x01(\tR\x12beneficiaryAccount\"\xf9\x01\n" +
+	"\x0fWithdrawRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\x12\x18\n" +
+	"\anetwork\x18\x06 \x01(\tR\anetwork\x12=\n" +
+	"\vtravel_rule\x18\a \x01(\v2\x1c.custodian.v1.TravelRuleDataR\n" +
+	"travelRule\"L\n" +
+	"\x10WithdrawResponse\x128\n" +
+	"\n" +
+	"withdrawal\x18\x01 \x01(\v2\x18.custodian.v1.WithdrawalR\n" +
+	"withdrawal\"\x89\x03\n" +
+	"\x11WithdrawalAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x03 \x01(\tR\aassetId\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05label\x18\x06 \x01(\tR\x05label\x12\x19\n" +
+	"\badded_by\x18\a \x01(\tR\aaddedBy\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x125\n" +
+	"\badded_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\x12;\n" +
+	"\vactive_from\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"activeFrom\x129\n" +
+	"\n" +
+	"removed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tremovedAt\"\xbc\x01\n" +
+	"\x1bAddWithdrawalAddressRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05label\x18\x05 \x01(\tR\x05label\x12\x19\n" +
+	"\badded_by\x18\x06 \x01(\tR\aaddedBy\"?\n" +
+	"\x1eListWithdrawalAddressesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"`\n" +
+	"\x1fListWithdrawalAddressesResponse\x12=\n" +
+	"\taddresses\x18\x01 \x03(\v2\x1f.custodian.v1.WithdrawalAddressR\taddresses\"^\n" +
+	"\x1eRemoveWithdrawalAddressRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tR\taddressId\"V\n" +
+	"\x19WithdrawalAddressResponse\x129\n" +
+	"\aaddress\x18\x01 \x01(\v2\x1f.custodian.v1.WithdrawalAddressR\aaddress\";\n" +
+	"\x14GetWithdrawalRequest\x12#\n" +
+	"\rwithdrawal_id\x18\x01 \x01(\tR\fwithdrawalId\"Q\n" +
+	"\x15GetWithdrawalResponse\x128\n" +
+	"\n" +
+	"withdrawal\x18\x01 \x01(\v2\x18.custodian.v1.WithdrawalR\n" +
+	"withdrawal\"\xaa\x01\n" +
+	"\x19SubmitChainDepositRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x12!\n" +
+	"\ffrom_address\x18\x05 \x01(\tR\vfromAddress\"\\\n" +
+	"\x18ChainTransactionResponse\x12@\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1e.custodian.v1.ChainTransactionR\vtransaction\"1\n" +
+	"\x15GetChainStatusRequest\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\"\xf4\x02\n" +
+	"\x16GetChainStatusResponse\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x03R\x06height\x12\x19\n" +
+	"\btip_hash\x18\x03 \x01(\tR\atipHash\x12<\n" +
+	"\ftip_mined_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"tipMinedAt\x12>\n" +
+	"\rnext_block_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vnextBlockAt\x12!\n" +
+	"\fmempool_size\x18\x06 \x01(\x05R\vmempoolSize\x124\n" +
+	"\x16block_interval_seconds\x18\a \x01(\x03R\x14blockIntervalSeconds\x12$\n" +
+	"\rconfirmations\x18\b \x01(\x05R\rconfirmations\x12\x10\n" +
+	"\x03fee\x18\t \x01(\x01R\x03fee\"J\n" +
+	"\x1aGetChainTransactionRequest\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\"\xa3\x01\n" +
+	"\x18SubmitFiatDepositRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04rail\x18\x04 \x01(\tR\x04rail\x12!\n" +
+	"\fbank_account\x18\x05 \x01(\tR\vbankAccount\"J\n" +
+	"\x13FiatPaymentResponse\x123\n" +
+	"\apayment\x18\x01 \x01(\v2\x19.custodian.v1.FiatPaymentR\apayment\"6\n" +
+	"\x15GetFiatPaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\"2\n" +
+	"\x15GetWalletTiersRequest\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\tR\aassetId\"\xb7\x02\n" +
+	"\x12RebalanceOperation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x1b\n" +
+	"\tfrom_tier\x18\x03 \x01(\tR\bfromTier\x12\x17\n" +
+	"\ato_tier\x18\x04 \x01(\tR\x06toTier\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompletes_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletesAt\"\xe2\x02\n" +
+	"\x16GetWalletTiersResponse\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\tR\aassetId\x12\x10\n" +
+	"\x03hot\x18\x02 \x01(\x01R\x03hot\x12\x12\n" +
+	"\x04warm\x18\x03 \x01(\x01R\x04warm\x12\x12\n" +
+	"\x04cold\x18\x04 \x01(\x01R\x04cold\x12\x1d\n" +
+	"\n" +
+	"target_hot\x18\x05 \x01(\x01R\ttargetHot\x12\x1f\n" +
+	"\vtarget_warm\x18\x06 \x01(\x01R\n" +
+	"targetWarm\x12\x1f\n" +
+	"\vtarget_cold\x18\a \x01(\x01R\n" +
+	"targetCold\x12\x1d\n" +
+	"\n" +
+	"in_transit\x18\b \x01(\x01R\tinTransit\x121\n" +
+	"\x14awaiting_withdrawals\x18\t \x01(\x05R\x13awaitingWithdrawals\x12@\n" +
+	"\n" +
+	"operations\x18\n" +
+	" \x03(\v2 .custodian.v1.RebalanceOperationR\n" +
+	"operations\"M\n" +
+	"\x11GetBalanceRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\"\x86\x01\n" +
+	"\x12GetBalanceResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x01R\abalance\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\x01R\tavailable\"V\n" +
+	"\x1aGetTransferHeadroomRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\"T\n" +
+	"\n" +
+	"LimitUsage\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x01R\x05limit\x12\x12\n" +
+	"\x04used\x18\x02 \x01(\x01R\x04used\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x01R\tremaining\"\xe4\x02\n" +
+	"\x1bGetTransferHeadroomResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12,\n" +
+	"\x0fper_transaction\x18\x03 \x01(\x01H\x00R\x0eperTransaction\x88\x01\x01\x12.\n" +
+	"\x05daily\x18\x04 \x01(\v2\x18.custodian.v1.LimitUsageR\x05daily\x122\n" +
+	"\arolling\x18\x05 \x01(\v2\x18.custodian.v1.LimitUsageR\arolling\x124\n" +
+	"\x16rolling_window_seconds\x18\x06 \x01(\x03R\x14rollingWindowSeconds\x12!\n" +
+	"\tremaining\x18\a \x01(\x01H\x01R\tremaining\x88\x01\x01B\x12\n" +
+	"\x10_per_transactionB\f\n" +
+	"\n" +
+	"_remaining\"\xeb\x02\n" +
+	"\x17SubmitSettlementRequest\x12!\n" +
+	"\ffrom_account\x18\x01 \x01(\tR\vfromAccount\x12\x1d\n" +
+	"\n" +
+	"to_account\x18\x02 \x01(\tR\ttoAccount\x12\x19\n" +
+	"\basset_id\x18\x03 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12+\n" +
+	"\x11from_counterparty\x18\x05 \x01(\tR\x10fromCounterparty\x12'\n" +
+	"\x0fto_counterparty\x18\x06 \x01(\tR\x0etoCounterparty\x12#\n" +
+	"\rallow_partial\x18\a \x01(\bR\fallowPartial\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\x12=\n" +
+	"\vtravel_rule\x18\t \x01(\v2\x1c.custodian.v1.TravelRuleDataR\n" +
+	"travelRule\"\xd4\x02\n" +
+	"\x18SubmitSettlementResponse\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12C\n" +
+	"\x0fsettlement_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0esettlementDate\x12\x1f\n" +
+	"\vapproval_id\x18\x04 \x01(\tR\n" +
+	"approvalId\x12\x1b\n" +
+	"\treview_id\x18\x05 \x01(\tR\breviewId\x12\x1f\n" +
+	"\vreason_code\x18\x06 \x01(\tR\n" +
+	"reasonCode\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12?\n" +
+	"\rfail_deadline\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\ffailDeadline\"\x9e\x05\n" +
+	"\n" +
+	"Settlement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\ffrom_account\x18\x02 \x01(\tR\vfromAccount\x12\x1d\n" +
+	"\n" +
+	"to_account\x18\x03 \x01(\tR\ttoAccount\x12\x19\n" +
+	"\basset_id\x18\x04 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12%\n" +
+	"\x0esettled_amount\x18\x06 \x01(\x01R\rsettledAmount\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1f\n" +
+	"\vreason_code\x18\b \x01(\tR\n" +
+	"reasonCode\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12C\n" +
+	"\x0fsettlement_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0esettlementDate\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12+\n" +
+	"\x11from_counterparty\x18\f \x01(\tR\x10fromCounterparty\x12'\n" +
+	"\x0fto_counterparty\x18\r \x01(\tR\x0etoCounterparty\x12\x19\n" +
+	"\btrade_id\x18\x0e \x01(\tR\atradeId\x12A\n" +
+	"\n" +
+	"amendments\x18\x0f \x03(\v2!.custodian.v1.SettlementAmendmentR\n" +
+	"amendments\x12!\n" +
+	"\finitiated_by\x18\x10 \x01(\tR\vinitiatedBy\x12\x1f\n" +
+	"\vapproval_id\x18\x11 \x01(\tR\n" +
+	"approvalId\x12\x1b\n" +
+	"\treview_id\x18\x12 \x01(\tR\breviewId\"\x95\x04\n" +
+	"\x13SettlementAmendment\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x05R\bsequence\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\x12\x1f\n" +
+	"\vresolved_by\x18\x05 \x01(\tR\n" +
+	"resolvedBy\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12'\n" +
+	"\x0fprevious_amount\x18\a \x01(\x01R\x0epreviousAmount\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12T\n" +
+	"\x18previous_settlement_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x16previousSettlementDate\x12C\n" +
+	"\x0fsettlement_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0esettlementDate\x12=\n" +
+	"\frequested_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12;\n" +
+	"\vresolved_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\";\n" +
+	"\x14GetSettlementRequest\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\"Q\n" +
+	"\x15GetSettlementResponse\x128\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x18.custodian.v1.SettlementR\n" +
+	"settlement\"\xe5\x01\n" +
+	"\x16AmendSettlementRequest\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\x01H\x00R\x06amount\x88\x01\x01\x12C\n" +
+	"\x0fsettlement_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0esettlementDateB\t\n" +
+	"\a_amount\"y\n" +
+	"\x17CancelSettlementRequest\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"f\n" +
+	"\x1eApproveSettlementChangeRequest\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12\x1f\n" +
+	"\vapproved_by\x18\x02 \x01(\tR\n" +
+	"approvedBy\"}\n" +
+	"\x1dRejectSettlementChangeRequest\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12\x1f\n" +
+	"\vrejected_by\x18\x02 \x01(\tR\n" +
+	"rejectedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"T\n" +
+	"\x18SettlementChangeResponse\x128\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x18.custodian.v1.SettlementR\n" +
+	"settlement\"\x8e\x01\n" +
+	"\x10ApprovalDecision\x12\x1a\n" +
+	"\bapprover\x18\x01 \x01(\tR\bapprover\x12\x1a\n" +
+	"\bapproved\x18\x02 \x01(\bR\bapproved\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12*\n" +
+	"\x02at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\x8d\x04\n" +
+	"\bApproval\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x05 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12!\n" +
+	"\frequested_by\x18\a \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06quorum\x18\b \x01(\x05R\x06quorum\x12\x1c\n" +
+	"\tapprovers\x18\t \x03(\tR\tapprovers\x12<\n" +
+	"\tdecisions\x18\n" +
+	" \x03(\v2\x1e.custodian.v1.ApprovalDecisionR\tdecisions\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\vresolved_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\".\n" +
+	"\x14ListApprovalsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"M\n" +
+	"\x15ListApprovalsResponse\x124\n" +
+	"\tapprovals\x18\x01 \x03(\v2\x16.custodian.v1.ApprovalR\tapprovals\"5\n" +
+	"\x12GetApprovalRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\"V\n" +
+	"\x17ApproveOperationRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\x12\x1a\n" +
+	"\bapprover\x18\x02 \x01(\tR\bapprover\"m\n" +
+	"\x16RejectOperationRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\x12\x1a\n" +
+	"\bapprover\x18\x02 \x01(\tR\bapprover\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"F\n" +
+	"\x10ApprovalResponse\x122\n" +
+	"\bapproval\x18\x01 \x01(\v2\x16.custodian.v1.ApprovalR\bapproval\"\x8f\x03\n" +
+	"\x10ComplianceReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\tR\taccountId\x12\x19\n" +
+	"\basset_id\x18\x05 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x18\n" +
+	"\areasons\x18\a \x03(\tR\areasons\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1a\n" +
+	"\breviewer\x18\t \x01(\tR\breviewer\x12\x14\n" +
+	"\x05notes\x18\n" +
+	" \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vresolved_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"6\n" +
+	"\x1cListComplianceReviewsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"Y\n" +
+	"\x1dListComplianceReviewsResponse\x128\n" +
+	"\areviews\x18\x01 \x03(\v2\x1e.custodian.v1.ComplianceReviewR\areviews\"9\n" +
+	"\x1aGetComplianceReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\"o\n" +
+	"\x1eResolveComplianceReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x1a\n" +
+	"\breviewer\x18\x02 \x01(\tR\breviewer\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"R\n" +
+	"\x18ComplianceReviewResponse\x126\n" +
+	"\x06review\x18\x01 \x01(\v2\x1e.custodian.v1.ComplianceReviewR\x06review\"\xc4\x04\n" +
+	"\x13MatchingInstruction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04side\x18\x02 \x01(\tR\x04side\x12'\n" +
+	"\x0ftrade_reference\x18\x03 \x01(\tR\x0etradeReference\x12!\n" +
+	"\ffrom_account\x18\x04 \x01(\tR\vfromAccount\x12\x1d\n" +
+	"\n" +
+	"to_account\x18\x05 \x01(\tR\ttoAccount\x12\x19\n" +
+	"\basset_id\x18\x06 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12C\n" +
+	"\x0fsettlement_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0esettlementDate\x12!\n" +
+	"\fsubmitted_by\x18\t \x01(\tR\vsubmittedBy\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12!\n" +
+	"\fmatched_with\x18\v \x01(\tR\vmatchedWith\x12#\n" +
+	"\rsettlement_id\x18\f \x01(\tR\fsettlementId\x12)\n" +
+	"\x10mismatch_reasons\x18\r \x03(\tR\x0fmismatchReasons\x12=\n" +
+	"\fsubmitted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x129\n" +
+	"\n" +
+	"matched_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tmatchedAt\"\xe3\x02\n" +
+	" SubmitMatchingInstructionRequest\x12%\n" +
+	"\x0einstruction_id\x18\x01 \x01(\tR\rinstructionId\x12\x12\n" +
+	"\x04side\x18\x02 \x01(\tR\x04side\x12'\n" +
+	"\x0ftrade_reference\x18\x03 \x01(\tR\x0etradeReference\x12!\n" +
+	"\ffrom_account\x18\x04 \x01(\tR\vfromAccount\x12\x1d\n" +
+	"\n" +
+	"to_account\x18\x05 \x01(\tR\ttoAccount\x12\x19\n" +
+	"\basset_id\x18\x06 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12C\n" +
+	"\x0fsettlement_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0esettlementDate\x12!\n" +
+	"\fsubmitted_by\x18\t \x01(\tR\vsubmittedBy\"h\n" +
+	"!SubmitMatchingInstructionResponse\x12C\n" +
+	"\vinstruction\x18\x01 \x01(\v2!.custodian.v1.MatchingInstructionR\vinstruction\"F\n" +
+	"\x1dGetMatchingInstructionRequest\x12%\n" +
+	"\x0einstruction_id\x18\x01 \x01(\tR\rinstructionId\"e\n" +
+	"\x1eGetMatchingInstructionResponse\x12C\n" +
+	"\vinstruction\x18\x01 \x01(\v2!.custodian.v1.MatchingInstructionR\vinstruction\"\x1a\n" +
+	"\x18GetMismatchReportRequest\"\x9b\x01\n" +
+	"\x14UnmatchedInstruction\x12C\n" +
+	"\vinstruction\x18\x01 \x01(\v2!.custodian.v1.MatchingInstructionR\vinstruction\x12\x1f\n" +
+	"\vage_seconds\x18\x02 \x01(\x03R\n" +
+	"ageSeconds\x12\x1d\n" +
+	"\n" +
+	"age_bucket\x18\x03 \x01(\tR\tageBucket\"\xb6\x02\n" +
+	"\x19GetMismatchReportResponse\x12=\n" +
+	"\fgenerated_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12@\n" +
+	"\tunmatched\x18\x02 \x03(\v2\".custodian.v1.UnmatchedInstructionR\tunmatched\x12Y\n" +
+	"\fcount_by_age\x18\x03 \x03(\v27.custodian.v1.GetMismatchReportResponse.CountByAgeEntryR\n" +
+	"countByAge\x1a=\n" +
+	"\x0fCountByAgeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"+\n" +
+	"\x15GetFailsReportRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\xfd\x03\n" +
+	"\x0eSettlementFail\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12!\n" +
+	"\ffrom_account\x18\x02 \x01(\tR\vfromAccount\x12\x1d\n" +
+	"\n" +
+	"to_account\x18\x03 \x01(\tR\ttoAccount\x12\x19\n" +
+	"\basset_id\x18\x04 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12%\n" +
+	"\x0esettled_amount\x18\x06 \x01(\x01R\rsettledAmount\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1f\n" +
+	"\vreason_code\x18\b \x01(\tR\n" +
+	"reasonCode\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12\x1a\n" +
+	"\battempts\x18\n" +
+	" \x01(\x05R\battempts\x12C\n" +
+	"\x0fsettlement_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0esettlementDate\x127\n" +
+	"\tfailed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\x12?\n" +
+	"\rfail_deadline\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\ffailDeadline\"\xb2\x03\n" +
+	"\x16GetFailsReportResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x122\n" +
+	"\x05fails\x18\x02 \x03(\v2\x1c.custodian.v1.SettlementFailR\x05fails\x12_\n" +
+	"\x0fcount_by_reason\x18\x03 \x03(\v27.custodian.v1.GetFailsReportResponse.CountByReasonEntryR\rcountByReason\x12h\n" +
+	"\x12unsettled_by_asset\x18\x04 \x03(\v2:.custodian.v1.GetFailsReportResponse.UnsettledByAssetEntryR\x10unsettledByAsset\x1a@\n" +
+	"\x12CountByReasonEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aC\n" +
+	"\x15UnsettledByAssetEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x82\x03\n" +
+	"\x1dStandingSettlementInstruction\x12\"\n" +
+	"\fcounterparty\x18\x01 \x01(\tR\fcounterparty\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x122\n" +
+	"\x15settlement_cycle_days\x18\x05 \x01(\x05R\x13settlementCycleDays\x12\x17\n" +
+	"\acut_off\x18\x06 \x01(\tR\x06cutOff\x12\x18\n" +
+	"\aversion\x18\a \x01(\x05R\aversion\x12A\n" +
+	"\x0eeffective_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12?\n" +
+	"\rsuperseded_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fsupersededAt\"\x99\x02\n" +
+	"'PutStandingSettlementInstructionRequest\x12\"\n" +
+	"\fcounterparty\x18\x01 \x01(\tR\fcounterparty\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x122\n" +
+	"\x15settlement_cycle_days\x18\x05 \x01(\x05R\x13settlementCycleDays\x12\x17\n" +
+	"\acut_off\x18\x06 \x01(\tR\x06cutOff\x12)\n" +
+	"\x10expected_version\x18\a \x01(\x05R\x0fexpectedVersion\"i\n" +
+	"(PutStandingSettlementInstructionResponse\x12=\n" +
+	"\x03ssi\x18\x01 \x01(\v2+.custodian.v1.StandingSettlementInstructionR\x03ssi\"\x91\x01\n" +
+	"'GetStandingSettlementInstructionRequest\x12\"\n" +
+	"\fcounterparty\x18\x01 \x01(\tR\fcounterparty\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12'\n" +
+	"\x0finclude_history\x18\x03 \x01(\bR\x0eincludeHistory\"\xb0\x01\n" +
+	"(GetStandingSettlementInstructionResponse\x12=\n" +
+	"\x03ssi\x18\x01 \x01(\v2+.custodian.v1.StandingSettlementInstructionR\x03ssi\x12E\n" +
+	"\ahistory\x18\x02 \x03(\v2+.custodian.v1.StandingSettlementInstructionR\ahistory\"O\n" +
+	")ListStandingSettlementInstructionsRequest\x12\"\n" +
+	"\fcounterparty\x18\x01 \x01(\tR\fcounterparty\"m\n" +
+	"*ListStandingSettlementInstructionsResponse\x12?\n" +
+	"\x04ssis\x18\x01 \x03(\v2+.custodian.v1.StandingSettlementInstructionR\x04ssis\"\x93\x01\n" +
+	"\x1dSubscribeAccountEventsRequest\x12\x1f\n" +
+	"\vaccount_ids\x18\x01 \x03(\tR\n" +
+	"accountIds\x127\n" +
+	"\x15resume_after_sequence\x18\x02 \x01(\x04H\x00R\x13resumeAfterSequence\x88\x01\x01B\x18\n" +
+	"\x16_resume_after_sequence\"\xf8\x03\n" +
+	"\fAccountEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x122\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.custodian.v1.AccountEventTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12D\n" +
+	"\x0ebalance_change\x18\n" +
+	" \x01(\v2\x1b.custodian.v1.BalanceChangeH\x00R\rbalanceChange\x12Y\n" +
+	"\x15settlement_transition\x18\v \x01(\v2\".custodian.v1.SettlementTransitionH\x00R\x14settlementTransition\x12;\n" +
+	"\vhold_change\x18\f \x01(\v2\x18.custodian.v1.HoldChangeH\x00R\n" +
+	"holdChange\x12W\n" +
+	"\x15account_status_change\x18\r \x01(\v2!.custodian.v1.AccountStatusChangeH\x00R\x13accountStatusChangeB\b\n" +
+	"\x06detail\"\x7f\n" +
+	"\rBalanceChange\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\tR\aassetId\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x01R\x05delta\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x01R\abalance\x12#\n" +
+	"\rsettlement_id\x18\x04 \x01(\tR\fsettlementId\"k\n" +
+	"\x14SettlementTransition\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"X\n" +
+	"\n" +
+	"HoldChange\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\"V\n" +
+	"\x13AccountStatusChange\x12'\n" +
+	"\x0fprevious_status\x18\x01 \x01(\tR\x0epreviousStatus\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status*\x89\x02\n" +
+	"\x10AccountEventType\x12\"\n" +
+	"\x1eACCOUNT_EVENT_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"ACCOUNT_EVENT_TYPE_BALANCE_CHANGED\x10\x01\x120\n" +
+	",ACCOUNT_EVENT_TYPE_SETTLEMENT_STATUS_CHANGED\x10\x02\x12\"\n" +
+	"\x1eACCOUNT_EVENT_TYPE_HOLD_PLACED\x10\x03\x12$\n" +
+	" ACCOUNT_EVENT_TYPE_HOLD_RELEASED\x10\x04\x12-\n" +
+	")ACCOUNT_EVENT_TYPE_ACCOUNT_STATUS_CHANGED\x10\x052\x96,\n" +
+	"\x10CustodianService\x12u\n" +
+	"\rCreateAccount\x12\".custodian.v1.CreateAccountRequest\x1a#.custodian.v1.CreateAccountResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/accounts\x12y\n" +
+	"\aDeposit\x12\x1c.custodian.v1.DepositRequest\x1a\x1d.custodian.v1.DepositResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/accounts/{account_id}/deposits\x12\x7f\n" +
+	"\bWithdraw\x12\x1d.custodian.v1.WithdrawRequest\x1a\x1e.custodian.v1.WithdrawResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/accounts/{account_id}/withdrawals\x12\xa9\x01\n" +
+	"\x14AddWithdrawalAddress\x12).custodian.v1.AddWithdrawalAddressRequest\x1a'.custodian.v1.WithdrawalAddressResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/accounts/{account_id}/withdrawal-addresses\x12\xb2\x01\n" +
+	"\x17ListWithdrawalAddresses\x12,.custodian.v1.ListWithdrawalAddressesRequest\x1a-.custodian.v1.ListWithdrawalAddressesResponse\":\x82\xd3\xe4\x93\x024\x122/api/v1/accounts/{account_id}/withdrawal-addresses\x12\xb9\x01\n" +
+	"\x17RemoveWithdrawalAddress\x12,.custodian.v1.RemoveWithdrawalAddressRequest\x1a'.custodian.v1.WithdrawalAddressResponse\"G\x82\xd3\xe4\x93\x02A*?/api/v1/accounts/{account_id}/withdrawal-addresses/{address_id}\x12\x85\x01\n" +
+	"\rGetWithdrawal\x12\".custodian.v1.GetWithdrawalRequest\x1a#.custodian.v1.GetWithdrawalResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/withdrawals/{withdrawal_id}\x12\x9e\x01\n" +
+	"\x12SubmitChainDeposit\x12'.custodian.v1.SubmitChainDepositRequest\x1a&.custodian.v1.ChainTransactionResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/accounts/{account_id}/chain-deposits\x12}\n" +
+	"\x0eGetChainStatus\x12#.custodian.v1.GetChainStatusRequest\x1a$.custodian.v1.GetChainStatusResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/chains/{network}\x12\x9d\x01\n" +
+	"\x13GetChainTransaction\x12(.custodian.v1.GetChainTransactionRequest\x1a&.custodian.v1.ChainTransactionResponse\"4\x82\xd3\xe4\x93\x02.\x12,/api/v1/chains/{network}/transactions/{hash}\x12\x96\x01\n" +
+	"\x11SubmitFiatDeposit\x12&.custodian.v1.SubmitFiatDepositRequest\x1a!.custodian.v1.FiatPaymentResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/accounts/{account_id}/fiat-deposits\x12\x84\x01\n" +
+	"\x0eGetFiatPayment\x12#.custodian.v1.GetFiatPaymentRequest\x1a!.custodian.v1.FiatPaymentResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/fiat-payments/{payment_id}\x12\x84\x01\n" +
+	"\x0eGetWalletTiers\x12#.custodian.v1.GetWalletTiersRequest\x1a$.custodian.v1.GetWalletTiersResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/wallet-tiers/{asset_id}\x12\x8a\x01\n" +
+	"\n" +
+	"GetBalance\x12\x1f.custodian.v1.GetBalanceRequest\x1a .custodian.v1.GetBalanceResponse\"9\x82\xd3\xe4\x93\x023\x121/api/v1/accounts/{account_id}/balances/{asset_id}\x12\xa3\x01\n" +
+	"\x13GetTransferHeadroom\x12(.custodian.v1.GetTransferHeadroomRequest\x1a).custodian.v1.GetTransferHeadroomResponse\"7\x82\xd3\xe4\x93\x021\x12//api/v1/accounts/{account_id}/limits/{asset_id}\x12\x81\x01\n" +
+	"\x10SubmitSettlement\x12%.custodian.v1.SubmitSettlementRequest\x1a&.custodian.v1.SubmitSettlementResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/settlements\x12\x85\x01\n" +
+	"\rGetSettlement\x12\".custodian.v1.GetSettlementRequest\x1a#.custodian.v1.GetSettlementResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/settlements/{settlement_id}\x12\x95\x01\n" +
+	"\x0fAmendSettlement\x12$.custodian.v1.AmendSettlementRequest\x1a&.custodian.v1.SettlementChangeResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/settlements/{settlement_id}/amend\x12\x98\x01\n" +
+	"\x10CancelSettlement\x12%.custodian.v1.CancelSettlementRequest\x1a&.custodian.v1.SettlementChangeResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/settlements/{settlement_id}/cancel\x12\xae\x01\n" +
+	"\x17ApproveSettlementChange\x12,.custodian.v1.ApproveSettlementChangeRequest\x1a&.custodian.v1.SettlementChangeResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/settlements/{settlement_id}/change/approve\x12\xab\x01\n" +
+	"\x16RejectSettlementChange\x12+.custodian.v1.RejectSettlementChangeRequest\x1a&.custodian.v1.SettlementChangeResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/settlements/{settlement_id}/change/reject\x12s\n" +
+	"\rListApprovals\x12\".custodian.v1.ListApprovalsRequest\x1a#.custodian.v1.ListApprovalsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/approvals\x12x\n" +
+	"\vGetApproval\x12 .custodian.v1.GetApprovalRequest\x1a\x1e.custodian.v1.ApprovalResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/approvals/{approval_id}\x12\x8d\x01\n" +
+	"\x10ApproveOperation\x12%.custodian.v1.ApproveOperationRequest\x1a\x1e.custodian.v1.ApprovalResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/approvals/{approval_id}/approve\x12\x8a\x01\n" +
+	"\x0fRejectOperation\x12$.custodian.v1.RejectOperationRequest\x1a\x1e.custodian.v1.ApprovalResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/approvals/{approval_id}/reject\x12\x94\x01\n" +
+	"\x15ListComplianceReviews\x12*.custodian.v1.ListComplianceReviewsRequest\x1a+.custodian.v1.ListComplianceReviewsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/compliance/reviews\x12\x97\x01\n" +
+	"\x13GetComplianceReview\x12(.custodian.v1.GetComplianceReviewRequest\x1a&.custodian.v1.ComplianceReviewResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/compliance/reviews/{review_id}\x12\xaa\x01\n" +
+	"\x17ReleaseComplianceReview\x12,.custodian.v1.ResolveComplianceReviewRequest\x1a&.custodian.v1.ComplianceReviewResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/compliance/reviews/{review_id}/release\x12\xa8\x01\n" +
+	"\x16RejectComplianceReview\x12,.custodian.v1.ResolveComplianceReviewRequest\x1a&.custodian.v1.ComplianceReviewResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/compliance/reviews/{review_id}/reject\x12\xa6\x01\n" +
+	"\x19SubmitMatchingInstruction\x12..custodian.v1.SubmitMatchingInstructionRequest\x1a/.custodian.v1.SubmitMatchingInstructionResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/matching-instructions\x12\xab\x01\n" +
+	"\x16GetMatchingInstruction\x12+.custodian.v1.GetMatchingInstructionRequest\x1a,.custodian.v1.GetMatchingInstructionResponse\"6\x82\xd3\xe4\x93\x020\x12./api/v1/matching-instructions/{instruction_id}\x12\x88\x01\n" +
+	"\x11GetMismatchReport\x12&.custodian.v1.GetMismatchReportRequest\x1a'.custodian.v1.GetMismatchReportResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/reports/mismatches\x12z\n" +
+	"\x0eGetFailsReport\x12#.custodian.v1.GetFailsReportRequest\x1a$.custodian.v1.GetFailsReportResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/reports/fails\x12\xc4\x01\n" +
+	" PutStandingSettlementInstruction\x125.custodian.v1.PutStandingSettlementInstructionRequest\x1a6.custodian.v1.PutStandingSettlementInstructionResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/api/v1/ssis/{counterparty}/{asset_id}\x12\xc1\x01\n" +
+	" GetStandingSettlementInstruction\x125.custodian.v1.GetStandingSettlementInstructionRequest\x1a6.custodian.v1.GetStandingSettlementInstructionResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/ssis/{counterparty}/{asset_id}\x12\xad\x01\n" +
+	"\"ListStandingSettlementInstructions\x127.custodian.v1.ListStandingSettlementInstructionsRequest\x1a8.custodian.v1.ListStandingSettlementInstructionsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/ssis\x12\x83\x01\n" +
+	"\x16SubscribeAccountEvents\x12+.custodian.v1.SubscribeAccountEventsRequest\x1a\x1a.custodian.v1.AccountEvent\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/account-events0\x01BaZ_github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/custodian/v1;custodianv1b\x06proto3"
+
+var (
+	file_custodian_v1_custodian_proto_rawDescOnce sync.Once
+	file_custodian_v1_custodian_proto_rawDescData []byte
+)
+
+func file_custodian_v1_custodian_proto_rawDescGZIP() []byte {
+	file_custodian_v1_custodian_proto_rawDescOnce.Do(func() {
+		file_custodian_v1_custodian_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_custodian_v1_custodian_proto_rawDesc), len(file_custodian_v1_custodian_proto_rawDesc)))
+	})
+	return file_custodian_v1_custodian_proto_rawDescData
+}
+
+var file_custodian_v1_custodian_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_custodian_v1_custodian_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
+var file_custodian_v1_custodian_proto_goTypes = []any{
+	(AccountEventType)(0),                              // 0: custodian.v1.AccountEventType
+	(*Account)(nil),                                    // 1: custodian.v1.Account
+	(*CreateAccountRequest)(nil),                       // 2: custodian.v1.CreateAccountRequest
+	(*CreateAccountResponse)(nil),                      // 3: custodian.v1.CreateAccountResponse
+	(*DepositRequest)(nil),                             // 4: custodian.v1.DepositRequest
+	(*DepositResponse)(nil),                            // 5: custodian.v1.DepositResponse
+	(*Withdrawal)(nil),                                 // 6: custodian.v1.Withdrawal
+	(*FiatPayment)(nil),                                // 7: custodian.v1.FiatPayment
+	(*ChainTransaction)(nil),                           // 8: custodian.v1.ChainTransaction
+	(*TravelRuleData)(nil),                             // 9: custodian.v1.TravelRuleData
+	(*WithdrawRequest)(nil),                            // 10: custodian.v1.WithdrawRequest
+	(*WithdrawResponse)(nil),                           // 11: custodian.v1.WithdrawResponse
+	(*WithdrawalAddress)(nil),                          // 12: custodian.v1.WithdrawalAddress
+	(*AddWithdrawalAddressRequest)(nil),                // 13: custodian.v1.AddWithdrawalAddressRequest
+	(*ListWithdrawalAddressesRequest)(nil),             // 14: custodian.v1.ListWithdrawalAddressesRequest
+	(*ListWithdrawalAddressesResponse)(nil),            // 15: custodian.v1.ListWithdrawalAddressesResponse
+	(*RemoveWithdrawalAddressRequest)(nil),             // 16: custodian.v1.RemoveWithdrawalAddressRequest
+	(*WithdrawalAddressResponse)(nil),                  // 17: custodian.v1.WithdrawalAddressResponse
+	(*GetWithdrawalRequest)(nil),                       // 18: custodian.v1.GetWithdrawalRequest
+	(*GetWithdrawalResponse)(nil),                      // 19: custodian.v1.GetWithdrawalResponse
+	(*SubmitChainDepositRequest)(nil),                  // 20: custodian.v1.SubmitChainDepositRequest
+	(*ChainTransactionResponse)(nil),                   // 21: custodian.v1.ChainTransactionResponse
+	(*GetChainStatusRequest)(nil),                      // 22: custodian.v1.GetChainStatusRequest
+	(*GetChainStatusResponse)(nil),                     // 23: custodian.v1.GetChainStatusResponse
+	(*GetChainTransactionRequest)(nil),                 // 24: custodian.v1.GetChainTransactionRequest
+	(*SubmitFiatDepositRequest)(nil),                   // 25: custodian.v1.SubmitFiatDepositRequest
+	(*FiatPaymentResponse)(nil),                        // 26: custodian.v1.FiatPaymentResponse
+	(*GetFiatPaymentRequest)(nil),                      // 27: custodian.v1.GetFiatPaymentRequest
+	(*GetWalletTiersRequest)(nil),                      // 28: custodian.v1.GetWalletTiersRequest
+	(*RebalanceOperation)(nil),                         // 29: custodian.v1.RebalanceOperation
+	(*GetWalletTiersResponse)(nil),                     // 30: custodian.v1.GetWalletTiersResponse
+	(*GetBalanceRequest)(nil),                          // 31: custodian.v1.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                         // 32: custodian.v1.GetBalanceResponse
+	(*GetTransferHeadroomRequest)(nil),                 // 33: custodian.v1.GetTransferHeadroomRequest
+	(*LimitUsage)(nil),                                 // 34: custodian.v1.LimitUsage
+	(*GetTransferHeadroomResponse)(nil),                // 35: custodian.v1.GetTransferHeadroomResponse
+	(*SubmitSettlementRequest)(nil),                    // 36: custodian.v1.SubmitSettlementRequest
+	(*SubmitSettlementResponse)(nil),                   // 37: custodian.v1.SubmitSettlementResponse
+	(*Settlement)(nil),                                 // 38: custodian.v1.Settlement
+	(*SettlementAmendment)(nil),                        // 39: custodian.v1.SettlementAmendment
+	(*GetSettlementRequest)(nil),                       // 40: custodian.v1.GetSettlementRequest
+	(*GetSettlementResponse)(nil),                      // 41: custodian.v1.GetSettlementResponse
+	(*AmendSettlementRequest)(nil),                     // 42: custodian.v1.AmendSettlementRequest
+	(*CancelSettlementRequest)(nil),                    // 43: custodian.v1.CancelSettlementRequest
+	(*ApproveSettlementChangeRequest)(nil),             // 44: custodian.v1.ApproveSettlementChangeRequest
+	(*RejectSettlementChangeRequest)(nil),              // 45: custodian.v1.RejectSettlementChangeRequest
+	(*SettlementChangeResponse)(nil),                   // 46: custodian.v1.SettlementChangeResponse
+	(*ApprovalDecision)(nil),                           // 47: custodian.v1.ApprovalDecision
+	(*Approval)(nil),                                   // 48: custodian.v1.Approval
+	(*ListApprovalsRequest)(nil),                       // 49: custodian.v1.ListApprovalsRequest
+	(*ListApprovalsResponse)(nil),                      // 50: custodian.v1.ListApprovalsResponse
+	(*GetApprovalRequest)(nil),                         // 51: custodian.v1.GetApprovalRequest
+	(*ApproveOperationRequest)(nil),                    // 52: custodian.v1.ApproveOperationRequest
+	(*RejectOperationRequest)(nil),                     // 53: custodian.v1.RejectOperationRequest
+	(*ApprovalResponse)(nil),                           // 54: custodian.v1.ApprovalResponse
+	(*ComplianceReview)(nil),                           // 55: custodian.v1.ComplianceReview
+	(*ListComplianceReviewsRequest)(nil),               // 56: custodian.v1.ListComplianceReviewsRequest
+	(*ListComplianceReviewsResponse)(nil),              // 57: custodian.v1.ListComplianceReviewsResponse
+	(*GetComplianceReviewRequest)(nil),                 // 58: custodian.v1.GetComplianceReviewRequest
+	(*ResolveComplianceReviewRequest)(nil),             // 59: custodian.v1.ResolveComplianceReviewRequest
+	(*ComplianceReviewResponse)(nil),                   // 60: custodian.v1.ComplianceReviewResponse
+	(*MatchingInstruction)(nil),                        // 61: custodian.v1.MatchingInstruction
+	(*SubmitMatchingInstructionRequest)(nil),           // 62: custodian.v1.SubmitMatchingInstructionRequest
+	(*SubmitMatchingInstructionResponse)(nil),          // 63: custodian.v1.SubmitMatchingInstructionResponse
+	(*GetMatchingInstructionRequest)(nil),              // 64: custodian.v1.GetMatchingInstructionRequest
+	(*GetMatchingInstructionResponse)(nil),             // 65: custodian.v1.GetMatchingInstructionResponse
+	(*GetMismatchReportRequest)(nil),                   // 66: custodian.v1.GetMismatchReportRequest
+	(*UnmatchedInstruction)(nil),                       // 67: custodian.v1.UnmatchedInstruction
+	(*GetMismatchReportResponse)(nil),                  // 68: custodian.v1.GetMismatchReportResponse
+	(*GetFailsReportRequest)(nil),                      // 69: custodian.v1.GetFailsReportRequest
+	(*SettlementFail)(nil),                             // 70: custodian.v1.SettlementFail
+	(*GetFailsReportResponse)(nil),                     // 71: custodian.v1.GetFailsReportResponse
+	(*StandingSettlementInstruction)(nil),              // 72: custodian.v1.StandingSettlementInstruction
+	(*PutStandingSettlementInstructionRequest)(nil),    // 73: custodian.v1.PutStandingSettlementInstructionRequest
+	(*PutStandingSettlementInstructionResponse)(nil),   // 74: custodian.v1.PutStandingSettlementInstructionResponse
+	(*GetStandingSettlementInstructionRequest)(nil),    // 75: custodian.v1.GetStandingSettlementInstructionRequest
+	(*GetStandingSettlementInstructionResponse)(nil),   // 76: custodian.v1.GetStandingSettlementInstructionResponse
+	(*ListStandingSettlementInstructionsRequest)(nil),  // 77: custodian.v1.ListStandingSettlementInstructionsRequest
+	(*ListStandingSettlementInstructionsResponse)(nil), // 78: custodian.v1.ListStandingSettlementInstructionsResponse
+	(*SubscribeAccountEventsRequest)(nil),              // 79: custodian.v1.SubscribeAccountEventsRequest
+	(*AccountEvent)(nil),                               // 80: custodian.v1.AccountEvent
+	(*BalanceChange)(nil),                              // 81: custodian.v1.BalanceChange
+	(*SettlementTransition)(nil),                       // 82: custodian.v1.SettlementTransition
+	(*HoldChange)(nil),                                 // 83: custodian.v1.HoldChange
+	(*AccountStatusChange)(nil),                        // 84: custodian.v1.AccountStatusChange
+	nil,                                                // 85: custodian.v1.GetMismatchReportResponse.CountByAgeEntry
+	nil,                                                // 86: custodian.v1.GetFailsReportResponse.CountByReasonEntry
+	nil,                                                // 87: custodian.v1.GetFailsReportResponse.UnsettledByAssetEntry
+	(*timestamppb.Timestamp)(nil),                      // 88: google.protobuf.Timestamp
+}
+var file_custodian_v1_custodian_proto_depIdxs = []int32{
+	88,  // 0: custodian.v1.Account.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 1: custodian.v1.Account.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 2: custodian.v1.CreateAccountResponse.account:type_name -> custodian.v1.Account
+	88,  // 3: custodian.v1.Withdrawal.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 4: custodian.v1.Withdrawal.completed_at:type_name -> google.protobuf.Timestamp
+	88,  // 5: custodian.v1.FiatPayment.submitted_at:type_name -> google.protobuf.Timestamp
+	88,  // 6: custodian.v1.FiatPayment.batch_at:type_name -> google.protobuf.Timestamp
+	88,  // 7: custodian.v1.FiatPayment.settles_at:type_name -> google.protobuf.Timestamp
+	88,  // 8: custodian.v1.FiatPayment.completed_at:type_name -> google.protobuf.Timestamp
+	88,  // 9: custodian.v1.ChainTransaction.broadcast_at:type_name -> google.protobuf.Timestamp
+	88,  // 10: custodian.v1.ChainTransaction.confirmed_at:type_name -> google.protobuf.Timestamp
+	9,   // 11: custodian.v1.WithdrawRequest.travel_rule:type_name -> custodian.v1.TravelRuleData
+	6,   // 12: custodian.v1.WithdrawResponse.withdrawal:type_name -> custodian.v1.Withdrawal
+	88,  // 13: custodian.v1.WithdrawalAddress.added_at:type_name -> google.protobuf.Timestamp
+	88,  // 14: custodian.v1.WithdrawalAddress.active_from:type_name -> google.protobuf.Timestamp
+	88,  // 15: custodian.v1.WithdrawalAddress.removed_at:type_name -> google.protobuf.Timestamp
+	12,  // 16: custodian.v1.ListWithdrawalAddressesResponse.addresses:type_name -> custodian.v1.WithdrawalAddress
+	12,  // 17: custodian.v1.WithdrawalAddressResponse.address:type_name -> custodian.v1.WithdrawalAddress
+	6,   // 18: custodian.v1.GetWithdrawalResponse.withdrawal:type_name -> custodian.v1.Withdrawal
+	8,   // 19: custodian.v1.ChainTransactionResponse.transaction:type_name -> custodian.v1.ChainTransaction
+	88,  // 20: custodian.v1.GetChainStatusResponse.tip_mined_at:type_name -> google.protobuf.Timestamp
+	88,  // 21: custodian.v1.GetChainStatusResponse.next_block_at:type_name -> google.protobuf.Timestamp
+	7,   // 22: custodian.v1.FiatPaymentResponse.payment:type_name -> custodian.v1.FiatPayment
+	88,  // 23: custodian.v1.RebalanceOperation.started_at:type_name -> google.protobuf.Timestamp
+	88,  // 24: custodian.v1.RebalanceOperation.completes_at:type_name -> google.protobuf.Timestamp
+	29,  // 25: custodian.v1.GetWalletTiersResponse.operations:type_name -> custodian.v1.RebalanceOperation
+	34,  // 26: custodian.v1.GetTransferHeadroomResponse.daily:type_name -> custodian.v1.LimitUsage
+	34,  // 27: custodian.v1.GetTransferHeadroomResponse.rolling:type_name -> custodian.v1.LimitUsage
+	9,   // 28: custodian.v1.SubmitSettlementRequest.travel_rule:type_name -> custodian.v1.TravelRuleData
+	88,  // 29: custodian.v1.SubmitSettlementResponse.settlement_date:type_name -> google.protobuf.Timestamp
+	88,  // 30: custodian.v1.SubmitSettlementResponse.fail_deadline:type_name -> google.protobuf.Timestamp
+	88,  // 31: custodian.v1.Settlement.settlement_date:type_name -> google.protobuf.Timestamp
+	88,  // 32: custodian.v1.Settlement.created_at:type_name -> google.protobuf.Timestamp
+	39,  // 33: custodian.v1.Settlement.amendments:type_name -> custodian.v1.SettlementAmendment
+	88,  // 34: custodian.v1.SettlementAmendment.previous_settlement_date:type_name -> google.protobuf.Timestamp
+	88,  // 35: custodian.v1.SettlementAmendment.settlement_date:type_name -> google.protobuf.Timestamp
+	88,  // 36: custodian.v1.SettlementAmendment.requested_at:type_name -> google.protobuf.Timestamp
+	88,  // 37: custodian.v1.SettlementAmendment.resolved_at:type_name -> google.protobuf.Timestamp
+	38,  // 38: custodian.v1.GetSettlementResponse.settlement:type_name -> custodian.v1.Settlement
+	88,  // 39: custodian.v1.AmendSettlementRequest.settlement_date:type_name -> google.protobuf.Timestamp
+	38,  // 40: custodian.v1.SettlementChangeResponse.settlement:type_name -> custodian.v1.Settlement
+	88,  // 41: custodian.v1.ApprovalDecision.at:type_name -> google.protobuf.Timestamp
+	47,  // 42: custodian.v1.Approval.decisions:type_name -> custodian.v1.ApprovalDecision
+	88,  // 43: custodian.v1.Approval.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 44: custodian.v1.Approval.expires_at:type_name -> google.protobuf.Timestamp
+	88,  // 45: custodian.v1.Approval.resolved_at:type_name -> google.protobuf.Timestamp
+	48,  // 46: custodian.v1.ListApprovalsResponse.approvals:type_name -> custodian.v1.Approval
+	48,  // 47: custodian.v1.ApprovalResponse.approval:type_name -> custodian.v1.Approval
+	88,  // 48: custodian.v1.ComplianceReview.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 49: custodian.v1.ComplianceReview.resolved_at:type_name -> google.protobuf.Timestamp
+	55,  // 50: custodian.v1.ListComplianceReviewsResponse.reviews:type_name -> custodian.v1.ComplianceReview
+	55,  // 51: custodian.v1.ComplianceReviewResponse.review:type_name -> custodian.v1.ComplianceReview
+	88,  // 52: custodian.v1.MatchingInstruction.settlement_date:type_name -> google.protobuf.Timestamp
+	88,  // 53: custodian.v1.MatchingInstruction.submitted_at:type_name -> google.protobuf.Timestamp
+	88,  // 54: custodian.v1.MatchingInstruction.matched_at:type_name -> google.protobuf.Timestamp
+	88,  // 55: custodian.v1.SubmitMatchingInstructionRequest.settlement_date:type_name -> google.protobuf.Timestamp
+	61,  // 56: custodian.v1.SubmitMatchingInstructionResponse.instruction:type_name -> custodian.v1.MatchingInstruction
+	61,  // 57: custodian.v1.GetMatchingInstructionResponse.instruction:type_name -> custodian.v1.MatchingInstruction
+	61,  // 58: custodian.v1.UnmatchedInstruction.instruction:type_name -> custodian.v1.MatchingInstruction
+	88,  // 59: custodian.v1.GetMismatchReportResponse.generated_at:type_name -> google.protobuf.Timestamp
+	67,  // 60: custodian.v1.GetMismatchReportResponse.unmatched:type_name -> custodian.v1.UnmatchedInstruction
+	85,  // 61: custodian.v1.GetMismatchReportResponse.count_by_age:type_name -> custodian.v1.GetMismatchReportResponse.CountByAgeEntry
+	88,  // 62: custodian.v1.SettlementFail.settlement_date:type_name -> google.protobuf.Timestamp
+	88,  // 63: custodian.v1.SettlementFail.failed_at:type_name -> google.protobuf.Timestamp
+	88,  // 64: custodian.v1.SettlementFail.fail_deadline:type_name -> google.protobuf.Timestamp
+	70,  // 65: custodian.v1.GetFailsReportResponse.fails:type_name -> custodian.v1.SettlementFail
+	86,  // 66: custodian.v1.GetFailsReportResponse.count_by_reason:type_name -> custodian.v1.GetFailsReportResponse.CountByReasonEntry
+	87,  // 67: custodian.v1.GetFailsReportResponse.unsettled_by_asset:type_name -> custodian.v1.GetFailsReportResponse.UnsettledByAssetEntry
+	88,  // 68: custodian.v1.StandingSettlementInstruction.effective_from:type_name -> google.protobuf.Timestamp
+	88,  // 69: custodian.v1.StandingSettlementInstruction.superseded_at:type_name -> google.protobuf.Timestamp
+	72,  // 70: custodian.v1.PutStandingSettlementInstructionResponse.ssi:type_name -> custodian.v1.StandingSettlementInstruction
+	72,  // 71: custodian.v1.GetStandingSettlementInstructionResponse.ssi:type_name -> custodian.v1.StandingSettlementInstruction
+	72,  // 72: custodian.v1.GetStandingSettlementInstructionResponse.history:type_name -> custodian.v1.StandingSettlementInstruction
+	72,  // 73: custodian.v1.ListStandingSettlementInstructionsResponse.ssis:type_name -> custodian.v1.StandingSettlementInstruction
+	0,   // 74: custodian.v1.AccountEvent.type:type_name -> custodian.v1.AccountEventType
+	88,  // 75: custodian.v1.AccountEvent.timestamp:type_name -> google.protobuf.Timestamp
+	81,  // 76: custodian.v1.AccountEvent.balance_change:type_name -> custodian.v1.BalanceChange
+	82,  // 77: custodian.v1.AccountEvent.settlement_transition:type_name -> custodian.v1.SettlementTransition
+	83,  // 78: custodian.v1.AccountEvent.hold_change:type_name -> custodian.v1.HoldChange
+	84,  // 79: custodian.v1.AccountEvent.account_status_change:type_name -> custodian.v1.AccountStatusChange
+	2,   // 80: custodian.v1.CustodianService.CreateAccount:input_type -> custodian.v1.CreateAccountRequest
+	4,   // 81: custodian.v1.CustodianService.Deposit:input_type -> custodian.v1.DepositRequest
+	10,  // 82: custodian.v1.CustodianService.Withdraw:input_type -> custodian.v1.WithdrawRequest
+	13,  // 83: custodian.v1.CustodianService.AddWithdrawalAddress:input_type -> custodian.v1.AddWithdrawalAddressRequest
+	14,  // 84: custodian.v1.CustodianService.ListWithdrawalAddresses:input_type -> custodian.v1.ListWithdrawalAddressesRequest
+	16,  // 85: custodian.v1.CustodianService.RemoveWithdrawalAddress:input_type -> custodian.v1.RemoveWithdrawalAddressRequest
+	18,  // 86: custodian.v1.CustodianService.GetWithdrawal:input_type -> custodian.v1.GetWithdrawalRequest
+	20,  // 87: custodian.v1.CustodianService.SubmitChainDeposit:input_type -> custodian.v1.SubmitChainDepositRequest
+	22,  // 88: custodian.v1.CustodianService.GetChainStatus:input_type -> custodian.v1.GetChainStatusRequest
+	24,  // 89: custodian.v1.CustodianService.GetChainTransaction:input_type -> custodian.v1.GetChainTransactionRequest
+	25,  // 90: custodian.v1.CustodianService.SubmitFiatDeposit:input_type -> custodian.v1.SubmitFiatDepositRequest
+	27,  // 91: custodian.v1.CustodianService.GetFiatPayment:input_type -> custodian.v1.GetFiatPaymentRequest
+	28,  // 92: custodian.v1.CustodianService.GetWalletTiers:input_type -> custodian.v1.GetWalletTiersRequest
+	31,  // 93: custodian.v1.CustodianService.GetBalance:input_type -> custodian.v1.GetBalanceRequest
+	33,  // 94: custodian.v1.CustodianService.GetTransferHeadroom:input_type -> custodian.v1.GetTransferHeadroomRequest
+	36,  // 95: custodian.v1.CustodianService.SubmitSettlement:input_type -> custodian.v1.SubmitSettlementRequest
+	40,  // 96: custodian.v1.CustodianService.GetSettlement:input_type -> custodian.v1.GetSettlementRequest
+	42,  // 97: custodian.v1.CustodianService.AmendSettlement:input_type -> custodian.v1.AmendSettlementRequest
+	43,  // 98: custodian.v1.CustodianService.CancelSettlement:input_type -> custodian.v1.CancelSettlementRequest
+	44,  // 99: custodian.v1.CustodianService.ApproveSettlementChange:input_type -> custodian.v1.ApproveSettlementChangeRequest
+	45,  // 100: custodian.v1.CustodianService.RejectSettlementChange:input_type -> custodian.v1.RejectSettlementChangeRequest
+	49,  // 101: custodian.v1.CustodianService.ListApprovals:input_type -> custodian.v1.ListApprovalsRequest
+	51,  // 102: custodian.v1.CustodianService.GetApproval:input_type -> custodian.v1.GetApprovalRequest
+	52,  // 103: custodian.v1.CustodianService.ApproveOperation:input_type -> custodian.v1.ApproveOperationRequest
+	53,  // 104: custodian.v1.CustodianService.RejectOperation:input_type -> custodian.v1.RejectOperationRequest
+	56,  // 105: custodian.v1.CustodianService.ListComplianceReviews:input_type -> custodian.v1.ListComplianceReviewsRequest
+	58,  // 106: custodian.v1.CustodianService.GetComplianceReview:input_type -> custodian.v1.GetComplianceReviewRequest
+	59,  // 107: custodian.v1.CustodianService.ReleaseComplianceReview:input_type -> custodian.v1.ResolveComplianceReviewRequest
+	59,  // 108: custodian.v1.CustodianService.RejectComplianceReview:input_type -> custodian.v1.ResolveComplianceReviewRequest
+	62,  // 109: custodian.v1.CustodianService.SubmitMatchingInstruction:input_type -> custodian.v1.SubmitMatchingInstructionRequest
+	64,  // 110: custodian.v1.CustodianService.GetMatchingInstruction:input_type -> custodian.v1.GetMatchingInstructionRequest
+	66,  // 111: custodian.v1.CustodianService.GetMismatchReport:input_type -> custodian.v1.GetMismatchReportRequest
+	69,  // 112: custodian.v1.CustodianService.GetFailsReport:input_type -> custodian.v1.GetFailsReportRequest
+	73,  // 113: custodian.v1.CustodianService.PutStandingSettlementInstruction:input_type -> custodian.v1.PutStandingSettlementInstructionRequest
+	75,  // 114: custodian.v1.CustodianService.GetStandingSettlementInstruction:input_type -> custodian.v1.GetStandingSettlementInstructionRequest
+	77,  // 115: custodian.v1.CustodianService.ListStandingSettlementInstructions:input_type -> custodian.v1.ListStandingSettlementInstructionsRequest
+	79,  // 116: custodian.v1.CustodianService.SubscribeAccountEvents:input_type -> custodian.v1.SubscribeAccountEventsRequest
+	3,   // 117: custodian.v1.CustodianService.CreateAccount:output_type -> custodian.v1.CreateAccountResponse
+	5,   // 118: custodian.v1.CustodianService.Deposit:output_type -> custodian.v1.DepositResponse
+	11,  // 119: custodian.v1.CustodianService.Withdraw:output_type -> custodian.v1.WithdrawResponse
+	17,  // 120: custodian.v1.CustodianService.AddWithdrawalAddress:output_type -> custodian.v1.WithdrawalAddressResponse
+	15,  // 121: custodian.v1.CustodianService.ListWithdrawalAddresses:output_type -> custodian.v1.ListWithdrawalAddressesResponse
+	17,  // 122: custodian.v1.CustodianService.RemoveWithdrawalAddress:output_type -> custodian.v1.WithdrawalAddressResponse
+	19,  // 123: custodian.v1.CustodianService.GetWithdrawal:output_type -> custodian.v1.GetWithdrawalResponse
+	21,  // 124: custodian.v1.CustodianService.SubmitChainDeposit:output_type -> custodian.v1.ChainTransactionResponse
+	23,  // 125: custodian.v1.CustodianService.GetChainStatus:output_type -> custodian.v1.GetChainStatusResponse
+	21,  // 126: custodian.v1.CustodianService.GetChainTransaction:output_type -> custodian.v1.ChainTransactionResponse
+	26,  // 127: custodian.v1.CustodianService.SubmitFiatDeposit:output_type -> custodian.v1.FiatPaymentResponse
+	26,  // 128: custodian.v1.CustodianService.GetFiatPayment:output_type -> custodian.v1.FiatPaymentResponse
+	30,  // 129: custodian.v1.CustodianService.GetWalletTiers:output_type -> custodian.v1.GetWalletTiersResponse
+	32,  // 130: custodian.v1.CustodianService.GetBalance:output_type -> custodian.v1.GetBalanceResponse
+	35,  // 131: custodian.v1.CustodianService.GetTransferHeadroom:output_type -> custodian.v1.GetTransferHeadroomResponse
+	37,  // 132: custodian.v1.CustodianService.SubmitSettlement:output_type -> custodian.v1.SubmitSettlementResponse
+	41,  // 133: custodian.v1.CustodianService.GetSettlement:output_type -> custodian.v1.GetSettlementResponse
+	46,  // 134: custodian.v1.CustodianService.AmendSettlement:output_type -> custodian.v1.SettlementChangeResponse
+	46,  // 135: custodian.v1.CustodianService.CancelSettlement:output_type -> custodian.v1.SettlementChangeResponse
+	46,  // 136: custodian.v1.CustodianService.ApproveSettlementChange:output_type -> custodian.v1.SettlementChangeResponse
+	46,  // 137: custodian.v1.CustodianService.RejectSettlementChange:output_type -> custodian.v1.SettlementChangeResponse
+	50,  // 138: custodian.v1.CustodianService.ListApprovals:output_type -> custodian.v1.ListApprovalsResponse
+	54,  // 139: custodian.v1.CustodianService.GetApproval:output_type -> custodian.v1.ApprovalResponse
+	54,  // 140: custodian.v1.CustodianService.ApproveOperation:output_type -> custodian.v1.ApprovalResponse
+	54,  // 141: custodian.v1.CustodianService.RejectOperation:output_type -> custodian.v1.ApprovalResponse
+	57,  // 142: custodian.v1.CustodianService.ListComplianceReviews:output_type -> custodian.v1.ListComplianceReviewsResponse
+	60,  // 143: custodian.v1.CustodianService.GetComplianceReview:output_type -> custodian.v1.ComplianceReviewResponse
+	60,  // 144: custodian.v1.CustodianService.ReleaseComplianceReview:output_type -> custodian.v1.ComplianceReviewResponse
+	60,  // 145: custodian.v1.CustodianService.RejectComplianceReview:output_type -> custodian.v1.ComplianceReviewResponse
+	63,  // 146: custodian.v1.CustodianService.SubmitMatchingInstruction:output_type -> custodian.v1.SubmitMatchingInstructionResponse
+	65,  // 147: custodian.v1.CustodianService.GetMatchingInstruction:output_type -> custodian.v1.GetMatchingInstructionResponse
+	68,  // 148: custodian.v1.CustodianService.GetMismatchReport:output_type -> custodian.v1.GetMismatchReportResponse
+	71,  // 149: custodian.v1.CustodianService.GetFailsReport:output_type -> custodian.v1.GetFailsReportResponse
+	74,  // 150: custodian.v1.CustodianService.PutStandingSettlementInstruction:output_type -> custodian.v1.PutStandingSettlementInstructionResponse
+	76,  // 151: custodian.v1.CustodianService.GetStandingSettlementInstruction:output_type -> custodian.v1.GetStandingSettlementInstructionResponse
+	78,  // 152: custodian.v1.CustodianService.ListStandingSettlementInstructions:output_type -> custodian.v1.ListStandingSettlementInstructionsResponse
+	80,  // 153: custodian.v1.CustodianService.SubscribeAccountEvents:output_type -> custodian.v1.AccountEvent
+	117, // [117:154] is the sub-list for method output_type
+	80,  // [80:117] is the sub-list for method input_type
+	80,  // [80:80] is the sub-list for extension type_name
+	80,  // [80:80] is the sub-list for extension extendee
+	0,   // [0:80] is the sub-list for field type_name
+}
+
+func init() { file_custodian_v1_custodian_proto_init() }
+func file_custodian_v1_custodian_proto_init() {
+	if File_custodian_v1_custodian_proto != nil {
+		return
+	}
+	file_custodian_v1_custodian_proto_msgTypes[34].OneofWrappers = []any{}
+	file_custodian_v1_custodian_proto_msgTypes[41].OneofWrappers = []any{}
+	file_custodian_v1_custodian_proto_msgTypes[78].OneofWrappers = []any{}
+	file_custodian_v1_custodian_proto_msgTypes[79].OneofWrappers = []any{
+		(*AccountEvent_BalanceChange)(nil),
+		(*AccountEvent_SettlementTransition)(nil),
+		(*AccountEvent_HoldChange)(nil),
+		(*AccountEvent_AccountStatusChange)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_custodian_v1_custodian_proto_rawDesc), len(file_custodian_v1_custodian_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   87,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_custodian_v1_custodian_proto_goTypes,
+		DependencyIndexes: file_custodian_v1_custodian_proto_depIdxs,
+		EnumInfos:         file_custodian_v1_custodian_proto_enumTypes,
+		MessageInfos:      file_custodian_v1_custodian_proto_msgTypes,
+	}.Build()
+	File_custodian_v1_custodian_proto = out.File
+	file_custodian_v1_custodian_proto_goTypes = nil
+	file_custodian_v1_custodian_proto_depIdxs = nil
+}
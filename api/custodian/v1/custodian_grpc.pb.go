@@ -0,0 +1,1587 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: custodian/v1/custodian.proto
+
+package custodianv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CustodianService_CreateAccount_FullMethodName                      = "/custodian.v1.CustodianService/CreateAccount"
+	CustodianService_Deposit_FullMethodName                            = "/custodian.v1.CustodianService/Deposit"
+	CustodianService_Withdraw_FullMethodName                           = "/custodian.v1.CustodianService/Withdraw"
+	CustodianService_AddWithdrawalAddress_FullMethodName               = "/custodian.v1.CustodianService/AddWithdrawalAddress"
+	CustodianService_ListWithdrawalAddresses_FullMethodName            = "/custodian.v1.CustodianService/ListWithdrawalAddresses"
+	CustodianService_RemoveWithdrawalAddress_FullMethodName            = "/custodian.v1.CustodianService/RemoveWithdrawalAddress"
+	CustodianService_GetWithdrawal_FullMethodName                      = "/custodian.v1.CustodianService/GetWithdrawal"
+	CustodianService_SubmitChainDeposit_FullMethodName                 = "/custodian.v1.CustodianService/SubmitChainDeposit"
+	CustodianService_GetChainStatus_FullMethodName                     = "/custodian.v1.CustodianService/GetChainStatus"
+	CustodianService_GetChainTransaction_FullMethodName                = "/custodian.v1.CustodianService/GetChainTransaction"
+	CustodianService_SubmitFiatDeposit_FullMethodName                  = "/custodian.v1.CustodianService/SubmitFiatDeposit"
+	CustodianService_GetFiatPayment_FullMethodName                     = "/custodian.v1.CustodianService/GetFiatPayment"
+	CustodianService_GetWalletTiers_FullMethodName                     = "/custodian.v1.CustodianService/GetWalletTiers"
+	CustodianService_GetBalance_FullMethodName                         = "/custodian.v1.CustodianService/GetBalance"
+	CustodianService_GetTransferHeadroom_FullMethodName                = "/custodian.v1.CustodianService/GetTransferHeadroom"
+	CustodianService_SubmitSettlement_FullMethodName                   = "/custodian.v1.CustodianService/SubmitSettlement"
+	CustodianService_GetSettlement_FullMethodName                      = "/custodian.v1.CustodianService/GetSettlement"
+	CustodianService_AmendSettlement_FullMethodName                    = "/custodian.v1.CustodianService/AmendSettlement"
+	CustodianService_CancelSettlement_FullMethodName                   = "/custodian.v1.CustodianService/CancelSettlement"
+	CustodianService_ApproveSettlementChange_FullMethodName            = "/custodian.v1.CustodianService/ApproveSettlementChange"
+	CustodianService_RejectSettlementChange_FullMethodName             = "/custodian.v1.CustodianService/RejectSettlementChange"
+	CustodianService_ListApprovals_FullMethodName                      = "/custodian.v1.CustodianService/ListApprovals"
+	CustodianService_GetApproval_FullMethodName                        = "/custodian.v1.CustodianService/GetApproval"
+	CustodianService_ApproveOperation_FullMethodName                   = "/custodian.v1.CustodianService/ApproveOperation"
+	CustodianService_RejectOperation_FullMethodName                    = "/custodian.v1.CustodianService/RejectOperation"
+	CustodianService_ListComplianceReviews_FullMethodName              = "/custodian.v1.CustodianService/ListComplianceReviews"
+	CustodianService_GetComplianceReview_FullMethodName                = "/custodian.v1.CustodianService/GetComplianceReview"
+	CustodianService_ReleaseComplianceReview_FullMethodName            = "/custodian.v1.CustodianService/ReleaseComplianceReview"
+	CustodianService_RejectComplianceReview_FullMethodName             = "/custodian.v1.CustodianService/RejectComplianceReview"
+	CustodianService_SubmitMatchingInstruction_FullMethodName          = "/custodian.v1.CustodianService/SubmitMatchingInstruction"
+	CustodianService_GetMatchingInstruction_FullMethodName             = "/custodian.v1.CustodianService/GetMatchingInstruction"
+	CustodianService_GetMismatchReport_FullMethodName                  = "/custodian.v1.CustodianService/GetMismatchReport"
+	CustodianService_GetFailsReport_FullMethodName                     = "/custodian.v1.CustodianService/GetFailsReport"
+	CustodianService_PutStandingSettlementInstruction_FullMethodName   = "/custodian.v1.CustodianService/PutStandingSettlementInstruction"
+	CustodianService_GetStandingSettlementInstruction_FullMethodName   = "/custodian.v1.CustodianService/GetStandingSettlementInstruction"
+	CustodianService_ListStandingSettlementInstructions_FullMethodName = "/custodian.v1.CustodianService/ListStandingSettlementInstructions"
+	CustodianService_SubscribeAccountEvents_FullMethodName             = "/custodian.v1.CustodianService/SubscribeAccountEvents"
+)
+
+// CustodianServiceClient is the client API for CustodianService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CustodianServiceClient interface {
+	// CreateAccount opens a new custody account
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	// Deposit credits an account (simulation funding)
+	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
+	// Withdraw debits an account for a withdrawal to an external address.
+	// Withdrawals the approval policy qualifies wait for approval. On a simulated
+	// network the withdrawal is broadcast and completes once confirmed.
+	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	// AddWithdrawalAddress whitelists a destination for an account; it can be used
+	// once its cooling-off period has passed
+	AddWithdrawalAddress(ctx context.Context, in *AddWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddressResponse, error)
+	// ListWithdrawalAddresses returns an account's address book
+	ListWithdrawalAddresses(ctx context.Context, in *ListWithdrawalAddressesRequest, opts ...grpc.CallOption) (*ListWithdrawalAddressesResponse, error)
+	// RemoveWithdrawalAddress takes a destination off an account's whitelist
+	RemoveWithdrawalAddress(ctx context.Context, in *RemoveWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddressResponse, error)
+	// GetWithdrawal returns a withdrawal and its outcome
+	GetWithdrawal(ctx context.Context, in *GetWithdrawalRequest, opts ...grpc.CallOption) (*GetWithdrawalResponse, error)
+	// SubmitChainDeposit broadcasts an inbound transfer on a simulated network;
+	// the account is credited once the transaction has its confirmations
+	SubmitChainDeposit(ctx context.Context, in *SubmitChainDepositRequest, opts ...grpc.CallOption) (*ChainTransactionResponse, error)
+	// GetChainStatus returns the tip and mempool of a simulated network
+	GetChainStatus(ctx context.Context, in *GetChainStatusRequest, opts ...grpc.CallOption) (*GetChainStatusResponse, error)
+	// GetChainTransaction returns a transaction on a simulated network and its
+	// confirmations
+	GetChainTransaction(ctx context.Context, in *GetChainTransactionRequest, opts ...grpc.CallOption) (*ChainTransactionResponse, error)
+	// SubmitFiatDeposit queues an inbound payment on a simulated fiat rail; the
+	// account is credited on the settlement date unless the payment is returned
+	SubmitFiatDeposit(ctx context.Context, in *SubmitFiatDepositRequest, opts ...grpc.CallOption) (*FiatPaymentResponse, error)
+	// GetFiatPayment returns a fiat rail payment, its schedule and any return code
+	GetFiatPayment(ctx context.Context, in *GetFiatPaymentRequest, opts ...grpc.CallOption) (*FiatPaymentResponse, error)
+	// GetWalletTiers returns an asset's holdings across the hot, warm and cold
+	// wallets and the rebalance operations in transit
+	GetWalletTiers(ctx context.Context, in *GetWalletTiersRequest, opts ...grpc.CallOption) (*GetWalletTiersResponse, error)
+	// GetBalance returns the balance of one asset in an account
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	// GetTransferHeadroom returns how much more of an asset an account may send
+	// under its per-transaction, daily and rolling transfer limits
+	GetTransferHeadroom(ctx context.Context, in *GetTransferHeadroomRequest, opts ...grpc.CallOption) (*GetTransferHeadroomResponse, error)
+	// SubmitSettlement moves an asset between two custody accounts. When both
+	// counterparties are given instead of accounts, their standing settlement
+	// instructions supply the accounts, cycle and cut-off.
+	SubmitSettlement(ctx context.Context, in *SubmitSettlementRequest, opts ...grpc.CallOption) (*SubmitSettlementResponse, error)
+	// GetSettlement returns a settlement with its amendment history
+	GetSettlement(ctx context.Context, in *GetSettlementRequest, opts ...grpc.CallOption) (*GetSettlementResponse, error)
+	// AmendSettlement changes the amount or settlement date of a settlement that
+	// has not started settling. With bilateral approval enabled the change waits
+	// for the other counterparty.
+	AmendSettlement(ctx context.Context, in *AmendSettlementRequest, opts ...grpc.CallOption) (*SettlementChangeResponse, error)
+	// CancelSettlement withdraws a settlement that has not started settling, with
+	// the same approval rules as AmendSettlement
+	CancelSettlement(ctx context.Context, in *CancelSettlementRequest, opts ...grpc.CallOption) (*SettlementChangeResponse, error)
+	// ApproveSettlementChange applies a change awaiting the other counterparty
+	ApproveSettlementChange(ctx context.Context, in *ApproveSettlementChangeRequest, opts ...grpc.CallOption) (*SettlementChangeResponse, error)
+	// RejectSettlementChange declines a change awaiting the other counterparty
+	RejectSettlementChange(ctx context.Context, in *RejectSettlementChangeRequest, opts ...grpc.CallOption) (*SettlementChangeResponse, error)
+	// ListApprovals lists transfers and withdrawals held by the approval policy,
+	// optionally filtered by status
+	ListApprovals(ctx context.Context, in *ListApprovalsRequest, opts ...grpc.CallOption) (*ListApprovalsResponse, error)
+	// GetApproval returns an approval request with the decisions recorded so far
+	GetApproval(ctx context.Context, in *GetApprovalRequest, opts ...grpc.CallOption) (*ApprovalResponse, error)
+	// ApproveOperation records an approval; the operation is carried out once the
+	// quorum is reached
+	ApproveOperation(ctx context.Context, in *ApproveOperationRequest, opts ...grpc.CallOption) (*ApprovalResponse, error)
+	// RejectOperation records a rejection, which abandons the operation
+	RejectOperation(ctx context.Context, in *RejectOperationRequest, opts ...grpc.CallOption) (*ApprovalResponse, error)
+	// ListComplianceReviews lists operations held by compliance screening,
+	// optionally filtered by status
+	ListComplianceReviews(ctx context.Context, in *ListComplianceReviewsRequest, opts ...grpc.CallOption) (*ListComplianceReviewsResponse, error)
+	// GetComplianceReview returns one held operation's review
+	GetComplianceReview(ctx context.Context, in *GetComplianceReviewRequest, opts ...grpc.CallOption) (*ComplianceReviewResponse, error)
+	// ReleaseComplianceReview lets a held operation continue
+	ReleaseComplianceReview(ctx context.Context, in *ResolveComplianceReviewRequest, opts ...grpc.CallOption) (*ComplianceReviewResponse, error)
+	// RejectComplianceReview abandons a held operation
+	RejectComplianceReview(ctx context.Context, in *ResolveComplianceReviewRequest, opts ...grpc.CallOption) (*ComplianceReviewResponse, error)
+	// SubmitMatchingInstruction records one counterparty's leg of a settlement.
+	// The settlement is created once the other side's leg matches it.
+	SubmitMatchingInstruction(ctx context.Context, in *SubmitMatchingInstructionRequest, opts ...grpc.CallOption) (*SubmitMatchingInstructionResponse, error)
+	// GetMatchingInstruction returns a matching instruction and its match status
+	GetMatchingInstruction(ctx context.Context, in *GetMatchingInstructionRequest, opts ...grpc.CallOption) (*GetMatchingInstructionResponse, error)
+	// GetMismatchReport lists unmatched instructions with their age and why they
+	// did not match
+	GetMismatchReport(ctx context.Context, in *GetMismatchReportRequest, opts ...grpc.CallOption) (*GetMismatchReportResponse, error)
+	// GetFailsReport summarises the settlements that were failing on a UTC day
+	GetFailsReport(ctx context.Context, in *GetFailsReportRequest, opts ...grpc.CallOption) (*GetFailsReportResponse, error)
+	// PutStandingSettlementInstruction creates or replaces the SSI a counterparty
+	// uses for an asset; every change creates a new version
+	PutStandingSettlementInstruction(ctx context.Context, in *PutStandingSettlementInstructionRequest, opts ...grpc.CallOption) (*PutStandingSettlementInstructionResponse, error)
+	// GetStandingSettlementInstruction returns the current SSI for a counterparty
+	// and asset, optionally with every earlier version
+	GetStandingSettlementInstruction(ctx context.Context, in *GetStandingSettlementInstructionRequest, opts ...grpc.CallOption) (*GetStandingSettlementInstructionResponse, error)
+	// ListStandingSettlementInstructions returns the current SSIs
+	ListStandingSettlementInstructions(ctx context.Context, in *ListStandingSettlementInstructionsRequest, opts ...grpc.CallOption) (*ListStandingSettlementInstructionsResponse, error)
+	// SubscribeAccountEvents streams balance, settlement, hold and account status
+	// events for a set of accounts. Reconnecting subscribers pass the last sequence
+	// they processed to replay anything they missed.
+	SubscribeAccountEvents(ctx context.Context, in *SubscribeAccountEventsRequest, opts ...grpc.CallOption) (CustodianService_SubscribeAccountEventsClient, error)
+}
+
+type custodianServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCustodianServiceClient(cc grpc.ClientConnInterface) CustodianServiceClient {
+	return &custodianServiceClient{cc}
+}
+
+func (c *custodianServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, CustodianService_CreateAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
+	out := new(DepositResponse)
+	err := c.cc.Invoke(ctx, CustodianService_Deposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, CustodianService_Withdraw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) AddWithdrawalAddress(ctx context.Context, in *AddWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddressResponse, error) {
+	out := new(WithdrawalAddressResponse)
+	err := c.cc.Invoke(ctx, CustodianService_AddWithdrawalAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) ListWithdrawalAddresses(ctx context.Context, in *ListWithdrawalAddressesRequest, opts ...grpc.CallOption) (*ListWithdrawalAddressesResponse, error) {
+	out := new(ListWithdrawalAddressesResponse)
+	err := c.cc.Invoke(ctx, CustodianService_ListWithdrawalAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) RemoveWithdrawalAddress(ctx context.Context, in *RemoveWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddressResponse, error) {
+	out := new(WithdrawalAddressResponse)
+	err := c.cc.Invoke(ctx, CustodianService_RemoveWithdrawalAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetWithdrawal(ctx context.Context, in *GetWithdrawalRequest, opts ...grpc.CallOption) (*GetWithdrawalResponse, error) {
+	out := new(GetWithdrawalResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) SubmitChainDeposit(ctx context.Context, in *SubmitChainDepositRequest, opts ...grpc.CallOption) (*ChainTransactionResponse, error) {
+	out := new(ChainTransactionResponse)
+	err := c.cc.Invoke(ctx, CustodianService_SubmitChainDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetChainStatus(ctx context.Context, in *GetChainStatusRequest, opts ...grpc.CallOption) (*GetChainStatusResponse, error) {
+	out := new(GetChainStatusResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetChainStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetChainTransaction(ctx context.Context, in *GetChainTransactionRequest, opts ...grpc.CallOption) (*ChainTransactionResponse, error) {
+	out := new(ChainTransactionResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetChainTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) SubmitFiatDeposit(ctx context.Context, in *SubmitFiatDepositRequest, opts ...grpc.CallOption) (*FiatPaymentResponse, error) {
+	out := new(FiatPaymentResponse)
+	err := c.cc.Invoke(ctx, CustodianService_SubmitFiatDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetFiatPayment(ctx context.Context, in *GetFiatPaymentRequest, opts ...grpc.CallOption) (*FiatPaymentResponse, error) {
+	out := new(FiatPaymentResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetFiatPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetWalletTiers(ctx context.Context, in *GetWalletTiersRequest, opts ...grpc.CallOption) (*GetWalletTiersResponse, error) {
+	out := new(GetWalletTiersResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetWalletTiers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
+	out := new(GetBalanceResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetTransferHeadroom(ctx context.Context, in *GetTransferHeadroomRequest, opts ...grpc.CallOption) (*GetTransferHeadroomResponse, error) {
+	out := new(GetTransferHeadroomResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetTransferHeadroom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) SubmitSettlement(ctx context.Context, in *SubmitSettlementRequest, opts ...grpc.CallOption) (*SubmitSettlementResponse, error) {
+	out := new(SubmitSettlementResponse)
+	err := c.cc.Invoke(ctx, CustodianService_SubmitSettlement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetSettlement(ctx context.Context, in *GetSettlementRequest, opts ...grpc.CallOption) (*GetSettlementResponse, error) {
+	out := new(GetSettlementResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetSettlement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) AmendSettlement(ctx context.Context, in *AmendSettlementRequest, opts ...grpc.CallOption) (*SettlementChangeResponse, error) {
+	out := new(SettlementChangeResponse)
+	err := c.cc.Invoke(ctx, CustodianService_AmendSettlement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) CancelSettlement(ctx context.Context, in *CancelSettlementRequest, opts ...grpc.CallOption) (*SettlementChangeResponse, error) {
+	out := new(SettlementChangeResponse)
+	err := c.cc.Invoke(ctx, CustodianService_CancelSettlement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) ApproveSettlementChange(ctx context.Context, in *ApproveSettlementChangeRequest, opts ...grpc.CallOption) (*SettlementChangeResponse, error) {
+	out := new(SettlementChangeResponse)
+	err := c.cc.Invoke(ctx, CustodianService_ApproveSettlementChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) RejectSettlementChange(ctx context.Context, in *RejectSettlementChangeRequest, opts ...grpc.CallOption) (*SettlementChangeResponse, error) {
+	out := new(SettlementChangeResponse)
+	err := c.cc.Invoke(ctx, CustodianService_RejectSettlementChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) ListApprovals(ctx context.Context, in *ListApprovalsRequest, opts ...grpc.CallOption) (*ListApprovalsResponse, error) {
+	out := new(ListApprovalsResponse)
+	err := c.cc.Invoke(ctx, CustodianService_ListApprovals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetApproval(ctx context.Context, in *GetApprovalRequest, opts ...grpc.CallOption) (*ApprovalResponse, error) {
+	out := new(ApprovalResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetApproval_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) ApproveOperation(ctx context.Context, in *ApproveOperationRequest, opts ...grpc.CallOption) (*ApprovalResponse, error) {
+	out := new(ApprovalResponse)
+	err := c.cc.Invoke(ctx, CustodianService_ApproveOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) RejectOperation(ctx context.Context, in *RejectOperationRequest, opts ...grpc.CallOption) (*ApprovalResponse, error) {
+	out := new(ApprovalResponse)
+	err := c.cc.Invoke(ctx, CustodianService_RejectOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) ListComplianceReviews(ctx context.Context, in *ListComplianceReviewsRequest, opts ...grpc.CallOption) (*ListComplianceReviewsResponse, error) {
+	out := new(ListComplianceReviewsResponse)
+	err := c.cc.Invoke(ctx, CustodianService_ListComplianceReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetComplianceReview(ctx context.Context, in *GetComplianceReviewRequest, opts ...grpc.CallOption) (*ComplianceReviewResponse, error) {
+	out := new(ComplianceReviewResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetComplianceReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) ReleaseComplianceReview(ctx context.Context, in *ResolveComplianceReviewRequest, opts ...grpc.CallOption) (*ComplianceReviewResponse, error) {
+	out := new(ComplianceReviewResponse)
+	err := c.cc.Invoke(ctx, CustodianService_ReleaseComplianceReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) RejectComplianceReview(ctx context.Context, in *ResolveComplianceReviewRequest, opts ...grpc.CallOption) (*ComplianceReviewResponse, error) {
+	out := new(ComplianceReviewResponse)
+	err := c.cc.Invoke(ctx, CustodianService_RejectComplianceReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) SubmitMatchingInstruction(ctx context.Context, in *SubmitMatchingInstructionRequest, opts ...grpc.CallOption) (*SubmitMatchingInstructionResponse, error) {
+	out := new(SubmitMatchingInstructionResponse)
+	err := c.cc.Invoke(ctx, CustodianService_SubmitMatchingInstruction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetMatchingInstruction(ctx context.Context, in *GetMatchingInstructionRequest, opts ...grpc.CallOption) (*GetMatchingInstructionResponse, error) {
+	out := new(GetMatchingInstructionResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetMatchingInstruction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetMismatchReport(ctx context.Context, in *GetMismatchReportRequest, opts ...grpc.CallOption) (*GetMismatchReportResponse, error) {
+	out := new(GetMismatchReportResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetMismatchReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetFailsReport(ctx context.Context, in *GetFailsReportRequest, opts ...grpc.CallOption) (*GetFailsReportResponse, error) {
+	out := new(GetFailsReportResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetFailsReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) PutStandingSettlementInstruction(ctx context.Context, in *PutStandingSettlementInstructionRequest, opts ...grpc.CallOption) (*PutStandingSettlementInstructionResponse, error) {
+	out := new(PutStandingSettlementInstructionResponse)
+	err := c.cc.Invoke(ctx, CustodianService_PutStandingSettlementInstruction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) GetStandingSettlementInstruction(ctx context.Context, in *GetStandingSettlementInstructionRequest, opts ...grpc.CallOption) (*GetStandingSettlementInstructionResponse, error) {
+	out := new(GetStandingSettlementInstructionResponse)
+	err := c.cc.Invoke(ctx, CustodianService_GetStandingSettlementInstruction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) ListStandingSettlementInstructions(ctx context.Context, in *ListStandingSettlementInstructionsRequest, opts ...grpc.CallOption) (*ListStandingSettlementInstructionsResponse, error) {
+	out := new(ListStandingSettlementInstructionsResponse)
+	err := c.cc.Invoke(ctx, CustodianService_ListStandingSettlementInstructions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *custodianServiceClient) SubscribeAccountEvents(ctx context.Context, in *SubscribeAccountEventsRequest, opts ...grpc.CallOption) (CustodianService_SubscribeAccountEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CustodianService_ServiceDesc.Streams[0], CustodianService_SubscribeAccountEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &custodianServiceSubscribeAccountEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CustodianService_SubscribeAccountEventsClient interface {
+	Recv() (*AccountEvent, error)
+	grpc.ClientStream
+}
+
+type custodianServiceSubscribeAccountEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *custodianServiceSubscribeAccountEventsClient) Recv() (*AccountEvent, error) {
+	m := new(AccountEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CustodianServiceServer is the server API for CustodianService service.
+// All implementations must embed UnimplementedCustodianServiceServer
+// for forward compatibility
+type CustodianServiceServer interface {
+	// CreateAccount opens a new custody account
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	// Deposit credits an account (simulation funding)
+	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
+	// Withdraw debits an account for a withdrawal to an external address.
+	// Withdrawals the approval policy qualifies wait for approval. On a simulated
+	// network the withdrawal is broadcast and completes once confirmed.
+	Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error)
+	// AddWithdrawalAddress whitelists a destination for an account; it can be used
+	// once its cooling-off period has passed
+	AddWithdrawalAddress(context.Context, *AddWithdrawalAddressRequest) (*WithdrawalAddressResponse, error)
+	// ListWithdrawalAddresses returns an account's address book
+	ListWithdrawalAddresses(context.Context, *ListWithdrawalAddressesRequest) (*ListWithdrawalAddressesResponse, error)
+	// RemoveWithdrawalAddress takes a destination off an account's whitelist
+	RemoveWithdrawalAddress(context.Context, *RemoveWithdrawalAddressRequest) (*WithdrawalAddressResponse, error)
+	// GetWithdrawal returns a withdrawal and its outcome
+	GetWithdrawal(context.Context, *GetWithdrawalRequest) (*GetWithdrawalResponse, error)
+	// SubmitChainDeposit broadcasts an inbound transfer on a simulated network;
+	// the account is credited once the transaction has its confirmations
+	SubmitChainDeposit(context.Context, *SubmitChainDepositRequest) (*ChainTransactionResponse, error)
+	// GetChainStatus returns the tip and mempool of a simulated network
+	GetChainStatus(context.Context, *GetChainStatusRequest) (*GetChainStatusResponse, error)
+	// GetChainTransaction returns a transaction on a simulated network and its
+	// confirmations
+	GetChainTransaction(context.Context, *GetChainTransactionRequest) (*ChainTransactionResponse, error)
+	// SubmitFiatDeposit queues an inbound payment on a simulated fiat rail; the
+	// account is credited on the settlement date unless the payment is returned
+	SubmitFiatDeposit(context.Context, *SubmitFiatDepositRequest) (*FiatPaymentResponse, error)
+	// GetFiatPayment returns a fiat rail payment, its schedule and any return code
+	GetFiatPayment(context.Context, *GetFiatPaymentRequest) (*FiatPaymentResponse, error)
+	// GetWalletTiers returns an asset's holdings across the hot, warm and cold
+	// wallets and the rebalance operations in transit
+	GetWalletTiers(context.Context, *GetWalletTiersRequest) (*GetWalletTiersResponse, error)
+	// GetBalance returns the balance of one asset in an account
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	// GetTransferHeadroom returns how much more of an asset an account may send
+	// under its per-transaction, daily and rolling transfer limits
+	GetTransferHeadroom(context.Context, *GetTransferHeadroomRequest) (*GetTransferHeadroomResponse, error)
+	// SubmitSettlement moves an asset between two custody accounts. When both
+	// counterparties are given instead of accounts, their standing settlement
+	// instructions supply the accounts, cycle and cut-off.
+	SubmitSettlement(context.Context, *SubmitSettlementRequest) (*SubmitSettlementResponse, error)
+	// GetSettlement returns a settlement with its amendment history
+	GetSettlement(context.Context, *GetSettlementRequest) (*GetSettlementResponse, error)
+	// AmendSettlement changes the amount or settlement date of a settlement that
+	// has not started settling. With bilateral approval enabled the change waits
+	// for the other counterparty.
+	AmendSettlement(context.Context, *AmendSettlementRequest) (*SettlementChangeResponse, error)
+	// CancelSettlement withdraws a settlement that has not started settling, with
+	// the same approval rules as AmendSettlement
+	CancelSettlement(context.Context, *CancelSettlementRequest) (*SettlementChangeResponse, error)
+	// ApproveSettlementChange applies a change awaiting the other counterparty
+	ApproveSettlementChange(context.Context, *ApproveSettlementChangeRequest) (*SettlementChangeResponse, error)
+	// RejectSettlementChange declines a change awaiting the other counterparty
+	RejectSettlementChange(context.Context, *RejectSettlementChangeRequest) (*SettlementChangeResponse, error)
+	// ListApprovals lists transfers and withdrawals held by the approval policy,
+	// optionally filtered by status
+	ListApprovals(context.Context, *ListApprovalsRequest) (*ListApprovalsResponse, error)
+	// GetApproval returns an approval request with the decisions recorded so far
+	GetApproval(context.Context, *GetApprovalRequest) (*ApprovalResponse, error)
+	// ApproveOperation records an approval; the operation is carried out once the
+	// quorum is reached
+	ApproveOperation(context.Context, *ApproveOperationRequest) (*ApprovalResponse, error)
+	// RejectOperation records a rejection, which abandons the operation
+	RejectOperation(context.Context, *RejectOperationRequest) (*ApprovalResponse, error)
+	// ListComplianceReviews lists operations held by compliance screening,
+	// optionally filtered by status
+	ListComplianceReviews(context.Context, *ListComplianceReviewsRequest) (*ListComplianceReviewsResponse, error)
+	// GetComplianceReview returns one held operation's review
+	GetComplianceReview(context.Context, *GetComplianceReviewRequest) (*ComplianceReviewResponse, error)
+	// ReleaseComplianceReview lets a held operation continue
+	ReleaseComplianceReview(context.Context, *ResolveComplianceReviewRequest) (*ComplianceReviewResponse, error)
+	// RejectComplianceReview abandons a held operation
+	RejectComplianceReview(context.Context, *ResolveComplianceReviewRequest) (*ComplianceReviewResponse, error)
+	// SubmitMatchingInstruction records one counterparty's leg of a settlement.
+	// The settlement is created once the other side's leg matches it.
+	SubmitMatchingInstruction(context.Context, *SubmitMatchingInstructionRequest) (*SubmitMatchingInstructionResponse, error)
+	// GetMatchingInstruction returns a matching instruction and its match status
+	GetMatchingInstruction(context.Context, *GetMatchingInstructionRequest) (*GetMatchingInstructionResponse, error)
+	// GetMismatchReport lists unmatched instructions with their age and why they
+	// did not match
+	GetMismatchReport(context.Context, *GetMismatchReportRequest) (*GetMismatchReportResponse, error)
+	// GetFailsReport summarises the settlements that were failing on a UTC day
+	GetFailsReport(context.Context, *GetFailsReportRequest) (*GetFailsReportResponse, error)
+	// PutStandingSettlementInstruction creates or replaces the SSI a counterparty
+	// uses for an asset; every change creates a new version
+	PutStandingSettlementInstruction(context.Context, *PutStandingSettlementInstructionRequest) (*PutStandingSettlementInstructionResponse, error)
+	// GetStandingSettlementInstruction returns the current SSI for a counterparty
+	// and asset, optionally with every earlier version
+	GetStandingSettlementInstruction(context.Context, *GetStandingSettlementInstructionRequest) (*GetStandingSettlementInstructionResponse, error)
+	// ListStandingSettlementInstructions returns the current SSIs
+	ListStandingSettlementInstructions(context.Context, *ListStandingSettlementInstructionsRequest) (*ListStandingSettlementInstructionsResponse, error)
+	// SubscribeAccountEvents streams balance, settlement, hold and account status
+	// events for a set of accounts. Reconnecting subscribers pass the last sequence
+	// they processed to replay anything they missed.
+	SubscribeAccountEvents(*SubscribeAccountEventsRequest, CustodianService_SubscribeAccountEventsServer) error
+	mustEmbedUnimplementedCustodianServiceServer()
+}
+
+// UnimplementedCustodianServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCustodianServiceServer struct {
+}
+
+func (UnimplementedCustodianServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedCustodianServiceServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedCustodianServiceServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedCustodianServiceServer) AddWithdrawalAddress(context.Context, *AddWithdrawalAddressRequest) (*WithdrawalAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWithdrawalAddress not implemented")
+}
+func (UnimplementedCustodianServiceServer) ListWithdrawalAddresses(context.Context, *ListWithdrawalAddressesRequest) (*ListWithdrawalAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWithdrawalAddresses not implemented")
+}
+func (UnimplementedCustodianServiceServer) RemoveWithdrawalAddress(context.Context, *RemoveWithdrawalAddressRequest) (*WithdrawalAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWithdrawalAddress not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetWithdrawal(context.Context, *GetWithdrawalRequest) (*GetWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawal not implemented")
+}
+func (UnimplementedCustodianServiceServer) SubmitChainDeposit(context.Context, *SubmitChainDepositRequest) (*ChainTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitChainDeposit not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetChainStatus(context.Context, *GetChainStatusRequest) (*GetChainStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainStatus not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetChainTransaction(context.Context, *GetChainTransactionRequest) (*ChainTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainTransaction not implemented")
+}
+func (UnimplementedCustodianServiceServer) SubmitFiatDeposit(context.Context, *SubmitFiatDepositRequest) (*FiatPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFiatDeposit not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetFiatPayment(context.Context, *GetFiatPaymentRequest) (*FiatPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFiatPayment not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetWalletTiers(context.Context, *GetWalletTiersRequest) (*GetWalletTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletTiers not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetTransferHeadroom(context.Context, *GetTransferHeadroomRequest) (*GetTransferHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransferHeadroom not implemented")
+}
+func (UnimplementedCustodianServiceServer) SubmitSettlement(context.Context, *SubmitSettlementRequest) (*SubmitSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSettlement not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetSettlement(context.Context, *GetSettlementRequest) (*GetSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlement not implemented")
+}
+func (UnimplementedCustodianServiceServer) AmendSettlement(context.Context, *AmendSettlementRequest) (*SettlementChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendSettlement not implemented")
+}
+func (UnimplementedCustodianServiceServer) CancelSettlement(context.Context, *CancelSettlementRequest) (*SettlementChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSettlement not implemented")
+}
+func (UnimplementedCustodianServiceServer) ApproveSettlementChange(context.Context, *ApproveSettlementChangeRequest) (*SettlementChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSettlementChange not implemented")
+}
+func (UnimplementedCustodianServiceServer) RejectSettlementChange(context.Context, *RejectSettlementChangeRequest) (*SettlementChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSettlementChange not implemented")
+}
+func (UnimplementedCustodianServiceServer) ListApprovals(context.Context, *ListApprovalsRequest) (*ListApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovals not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetApproval(context.Context, *GetApprovalRequest) (*ApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApproval not implemented")
+}
+func (UnimplementedCustodianServiceServer) ApproveOperation(context.Context, *ApproveOperationRequest) (*ApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOperation not implemented")
+}
+func (UnimplementedCustodianServiceServer) RejectOperation(context.Context, *RejectOperationRequest) (*ApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectOperation not implemented")
+}
+func (UnimplementedCustodianServiceServer) ListComplianceReviews(context.Context, *ListComplianceReviewsRequest) (*ListComplianceReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComplianceReviews not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetComplianceReview(context.Context, *GetComplianceReviewRequest) (*ComplianceReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComplianceReview not implemented")
+}
+func (UnimplementedCustodianServiceServer) ReleaseComplianceReview(context.Context, *ResolveComplianceReviewRequest) (*ComplianceReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseComplianceReview not implemented")
+}
+func (UnimplementedCustodianServiceServer) RejectComplianceReview(context.Context, *ResolveComplianceReviewRequest) (*ComplianceReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectComplianceReview not implemented")
+}
+func (UnimplementedCustodianServiceServer) SubmitMatchingInstruction(context.Context, *SubmitMatchingInstructionRequest) (*SubmitMatchingInstructionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMatchingInstruction not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetMatchingInstruction(context.Context, *GetMatchingInstructionRequest) (*GetMatchingInstructionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchingInstruction not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetMismatchReport(context.Context, *GetMismatchReportRequest) (*GetMismatchReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMismatchReport not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetFailsReport(context.Context, *GetFailsReportRequest) (*GetFailsReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailsReport not implemented")
+}
+func (UnimplementedCustodianServiceServer) PutStandingSettlementInstruction(context.Context, *PutStandingSettlementInstructionRequest) (*PutStandingSettlementInstructionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutStandingSettlementInstruction not implemented")
+}
+func (UnimplementedCustodianServiceServer) GetStandingSettlementInstruction(context.Context, *GetStandingSettlementInstructionRequest) (*GetStandingSettlementInstructionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandingSettlementInstruction not implemented")
+}
+func (UnimplementedCustodianServiceServer) ListStandingSettlementInstructions(context.Context, *ListStandingSettlementInstructionsRequest) (*ListStandingSettlementInstructionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStandingSettlementInstructions not implemented")
+}
+func (UnimplementedCustodianServiceServer) SubscribeAccountEvents(*SubscribeAccountEventsRequest, CustodianService_SubscribeAccountEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAccountEvents not implemented")
+}
+func (UnimplementedCustodianServiceServer) mustEmbedUnimplementedCustodianServiceServer() {}
+
+// UnsafeCustodianServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CustodianServiceServer will
+// result in compilation errors.
+type UnsafeCustodianServiceServer interface {
+	mustEmbedUnimplementedCustodianServiceServer()
+}
+
+func RegisterCustodianServiceServer(s grpc.ServiceRegistrar, srv CustodianServiceServer) {
+	s.RegisterService(&CustodianService_ServiceDesc, srv)
+}
+
+func _CustodianService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_Deposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_Withdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_AddWithdrawalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWithdrawalAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).AddWithdrawalAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_AddWithdrawalAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).AddWithdrawalAddress(ctx, req.(*AddWithdrawalAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_ListWithdrawalAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWithdrawalAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).ListWithdrawalAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_ListWithdrawalAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).ListWithdrawalAddresses(ctx, req.(*ListWithdrawalAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_RemoveWithdrawalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWithdrawalAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).RemoveWithdrawalAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_RemoveWithdrawalAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).RemoveWithdrawalAddress(ctx, req.(*RemoveWithdrawalAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetWithdrawal(ctx, req.(*GetWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_SubmitChainDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitChainDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).SubmitChainDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_SubmitChainDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).SubmitChainDeposit(ctx, req.(*SubmitChainDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetChainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetChainStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetChainStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetChainStatus(ctx, req.(*GetChainStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetChainTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetChainTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetChainTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetChainTransaction(ctx, req.(*GetChainTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_SubmitFiatDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitFiatDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).SubmitFiatDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_SubmitFiatDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).SubmitFiatDeposit(ctx, req.(*SubmitFiatDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetFiatPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFiatPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetFiatPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetFiatPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetFiatPayment(ctx, req.(*GetFiatPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetWalletTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetWalletTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetWalletTiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetWalletTiers(ctx, req.(*GetWalletTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetTransferHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetTransferHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetTransferHeadroom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetTransferHeadroom(ctx, req.(*GetTransferHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_SubmitSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).SubmitSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_SubmitSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).SubmitSettlement(ctx, req.(*SubmitSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetSettlement(ctx, req.(*GetSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_AmendSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).AmendSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_AmendSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).AmendSettlement(ctx, req.(*AmendSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_CancelSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).CancelSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_CancelSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).CancelSettlement(ctx, req.(*CancelSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_ApproveSettlementChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSettlementChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).ApproveSettlementChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_ApproveSettlementChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).ApproveSettlementChange(ctx, req.(*ApproveSettlementChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_RejectSettlementChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectSettlementChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).RejectSettlementChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_RejectSettlementChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).RejectSettlementChange(ctx, req.(*RejectSettlementChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_ListApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).ListApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_ListApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).ListApprovals(ctx, req.(*ListApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetApproval(ctx, req.(*GetApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_ApproveOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).ApproveOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_ApproveOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).ApproveOperation(ctx, req.(*ApproveOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_RejectOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).RejectOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_RejectOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).RejectOperation(ctx, req.(*RejectOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_ListComplianceReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListComplianceReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).ListComplianceReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_ListComplianceReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).ListComplianceReviews(ctx, req.(*ListComplianceReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetComplianceReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComplianceReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetComplianceReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetComplianceReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetComplianceReview(ctx, req.(*GetComplianceReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_ReleaseComplianceReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveComplianceReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).ReleaseComplianceReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_ReleaseComplianceReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).ReleaseComplianceReview(ctx, req.(*ResolveComplianceReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_RejectComplianceReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveComplianceReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).RejectComplianceReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_RejectComplianceReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).RejectComplianceReview(ctx, req.(*ResolveComplianceReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_SubmitMatchingInstruction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitMatchingInstructionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).SubmitMatchingInstruction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_SubmitMatchingInstruction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).SubmitMatchingInstruction(ctx, req.(*SubmitMatchingInstructionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetMatchingInstruction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchingInstructionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetMatchingInstruction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetMatchingInstruction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetMatchingInstruction(ctx, req.(*GetMatchingInstructionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetMismatchReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMismatchReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetMismatchReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetMismatchReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetMismatchReport(ctx, req.(*GetMismatchReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetFailsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFailsReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetFailsReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetFailsReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetFailsReport(ctx, req.(*GetFailsReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_PutStandingSettlementInstruction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutStandingSettlementInstructionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).PutStandingSettlementInstruction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_PutStandingSettlementInstruction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).PutStandingSettlementInstruction(ctx, req.(*PutStandingSettlementInstructionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_GetStandingSettlementInstruction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingSettlementInstructionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).GetStandingSettlementInstruction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_GetStandingSettlementInstruction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).GetStandingSettlementInstruction(ctx, req.(*GetStandingSettlementInstructionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_ListStandingSettlementInstructions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStandingSettlementInstructionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustodianServiceServer).ListStandingSettlementInstructions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustodianService_ListStandingSettlementInstructions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustodianServiceServer).ListStandingSettlementInstructions(ctx, req.(*ListStandingSettlementInstructionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustodianService_SubscribeAccountEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAccountEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CustodianServiceServer).SubscribeAccountEvents(m, &custodianServiceSubscribeAccountEventsServer{stream})
+}
+
+type CustodianService_SubscribeAccountEventsServer interface {
+	Send(*AccountEvent) error
+	grpc.ServerStream
+}
+
+type custodianServiceSubscribeAccountEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *custodianServiceSubscribeAccountEventsServer) Send(m *AccountEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// CustodianService_ServiceDesc is the grpc.ServiceDesc for CustodianService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CustodianService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "custodian.v1.CustodianService",
+	HandlerType: (*CustodianServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccount",
+			Handler:    _CustodianService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _CustodianService_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _CustodianService_Withdraw_Handler,
+		},
+		{
+			MethodName: "AddWithdrawalAddress",
+			Handler:    _CustodianService_AddWithdrawalAddress_Handler,
+		},
+		{
+			MethodName: "ListWithdrawalAddresses",
+			Handler:    _CustodianService_ListWithdrawalAddresses_Handler,
+		},
+		{
+			MethodName: "RemoveWithdrawalAddress",
+			Handler:    _CustodianService_RemoveWithdrawalAddress_Handler,
+		},
+		{
+			MethodName: "GetWithdrawal",
+			Handler:    _CustodianService_GetWithdrawal_Handler,
+		},
+		{
+			MethodName: "SubmitChainDeposit",
+			Handler:    _CustodianService_SubmitChainDeposit_Handler,
+		},
+		{
+			MethodName: "GetChainStatus",
+			Handler:    _CustodianService_GetChainStatus_Handler,
+		},
+		{
+			MethodName: "GetChainTransaction",
+			Handler:    _CustodianService_GetChainTransaction_Handler,
+		},
+		{
+			MethodName: "SubmitFiatDeposit",
+			Handler:    _CustodianService_SubmitFiatDeposit_Handler,
+		},
+		{
+			MethodName: "GetFiatPayment",
+			Handler:    _CustodianService_GetFiatPayment_Handler,
+		},
+		{
+			MethodName: "GetWalletTiers",
+			Handler:    _CustodianService_GetWalletTiers_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _CustodianService_GetBalance_Handler,
+		},
+		{
+			MethodName: "GetTransferHeadroom",
+			Handler:    _CustodianService_GetTransferHeadroom_Handler,
+		},
+		{
+			MethodName: "SubmitSettlement",
+			Handler:    _CustodianService_SubmitSettlement_Handler,
+		},
+		{
+			MethodName: "GetSettlement",
+			Handler:    _CustodianService_GetSettlement_Handler,
+		},
+		{
+			MethodName: "AmendSettlement",
+			Handler:    _CustodianService_AmendSettlement_Handler,
+		},
+		{
+			MethodName: "CancelSettlement",
+			Handler:    _CustodianService_CancelSettlement_Handler,
+		},
+		{
+			MethodName: "ApproveSettlementChange",
+			Handler:    _CustodianService_ApproveSettlementChange_Handler,
+		},
+		{
+			MethodName: "RejectSettlementChange",
+			Handler:    _CustodianService_RejectSettlementChange_Handler,
+		},
+		{
+			MethodName: "ListApprovals",
+			Handler:    _CustodianService_ListApprovals_Handler,
+		},
+		{
+			MethodName: "GetApproval",
+			Handler:    _CustodianService_GetApproval_Handler,
+		},
+		{
+			MethodName: "ApproveOperation",
+			Handler:    _CustodianService_ApproveOperation_Handler,
+		},
+		{
+			MethodName: "RejectOperation",
+			Handler:    _CustodianService_RejectOperation_Handler,
+		},
+		{
+			MethodName: "ListComplianceReviews",
+			Handler:    _CustodianService_ListComplianceReviews_Handler,
+		},
+		{
+			MethodName: "GetComplianceReview",
+			Handler:    _CustodianService_GetComplianceReview_Handler,
+		},
+		{
+			MethodName: "ReleaseComplianceReview",
+			Handler:    _CustodianService_ReleaseComplianceReview_Handler,
+		},
+		{
+			MethodName: "RejectComplianceReview",
+			Handler:    _CustodianService_RejectComplianceReview_Handler,
+		},
+		{
+			MethodName: "SubmitMatchingInstruction",
+			Handler:    _CustodianService_SubmitMatchingInstruction_Handler,
+		},
+		{
+			MethodName: "GetMatchingInstruction",
+			Handler:    _CustodianService_GetMatchingInstruction_Handler,
+		},
+		{
+			MethodName: "GetMismatchReport",
+			Handler:    _CustodianService_GetMismatchReport_Handler,
+		},
+		{
+			MethodName: "GetFailsReport",
+			Handler:    _CustodianService_GetFailsReport_Handler,
+		},
+		{
+			MethodName: "PutStandingSettlementInstruction",
+			Handler:    _CustodianService_PutStandingSettlementInstruction_Handler,
+		},
+		{
+			MethodName: "GetStandingSettlementInstruction",
+			Handler:    _CustodianService_GetStandingSettlementInstruction_Handler,
+		},
+		{
+			MethodName: "ListStandingSettlementInstructions",
+			Handler:    _CustodianService_ListStandingSettlementInstructions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeAccountEvents",
+			Handler:       _CustodianService_SubscribeAccountEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "custodian/v1/custodian.proto",
+}
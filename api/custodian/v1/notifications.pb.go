@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: custodian/v1/notifications.proto
+
+package custodianv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NotifySettlementRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	SettlementId   string                 `protobuf:"bytes,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	FromAccountId  string                 `protobuf:"bytes,4,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId    string                 `protobuf:"bytes,5,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	AssetId        string                 `protobuf:"bytes,6,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount         float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason         string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotifySettlementRequest) Reset() {
+	*x = NotifySettlementRequest{}
+	mi := &file_custodian_v1_notifications_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifySettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifySettlementRequest) ProtoMessage() {}
+
+func (x *NotifySettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_notifications_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifySettlementRequest.ProtoReflect.Descriptor instead.
+func (*NotifySettlementRequest) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_notifications_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotifySettlementRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *NotifySettlementRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *NotifySettlementRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotifySettlementRequest) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *NotifySettlementRequest) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *NotifySettlementRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *NotifySettlementRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *NotifySettlementRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *NotifySettlementRequest) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type NotifySettlementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifySettlementResponse) Reset() {
+	*x = NotifySettlementResponse{}
+	mi := &file_custodian_v1_notifications_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifySettlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifySettlementResponse) ProtoMessage() {}
+
+func (x *NotifySettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_custodian_v1_notifications_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifySettlementResponse.ProtoReflect.Descriptor instead.
+func (*NotifySettlementResponse) Descriptor() ([]byte, []int) {
+	return file_custodian_v1_notifications_proto_rawDescGZIP(), []int{1}
+}
+
+var File_custodian_v1_notifications_proto protoreflect.FileDescriptor
+
+const file_custodian_v1_notifications_proto_rawDesc = "" +
+	"\n" +
+	" custodian/v1/notifications.proto\x12\fcustodian.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd3\x02\n" +
+	"\x17NotifySettlementRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12#\n" +
+	"\rsettlement_id\x18\x02 \x01(\tR\fsettlementId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12&\n" +
+	"\x0ffrom_account_id\x18\x04 \x01(\tR\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x05 \x01(\tR\vtoAccountId\x12\x19\n" +
+	"\basset_id\x18\x06 \x01(\tR\aassetId\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12;\n" +
+	"\voccurred_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x1a\n" +
+	"\x18NotifySettlementResponse2\x83\x01\n" +
+	"\x1eSettlementNotificationReceiver\x12a\n" +
+	"\x10NotifySettlement\x12%.custodian.v1.NotifySettlementRequest\x1a&.custodian.v1.NotifySettlementResponseBaZ_github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/custodian/v1;custodianv1b\x06proto3"
+
+var (
+	file_custodian_v1_notifications_proto_rawDescOnce sync.Once
+	file_custodian_v1_notifications_proto_rawDescData []byte
+)
+
+func file_custodian_v1_notifications_proto_rawDescGZIP() []byte {
+	file_custodian_v1_notifications_proto_rawDescOnce.Do(func() {
+		file_custodian_v1_notifications_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_custodian_v1_notifications_proto_rawDesc), len(file_custodian_v1_notifications_proto_rawDesc)))
+	})
+	return file_custodian_v1_notifications_proto_rawDescData
+}
+
+var file_custodian_v1_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_custodian_v1_notifications_proto_goTypes = []any{
+	(*NotifySettlementRequest)(nil),  // 0: custodian.v1.NotifySettlementRequest
+	(*NotifySettlementResponse)(nil), // 1: custodian.v1.NotifySettlementResponse
+	(*timestamppb.Timestamp)(nil),    // 2: google.protobuf.Timestamp
+}
+var file_custodian_v1_notifications_proto_depIdxs = []int32{
+	2, // 0: custodian.v1.NotifySettlementRequest.occurred_at:type_name -> google.protobuf.Timestamp
+	0, // 1: custodian.v1.SettlementNotificationReceiver.NotifySettlement:input_type -> custodian.v1.NotifySettlementRequest
+	1, // 2: custodian.v1.SettlementNotificationReceiver.NotifySettlement:output_type -> custodian.v1.NotifySettlementResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_custodian_v1_notifications_proto_init() }
+func file_custodian_v1_notifications_proto_init() {
+	if File_custodian_v1_notifications_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_custodian_v1_notifications_proto_rawDesc), len(file_custodian_v1_notifications_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_custodian_v1_notifications_proto_goTypes,
+		DependencyIndexes: file_custodian_v1_notifications_proto_depIdxs,
+		MessageInfos:      file_custodian_v1_notifications_proto_msgTypes,
+	}.Build()
+	File_custodian_v1_notifications_proto = out.File
+	file_custodian_v1_notifications_proto_goTypes = nil
+	file_custodian_v1_notifications_proto_depIdxs = nil
+}
@@ -0,0 +1,112 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: custodian/v1/notifications.proto
+
+package custodianv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SettlementNotificationReceiver_NotifySettlement_FullMethodName = "/custodian.v1.SettlementNotificationReceiver/NotifySettlement"
+)
+
+// SettlementNotificationReceiverClient is the client API for SettlementNotificationReceiver service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SettlementNotificationReceiverClient interface {
+	// NotifySettlement reports a settlement status change
+	NotifySettlement(ctx context.Context, in *NotifySettlementRequest, opts ...grpc.CallOption) (*NotifySettlementResponse, error)
+}
+
+type settlementNotificationReceiverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSettlementNotificationReceiverClient(cc grpc.ClientConnInterface) SettlementNotificationReceiverClient {
+	return &settlementNotificationReceiverClient{cc}
+}
+
+func (c *settlementNotificationReceiverClient) NotifySettlement(ctx context.Context, in *NotifySettlementRequest, opts ...grpc.CallOption) (*NotifySettlementResponse, error) {
+	out := new(NotifySettlementResponse)
+	err := c.cc.Invoke(ctx, SettlementNotificationReceiver_NotifySettlement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SettlementNotificationReceiverServer is the server API for SettlementNotificationReceiver service.
+// All implementations must embed UnimplementedSettlementNotificationReceiverServer
+// for forward compatibility
+type SettlementNotificationReceiverServer interface {
+	// NotifySettlement reports a settlement status change
+	NotifySettlement(context.Context, *NotifySettlementRequest) (*NotifySettlementResponse, error)
+	mustEmbedUnimplementedSettlementNotificationReceiverServer()
+}
+
+// UnimplementedSettlementNotificationReceiverServer must be embedded to have forward compatible implementations.
+type UnimplementedSettlementNotificationReceiverServer struct {
+}
+
+func (UnimplementedSettlementNotificationReceiverServer) NotifySettlement(context.Context, *NotifySettlementRequest) (*NotifySettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifySettlement not implemented")
+}
+func (UnimplementedSettlementNotificationReceiverServer) mustEmbedUnimplementedSettlementNotificationReceiverServer() {
+}
+
+// UnsafeSettlementNotificationReceiverServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SettlementNotificationReceiverServer will
+// result in compilation errors.
+type UnsafeSettlementNotificationReceiverServer interface {
+	mustEmbedUnimplementedSettlementNotificationReceiverServer()
+}
+
+func RegisterSettlementNotificationReceiverServer(s grpc.ServiceRegistrar, srv SettlementNotificationReceiverServer) {
+	s.RegisterService(&SettlementNotificationReceiver_ServiceDesc, srv)
+}
+
+func _SettlementNotificationReceiver_NotifySettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifySettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementNotificationReceiverServer).NotifySettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementNotificationReceiver_NotifySettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementNotificationReceiverServer).NotifySettlement(ctx, req.(*NotifySettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SettlementNotificationReceiver_ServiceDesc is the grpc.ServiceDesc for SettlementNotificationReceiver service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SettlementNotificationReceiver_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "custodian.v1.SettlementNotificationReceiver",
+	HandlerType: (*SettlementNotificationReceiverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NotifySettlement",
+			Handler:    _SettlementNotificationReceiver_NotifySettlement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "custodian/v1/notifications.proto",
+}
@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: exchange/v1/exchange.proto
+
+package exchangev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetTradingStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradingStatusRequest) Reset() {
+	*x = GetTradingStatusRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradingStatusRequest) ProtoMessage() {}
+
+func (x *GetTradingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradingStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetTradingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{0}
+}
+
+type GetTradingStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveTrades  int32                  `protobuf:"varint,1,opt,name=active_trades,json=activeTrades,proto3" json:"active_trades,omitempty"`
+	TotalVolume   int64                  `protobuf:"varint,2,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+	LastTradeTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_trade_time,json=lastTradeTime,proto3" json:"last_trade_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradingStatusResponse) Reset() {
+	*x = GetTradingStatusResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradingStatusResponse) ProtoMessage() {}
+
+func (x *GetTradingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradingStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetTradingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTradingStatusResponse) GetActiveTrades() int32 {
+	if x != nil {
+		return x.ActiveTrades
+	}
+	return 0
+}
+
+func (x *GetTradingStatusResponse) GetTotalVolume() int64 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+func (x *GetTradingStatusResponse) GetLastTradeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTradeTime
+	}
+	return nil
+}
+
+var File_exchange_v1_exchange_proto protoreflect.FileDescriptor
+
+const file_exchange_v1_exchange_proto_rawDesc = "" +
+	"\n" +
+	"\x1aexchange/v1/exchange.proto\x12\vexchange.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x19\n" +
+	"\x17GetTradingStatusRequest\"\xa6\x01\n" +
+	"\x18GetTradingStatusResponse\x12#\n" +
+	"\ractive_trades\x18\x01 \x01(\x05R\factiveTrades\x12!\n" +
+	"\ftotal_volume\x18\x02 \x01(\x03R\vtotalVolume\x12B\n" +
+	"\x0flast_trade_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTradeTime2{\n" +
+	"\x18ExchangeSimulatorService\x12_\n" +
+	"\x10GetTradingStatus\x12$.exchange.v1.GetTradingStatusRequest\x1a%.exchange.v1.GetTradingStatusResponseB_Z]github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/exchange/v1;exchangev1b\x06proto3"
+
+var (
+	file_exchange_v1_exchange_proto_rawDescOnce sync.Once
+	file_exchange_v1_exchange_proto_rawDescData []byte
+)
+
+func file_exchange_v1_exchange_proto_rawDescGZIP() []byte {
+	file_exchange_v1_exchange_proto_rawDescOnce.Do(func() {
+		file_exchange_v1_exchange_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_exchange_v1_exchange_proto_rawDesc), len(file_exchange_v1_exchange_proto_rawDesc)))
+	})
+	return file_exchange_v1_exchange_proto_rawDescData
+}
+
+var file_exchange_v1_exchange_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_exchange_v1_exchange_proto_goTypes = []any{
+	(*GetTradingStatusRequest)(nil),  // 0: exchange.v1.GetTradingStatusRequest
+	(*GetTradingStatusResponse)(nil), // 1: exchange.v1.GetTradingStatusResponse
+	(*timestamppb.Timestamp)(nil),    // 2: google.protobuf.Timestamp
+}
+var file_exchange_v1_exchange_proto_depIdxs = []int32{
+	2, // 0: exchange.v1.GetTradingStatusResponse.last_trade_time:type_name -> google.protobuf.Timestamp
+	0, // 1: exchange.v1.ExchangeSimulatorService.GetTradingStatus:input_type -> exchange.v1.GetTradingStatusRequest
+	1, // 2: exchange.v1.ExchangeSimulatorService.GetTradingStatus:output_type -> exchange.v1.GetTradingStatusResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_exchange_v1_exchange_proto_init() }
+func file_exchange_v1_exchange_proto_init() {
+	if File_exchange_v1_exchange_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_exchange_v1_exchange_proto_rawDesc), len(file_exchange_v1_exchange_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_exchange_v1_exchange_proto_goTypes,
+		DependencyIndexes: file_exchange_v1_exchange_proto_depIdxs,
+		MessageInfos:      file_exchange_v1_exchange_proto_msgTypes,
+	}.Build()
+	File_exchange_v1_exchange_proto = out.File
+	file_exchange_v1_exchange_proto_goTypes = nil
+	file_exchange_v1_exchange_proto_depIdxs = nil
+}
@@ -0,0 +1,112 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: exchange/v1/exchange.proto
+
+package exchangev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ExchangeSimulatorService_GetTradingStatus_FullMethodName = "/exchange.v1.ExchangeSimulatorService/GetTradingStatus"
+)
+
+// ExchangeSimulatorServiceClient is the client API for ExchangeSimulatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExchangeSimulatorServiceClient interface {
+	// GetTradingStatus reports current trading activity on the exchange
+	GetTradingStatus(ctx context.Context, in *GetTradingStatusRequest, opts ...grpc.CallOption) (*GetTradingStatusResponse, error)
+}
+
+type exchangeSimulatorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExchangeSimulatorServiceClient(cc grpc.ClientConnInterface) ExchangeSimulatorServiceClient {
+	return &exchangeSimulatorServiceClient{cc}
+}
+
+func (c *exchangeSimulatorServiceClient) GetTradingStatus(ctx context.Context, in *GetTradingStatusRequest, opts ...grpc.CallOption) (*GetTradingStatusResponse, error) {
+	out := new(GetTradingStatusResponse)
+	err := c.cc.Invoke(ctx, ExchangeSimulatorService_GetTradingStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExchangeSimulatorServiceServer is the server API for ExchangeSimulatorService service.
+// All implementations must embed UnimplementedExchangeSimulatorServiceServer
+// for forward compatibility
+type ExchangeSimulatorServiceServer interface {
+	// GetTradingStatus reports current trading activity on the exchange
+	GetTradingStatus(context.Context, *GetTradingStatusRequest) (*GetTradingStatusResponse, error)
+	mustEmbedUnimplementedExchangeSimulatorServiceServer()
+}
+
+// UnimplementedExchangeSimulatorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExchangeSimulatorServiceServer struct {
+}
+
+func (UnimplementedExchangeSimulatorServiceServer) GetTradingStatus(context.Context, *GetTradingStatusRequest) (*GetTradingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradingStatus not implemented")
+}
+func (UnimplementedExchangeSimulatorServiceServer) mustEmbedUnimplementedExchangeSimulatorServiceServer() {
+}
+
+// UnsafeExchangeSimulatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExchangeSimulatorServiceServer will
+// result in compilation errors.
+type UnsafeExchangeSimulatorServiceServer interface {
+	mustEmbedUnimplementedExchangeSimulatorServiceServer()
+}
+
+func RegisterExchangeSimulatorServiceServer(s grpc.ServiceRegistrar, srv ExchangeSimulatorServiceServer) {
+	s.RegisterService(&ExchangeSimulatorService_ServiceDesc, srv)
+}
+
+func _ExchangeSimulatorService_GetTradingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeSimulatorServiceServer).GetTradingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeSimulatorService_GetTradingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeSimulatorServiceServer).GetTradingStatus(ctx, req.(*GetTradingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExchangeSimulatorService_ServiceDesc is the grpc.ServiceDesc for ExchangeSimulatorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExchangeSimulatorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "exchange.v1.ExchangeSimulatorService",
+	HandlerType: (*ExchangeSimulatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTradingStatus",
+			Handler:    _ExchangeSimulatorService_GetTradingStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exchange/v1/exchange.proto",
+}
@@ -0,0 +1,31 @@
+// Command gencerts writes a throwaway CA and per-service certificates for running
+// the trading ecosystem locally with mutual TLS enabled
+package main
+
+import (
+	"flag"
+	"log"
+	"strings"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/security"
+)
+
+func main() {
+	outDir := flag.String("out", "certs", "directory to write ca.crt/ca.key and <name>.crt/<name>.key")
+	names := flag.String("names", "custodian-simulator,exchange-simulator,risk-monitor,audit-correlator",
+		"comma-separated service identities to issue certificates for")
+	flag.Parse()
+
+	var identities []string
+	for _, name := range strings.Split(*names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			identities = append(identities, name)
+		}
+	}
+
+	if err := security.WriteTestPKI(*outDir, identities); err != nil {
+		log.Fatalf("failed to generate test certificates: %v", err)
+	}
+
+	log.Printf("wrote test CA and %d certificates to %s", len(identities), *outDir)
+}
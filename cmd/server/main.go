@@ -2,24 +2,32 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
-	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
 	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
 	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/handlers"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/audit"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/compliance"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/eventbus"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/notifications"
 	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/security"
+	grpcserver "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/presentation/grpc"
 	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
 )
 
@@ -59,19 +67,111 @@ func main() {
 
 	custodianService := services.NewCustodianService(cfg, logger)
 
-	grpcServer := setupGRPCServer(cfg, custodianService, logger)
-	httpServer := setupHTTPServer(cfg, custodianService, logger)
+	calendars, err := services.NewBusinessCalendars(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to configure business calendars")
+	}
+	custodianService.SetBusinessCalendars(calendars)
+
+	approvalPolicy, err := services.NewApprovalPolicy(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to configure approval policy")
+	}
+	if approvalPolicy != nil && !cfg.TLSEnabled {
+		// Approvers are identified by their client certificate, so without TLS no
+		// held operation could ever be approved or rejected
+		logger.Fatal("Approval policies need TLS_ENABLED to identify approvers")
+	}
+	custodianService.SetApprovalPolicy(approvalPolicy)
+
+	transferLimits, err := services.NewTransferLimits(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to configure transfer limits")
+	}
+	custodianService.SetTransferLimits(transferLimits)
+
+	chainSimulation, err := services.NewChainSimulation(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to configure chain simulation")
+	}
+	custodianService.SetChainSimulation(chainSimulation)
+
+	fiatRails, err := services.NewFiatRails(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to configure fiat rails")
+	}
+	custodianService.SetFiatRails(fiatRails)
+
+	walletTiering, err := services.NewWalletTiering(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to configure wallet tiers")
+	}
+	custodianService.SetWalletTiering(walletTiering)
+
+	if cfg.ComplianceScreeningEnabled {
+		screener, err := compliance.NewListScreener(cfg, logger)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to configure compliance screening")
+		}
+		custodianService.SetComplianceScreener(screener)
+	}
+
+	peers := &interServiceClients{cfg: cfg, logger: logger}
+
+	notifier, stopNotifier := setupSettlementNotifier(cfg, logger, peers)
+	if notifier != nil {
+		custodianService.SetSettlementNotifier(notifier)
+	}
+
+	recorder := setupAuditRecorder(cfg, logger, peers)
+	if recorder != nil {
+		custodianService.SetAuditPort(recorder)
+	}
+
+	eventPublisher := setupEventPublisher(cfg, logger)
+	if eventPublisher != nil {
+		custodianService.SetDomainEventPublisher(eventPublisher)
+	}
+
+	settlementScheduler := services.NewSettlementScheduler(custodianService, cfg.SettlementSchedulerInterval, logger)
+	settlementScheduler.Start()
+
+	tradeConsumer := setupTradeConsumer(cfg, custodianService, logger)
+
+	grpcOpts, policy := setupTransportSecurity(cfg, logger)
+
+	grpcServer := grpcserver.NewCustodianGRPCServerWithDependencies(cfg, custodianService, logger, metricsPort, grpcOpts...)
+	addReadinessChecks(grpcServer, cfg, settlementScheduler, eventPublisher)
+
+	// JSON transcoding of the gRPC API, driven by the google.api.http proto annotations
+	gateway, err := grpcserver.NewHTTPGateway(grpcServer, logger, policy)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to build HTTP gateway")
+	}
+
+	httpServer := setupHTTPServer(cfg, custodianService, logger, policy, gateway, notifier)
+
+	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to listen on gRPC port")
+	}
 
 	go func() {
 		logger.WithField("port", cfg.GRPCPort).Info("Starting gRPC server")
-		if err := startGRPCServer(grpcServer, cfg.GRPCPort); err != nil {
+		if err := grpcServer.Serve(grpcListener); err != nil {
 			logger.WithError(err).Fatal("Failed to start gRPC server")
 		}
 	}()
 
 	go func() {
 		logger.WithField("port", cfg.HTTPPort).Info("Starting HTTP server")
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if httpServer.TLSConfig != nil {
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			logger.WithError(err).Fatal("Failed to start HTTP server")
 		}
 	}()
@@ -85,30 +185,256 @@ func main() {
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer shutdownCancel()
 
+	// Stop accepting traffic first so in-flight requests can still use the data adapter
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.WithError(err).Error("HTTP server forced to shutdown")
+	}
+
+	if err := grpcServer.Shutdown(shutdownCtx); err != nil {
+		logger.WithError(err).Error("gRPC server forced to shutdown")
+	}
+
+	// Stop creating and settling instructions before tearing down their outputs
+	if tradeConsumer != nil {
+		tradeConsumer.Stop()
+	}
+	settlementScheduler.Stop()
+
+	// Settlements have stopped, so no new notifications; pending ones stay in the outbox
+	stopNotifier(shutdownCtx)
+	if recorder != nil {
+		recorder.Stop()
+	}
+	if eventPublisher != nil {
+		eventPublisher.Stop()
+	}
+	peers.cleanup(shutdownCtx)
+
 	// Disconnect DataAdapter
 	if err := cfg.DisconnectDataAdapter(shutdownCtx); err != nil {
 		logger.WithError(err).Error("Failed to disconnect data adapter")
 	}
 
-	if err := httpServer.Shutdown(shutdownCtx); err != nil {
-		logger.WithError(err).Error("HTTP server forced to shutdown")
+	logger.Info("Servers shutdown complete")
+}
+
+// addReadinessChecks takes the gRPC server out of rotation while the settlement
+// scheduler is not running, the event stream's Redis is unreachable or the data
+// adapter reports itself unhealthy. Stub mode, without a data adapter, serves from
+// memory and stays ready.
+func addReadinessChecks(grpcServer *grpcserver.CustodianGRPCServer, cfg *config.Config, scheduler *services.SettlementScheduler, eventPublisher *eventbus.StreamPublisher) {
+	grpcServer.AddReadinessCheck("settlement_scheduler", scheduler.CheckRunning)
+
+	if eventPublisher != nil {
+		grpcServer.AddReadinessCheck("event_stream", eventPublisher.Ping)
 	}
 
-	grpcServer.GracefulStop()
-	logger.Info("Servers shutdown complete")
+	// The adapter interface has no probe of its own; use one when the adapter offers it
+	if probe, ok := cfg.GetDataAdapter().(interface{ HealthCheck(context.Context) error }); ok {
+		grpcServer.AddReadinessCheck("data_adapter", probe.HealthCheck)
+	}
 }
 
-func setupGRPCServer(cfg *config.Config, custodianService *services.CustodianService, logger *logrus.Logger) *grpc.Server {
-	server := grpc.NewServer()
+// setupTransportSecurity loads mTLS credentials and the client authorization policy
+// when TLS is enabled; otherwise both servers run in plaintext without authorization
+func setupTransportSecurity(cfg *config.Config, logger *logrus.Logger) ([]grpc.ServerOption, *security.Policy) {
+	if !cfg.TLSEnabled {
+		logger.Warn("TLS disabled, gRPC and HTTP servers accept unauthenticated clients")
+		return nil, nil
+	}
 
-	healthServer := health.NewServer()
-	grpc_health_v1.RegisterHealthServer(server, healthServer)
-	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	creds, err := security.ServerCredentials(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to load TLS credentials")
+	}
 
-	return server
+	policy, err := security.ParsePolicy(cfg.AuthorizationPolicy)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to parse authorization policy")
+	}
+
+	logger.WithFields(logrus.Fields{
+		"cert":    cfg.TLSCertFile,
+		"ca":      cfg.TLSCAFile,
+		"clients": policy.Clients(),
+	}).Info("Mutual TLS and client authorization enabled")
+
+	return []grpc.ServerOption{
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(grpcserver.AuthorizationUnaryInterceptor(policy, logger)),
+		grpc.ChainStreamInterceptor(grpcserver.AuthorizationStreamInterceptor(policy, logger)),
+	}, policy
+}
+
+// setupSettlementNotifier builds the outbound settlement notifier from the configured
+// peers and webhooks. It returns a nil notifier when no targets are configured; the
+// returned stop function is always safe to call.
+func setupSettlementNotifier(cfg *config.Config, logger *logrus.Logger, peers *interServiceClients) (*notifications.SettlementNotifier, func(context.Context)) {
+	noop := func(context.Context) {}
+
+	targets, err := notifications.ParseWebhooks(cfg.NotificationWebhooks, &http.Client{Timeout: cfg.NotificationDeliveryTimeout})
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to parse notification webhooks")
+	}
+
+	for _, peer := range strings.Split(cfg.NotificationPeers, ",") {
+		peer = strings.TrimSpace(peer)
+		if peer == "" {
+			continue
+		}
+		// Deliveries to peers fail and are retried until discovery is reachable
+		targets = append(targets, notifications.NewPeerTarget(peer, peers.manager()))
+	}
+
+	if len(targets) == 0 {
+		logger.Info("No settlement notification targets configured")
+		return nil, noop
+	}
+
+	outbox, err := notifications.OpenOutbox(cfg.NotificationOutboxPath)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to open notification outbox")
+	}
+
+	notifier := notifications.NewSettlementNotifier(cfg, outbox, targets, logger)
+	notifier.Start()
+
+	return notifier, func(ctx context.Context) {
+		notifier.Stop()
+		if err := outbox.Close(); err != nil {
+			logger.WithError(err).Error("Failed to close notification outbox")
+		}
+	}
+}
+
+// setupAuditRecorder builds the audit recorder for the configured sink, or returns
+// nil when auditing is disabled
+func setupAuditRecorder(cfg *config.Config, logger *logrus.Logger, peers *interServiceClients) *audit.Recorder {
+	var sink audit.Sink
+	switch cfg.AuditSink {
+	case "", "none":
+		logger.Warn("Audit trail disabled")
+		return nil
+	case "jsonl":
+		jsonl, err := audit.OpenJSONLSink(cfg.AuditJSONLPath)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to open audit log")
+		}
+		sink = jsonl
+	case "redis":
+		opt, err := redis.ParseURL(cfg.RedisURL)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to parse Redis URL for audit stream")
+		}
+		sink = audit.NewRedisStreamSink(redis.NewClient(opt), cfg.AuditRedisStream, cfg.AuditRedisMaxLen)
+	case "grpc":
+		sink = audit.NewGRPCSink(peers.manager())
+	default:
+		logger.WithField("sink", cfg.AuditSink).Fatal("Unknown audit sink")
+	}
+
+	recorder := audit.NewRecorder(cfg, sink, logger)
+	recorder.Start()
+	logger.WithField("sink", sink.Name()).Info("Audit trail enabled")
+
+	return recorder
+}
+
+// setupEventPublisher builds the domain event publisher on the configured Redis
+// stream, or returns nil when publishing is disabled
+func setupEventPublisher(cfg *config.Config, logger *logrus.Logger) *eventbus.StreamPublisher {
+	if !cfg.EventStreamEnabled {
+		logger.Info("Domain event stream disabled")
+		return nil
+	}
+
+	opt, err := redis.ParseURL(cfg.RedisURL)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to parse Redis URL for event stream")
+	}
+
+	publisher := eventbus.NewStreamPublisher(cfg, redis.NewClient(opt), logger)
+
+	var groups []string
+	for _, group := range strings.Split(cfg.EventStreamGroups, ",") {
+		if group = strings.TrimSpace(group); group != "" {
+			groups = append(groups, group)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.EventStreamWriteTimeout)
+	defer cancel()
+	if err := publisher.EnsureGroups(ctx, groups); err != nil {
+		// Events are still published; consumers can create their groups themselves
+		logger.WithError(err).Warn("Failed to create event stream consumer groups")
+	}
+
+	publisher.Start()
+	logger.WithField("stream", publisher.Stream()).Info("Publishing domain events")
+
+	return publisher
+}
+
+// setupTradeConsumer starts consuming exchange trade events into settlement
+// instructions, or returns nil when ingestion is disabled
+func setupTradeConsumer(cfg *config.Config, custodianService *services.CustodianService, logger *logrus.Logger) *eventbus.TradeStreamConsumer {
+	if !cfg.TradeIngestionEnabled {
+		return nil
+	}
+
+	ingestor, err := services.NewTradeSettlementIngestor(custodianService, cfg, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to configure trade ingestion")
+	}
+
+	opt, err := redis.ParseURL(cfg.RedisURL)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to parse Redis URL for trade stream")
+	}
+
+	consumer := eventbus.NewTradeStreamConsumer(cfg, redis.NewClient(opt), ingestor, logger)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.RequestTimeout)
+	defer cancel()
+	if err := consumer.Start(ctx); err != nil {
+		logger.WithError(err).Fatal("Failed to start trade ingestion")
+	}
+
+	logger.WithFields(logrus.Fields{
+		"stream": cfg.TradeStreamName,
+		"group":  cfg.TradeStreamGroup,
+	}).Info("Consuming exchange trade events")
+
+	return consumer
+}
+
+// interServiceClients creates the inter-service client manager on first use so it
+// is shared by every component that calls peers, and only exists when one does
+type interServiceClients struct {
+	cfg    *config.Config
+	logger *logrus.Logger
+	mgr    *infrastructure.DefaultInterServiceClientManager
 }
 
-func setupHTTPServer(cfg *config.Config, custodianService *services.CustodianService, logger *logrus.Logger) *http.Server {
+func (c *interServiceClients) manager() *infrastructure.DefaultInterServiceClientManager {
+	if c.mgr == nil {
+		c.mgr = infrastructure.NewInterServiceClientManager(c.cfg)
+		if err := c.mgr.Initialize(context.Background()); err != nil {
+			// Calls fail (and are retried where the caller retries) until discovery is reachable
+			c.logger.WithError(err).Warn("Service discovery unavailable for inter-service clients")
+		}
+	}
+	return c.mgr
+}
+
+func (c *interServiceClients) cleanup(ctx context.Context) {
+	if c.mgr == nil {
+		return
+	}
+	if err := c.mgr.Cleanup(ctx); err != nil {
+		c.logger.WithError(err).Error("Failed to clean up inter-service clients")
+	}
+}
+
+func setupHTTPServer(cfg *config.Config, custodianService *services.CustodianService, logger *logrus.Logger, policy *security.Policy, gateway *grpcserver.HTTPGateway, notifier *notifications.SettlementNotifier) *http.Server {
 	router := gin.New()
 	router.Use(gin.Recovery())
 
@@ -122,25 +448,46 @@ func setupHTTPServer(cfg *config.Config, custodianService *services.CustodianSer
 	healthHandler := handlers.NewHealthHandlerWithConfig(cfg, logger)
 	metricsHandler := handlers.NewMetricsHandler(metricsPort)
 
+	// Health, readiness and metrics stay reachable for probes and scrapers
 	v1 := router.Group("/api/v1")
 	{
 		v1.GET("/health", healthHandler.Health)
 		v1.GET("/ready", healthHandler.Ready)
+
+		if notifier != nil {
+			notificationHandler := handlers.NewNotificationHandler(notifier)
+			notifications := v1.Group("/notifications")
+			if policy != nil {
+				notifications.Use(security.AuthorizationMiddleware(policy, logger, security.PermissionRead))
+			}
+			notifications.GET("", notificationHandler.List)
+			notifications.GET("/:id", notificationHandler.Get)
+		}
 	}
 
 	// Metrics endpoint (outside v1 group, at root level)
 	router.GET("/metrics", metricsHandler.Metrics)
 
-	return &http.Server{
+	// gRPC methods as JSON; annotation paths are absolute so register on the root router
+	gateway.Register(router)
+	logger.WithField("routes", gateway.Routes()).Info("HTTP gateway routes registered")
+
+	server := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.HTTPPort),
 		Handler: router,
 	}
-}
+	server.RegisterOnShutdown(gateway.Close)
 
-func startGRPCServer(server *grpc.Server, port int) error {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
-	if err != nil {
-		return err
+	if cfg.TLSEnabled {
+		tlsConfig, err := security.NewServerTLSConfig(cfg)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to load HTTP TLS configuration")
+		}
+		// Probes and scrapers may connect without a certificate; the gateway and the
+		// notification routes still reject anonymous callers
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		server.TLSConfig = tlsConfig
 	}
-	return server.Serve(lis)
-}
\ No newline at end of file
+
+	return server
+}
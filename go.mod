@@ -9,7 +9,9 @@ require (
 	github.com/quantfidential/trading-ecosystem/custodian-data-adapter-go v0.0.0-00010101000000-000000000000
 	github.com/redis/go-redis/v9 v9.15.0
 	github.com/sirupsen/logrus v1.9.3
+	google.golang.org/genproto/googleapis/api v0.0.0-20230822172742-b8732ec3820d
 	google.golang.org/grpc v1.58.3
+	google.golang.org/protobuf v1.36.8
 )
 
 replace github.com/quantfidential/trading-ecosystem/custodian-data-adapter-go => ../custodian-data-adapter-go
@@ -48,7 +50,7 @@ require (
 	golang.org/x/net v0.43.0 // indirect
 	golang.org/x/sys v0.35.0 // indirect
 	golang.org/x/text v0.28.0 // indirect
+	google.golang.org/genproto v0.0.0-20230803162519-f966b187b2e5 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250707201910-8d1bb00bc6a7 // indirect
-	google.golang.org/protobuf v1.36.8 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
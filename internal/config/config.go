@@ -24,6 +24,7 @@ type Config struct {
 	// Network
 	HTTPPort                int
 	GRPCPort                int
+	GRPCReflectionEnabled   bool // Expose gRPC server reflection (for grpcurl and similar tools)
 
 	// Configuration
 	LogLevel                string
@@ -34,6 +35,135 @@ type Config struct {
 	CacheTTL                time.Duration
 	HealthCheckInterval     time.Duration
 
+	// Account event streaming
+	AccountEventBufferSize  int // Number of recent events kept for subscriber resume
+
+	// Transport security (mutual TLS for gRPC and HTTP, in and out)
+	TLSEnabled              bool
+	TLSCertFile             string
+	TLSKeyFile              string
+	TLSCAFile               string
+	AuthorizationPolicy     string // e.g. "exchange-simulator=read|write;risk-monitor=read"
+
+	// Inter-service resilience
+	CircuitBreakerFailureThreshold int           // Consecutive failures that open a peer's breaker
+	CircuitBreakerOpenTimeout      time.Duration // Time a breaker stays open before probing
+	CircuitBreakerHalfOpenMaxCalls int           // Probe calls allowed while half-open
+	RetryMaxAttempts               int           // Attempts per idempotent call, including the first
+	RetryInitialBackoff            time.Duration
+	RetryMaxBackoff                time.Duration
+
+	// Peer instance selection
+	LoadBalancingStrategy    string        // "round_robin" or "least_requests"
+	ServiceInstanceMaxAge    time.Duration // Instances whose LastSeen is older are skipped
+	DiscoveryRefreshInterval time.Duration // How often peer instance lists are re-resolved
+
+	// Inter-service connection pooling
+	ConnectionPoolSize    int           // Maximum gRPC connections per peer service
+	ConnectionIdleTimeout time.Duration // Pooled connections unused this long are closed
+
+	// Settlement notifications
+	NotificationPeers               string // Comma-separated peers implementing SettlementNotificationReceiver
+	NotificationWebhooks            string // "name=url;name=url"
+	NotificationOutboxPath          string // JSON-lines outbox; empty keeps notifications in memory
+	NotificationMaxAttempts         int
+	NotificationRetryInitialBackoff time.Duration
+	NotificationRetryMaxBackoff     time.Duration
+	NotificationPollInterval        time.Duration
+	NotificationDeliveryTimeout     time.Duration
+
+	// Audit trail
+	AuditSink         string // none, jsonl, redis or grpc (audit-correlator)
+	AuditJSONLPath    string
+	AuditRedisStream  string
+	AuditRedisMaxLen  int64 // Approximate stream length cap; 0 disables trimming
+	AuditBufferSize   int   // Queued events beyond this are dropped rather than blocking
+	AuditWriteTimeout time.Duration
+
+	// Domain event stream
+	EventStreamEnabled      bool
+	EventStreamName         string // Defaults to custodian:events:<instance name>
+	EventStreamMaxLen       int64  // Approximate stream length cap; 0 disables trimming
+	EventStreamGroups       string // Consumer groups created at startup, comma-separated
+	EventStreamBufferSize   int    // As AuditBufferSize, for the domain event queue
+	EventStreamWriteTimeout time.Duration
+
+	// Settlement instructions
+	SettlementSchedulerInterval time.Duration // How often instructions that have come due are settled
+	SettlementFailDeadline      time.Duration // How long after its settlement date a failed settlement is retried; 0 disables retries
+	SettlementBilateralApproval bool          // Amendments and cancellations need the other counterparty's approval
+
+	// Bilateral settlement matching
+	SettlementMatchingRequired bool          // Refuse settlements between accounts instructed by one side alone
+	MatchingAmountTolerance    float64       // Largest relative amount difference that still matches
+	MatchingDateTolerance      time.Duration // Largest settlement date difference that still matches; 0 requires the same UTC date
+
+	// Approval policies for transfers and withdrawals; disabled without approvers
+	ApprovalApprovers         string        // Comma-separated approver identities (M)
+	ApprovalQuorum            int           // Approvals needed (N)
+	ApprovalThresholds        string        // "ASSET=amount;default=amount"; operations at or above need approval
+	ApprovalAccountQuorums    string        // "ACCOUNT=n;..." overriding the quorum
+	ApprovalAccountThresholds string        // "ACCOUNT:ASSET=amount;..." overriding the thresholds
+	ApprovalExpiry            time.Duration // Pending approvals expire after this; 0 never expires
+
+	// Withdrawal address whitelisting
+	WithdrawalAddressCoolingOff time.Duration // How long a newly added address waits before it can be used
+
+	// Transfer limits; "ASSET=amount;default=amount", with "ACCOUNT:ASSET" keys overriding per account
+	TransferLimitPerTransaction string        // Largest single settlement or withdrawal
+	TransferLimitDaily          string        // Largest total sent per UTC day
+	TransferLimitRolling        string        // Largest total sent within TransferLimitRollingWindow
+	TransferLimitRollingWindow  time.Duration
+
+	// Compliance screening of transfers and withdrawals
+	ComplianceScreeningEnabled        bool
+	ComplianceSanctionedAddressesFile string // One address per line; # starts a comment
+	ComplianceSanctionedEntitiesFile  string // One account ID or name per line; # starts a comment
+	ComplianceTravelRuleThresholds    string // "ASSET=amount;default=amount"; larger operations without travel rule data are held
+
+	// Simulated blockchains for crypto deposits and withdrawals
+	ChainSimulationEnabled bool
+	ChainNetworks          string  // Comma-separated networks, e.g. "BTC,ETH"
+	ChainBlockIntervals    string  // "NETWORK=duration;..."
+	ChainConfirmations     string  // "NETWORK=n;..."; blocks before a transaction is final
+	ChainFees              string  // "NETWORK=amount;..."; charged per withdrawal
+	ChainMempoolInclusion  float64 // Chance a mempool transaction makes the next block
+	ChainReorgProbability  float64 // Chance a block is preceded by a reorg
+	ChainReorgMaxDepth     int
+	ChainSeed              int // 0 seeds from the clock
+
+	// Simulated fiat rails (UTC, on the business calendar of each asset)
+	FiatRailsEnabled       bool
+	FiatRailAssets         string  // Comma-separated assets that move over the rails
+	FiatRailDefault        string  // Rail used when a withdrawal names none
+	FiatRailBatchWindows   string  // "RAIL=HH:MM,HH:MM;..."; also defines the rails
+	FiatRailSettlementDays string  // "RAIL=n;..."; business days from batch to settlement
+	FiatRailReturnAccounts string  // "BANK_ACCOUNT=CODE;..."; payments to or from these are returned
+	FiatRailReturnRate     float64 // Chance any other payment is returned
+	FiatRailSeed           int     // 0 seeds from the clock
+
+	// Hot, warm and cold wallet tiers
+	WalletTieringEnabled    bool
+	WalletTierAssets        string  // Comma-separated assets held across the tiers
+	WalletTierTargets       string  // "ASSET=hot,warm,cold;default=hot,warm,cold" in percent
+	WalletTierTransferTimes string  // "TIER=duration;..."; how long a move out of each tier takes
+	WalletTierTolerance     float64 // Drift, as a fraction of holdings, that triggers a rebalance
+
+	// Business calendars (UTC); assets not listed settle every day with no cut-off
+	BusinessDayAssets  string // Comma-separated assets that settle Monday to Friday only
+	SettlementCutOffs  string // "ASSET=HH:MM;..."; later submissions roll to the next business day
+	SettlementHolidays string // "ASSET=YYYY-MM-DD,YYYY-MM-DD;..."
+
+	// Exchange trade ingestion
+	TradeIngestionEnabled   bool
+	TradeStreamName         string // Exchange stream carrying TradeExecuted / OrderFilled events
+	TradeStreamGroup        string
+	TradeStreamBatchSize    int
+	TradeStreamBlockTimeout time.Duration
+	TradeAccountMap         string // "exchangeAccount=custodianAccount;..."; unmapped IDs are used as-is
+	TradeSettlementCycles   string // "ASSET=days;...", with "default" for other assets
+	TradeDedupeWindow       int    // Number of recent trade IDs remembered for deduplication
+
 	// Data Adapter
 	dataAdapter adapters.DataAdapter
 
@@ -52,6 +182,7 @@ func Load() *Config {
 		Environment:             getEnv("ENVIRONMENT", "development"),
 		HTTPPort:                getEnvAsInt("HTTP_PORT", 8080),
 		GRPCPort:                getEnvAsInt("GRPC_PORT", 50051),
+		GRPCReflectionEnabled:   getEnvAsBool("GRPC_REFLECTION_ENABLED", false),
 		LogLevel:                getEnv("LOG_LEVEL", "info"),
 		PostgresURL:             getEnv("POSTGRES_URL", ""),
 		RedisURL:                getEnv("REDIS_URL", "redis://localhost:6379"),
@@ -59,6 +190,133 @@ func Load() *Config {
 		RequestTimeout:          getEnvAsDuration("REQUEST_TIMEOUT", 5*time.Second),
 		CacheTTL:                getEnvAsDuration("CACHE_TTL", 5*time.Minute),
 		HealthCheckInterval:     getEnvAsDuration("HEALTH_CHECK_INTERVAL", 30*time.Second),
+		AccountEventBufferSize:  getEnvAsInt("ACCOUNT_EVENT_BUFFER_SIZE", 10000),
+
+		// Inter-service resilience
+		CircuitBreakerFailureThreshold: getEnvAsInt("CIRCUIT_BREAKER_FAILURE_THRESHOLD", 5),
+		CircuitBreakerOpenTimeout:      getEnvAsDuration("CIRCUIT_BREAKER_OPEN_TIMEOUT", 30*time.Second),
+		CircuitBreakerHalfOpenMaxCalls: getEnvAsInt("CIRCUIT_BREAKER_HALF_OPEN_MAX_CALLS", 1),
+		RetryMaxAttempts:               getEnvAsInt("RETRY_MAX_ATTEMPTS", 3),
+		RetryInitialBackoff:            getEnvAsDuration("RETRY_INITIAL_BACKOFF", 100*time.Millisecond),
+		RetryMaxBackoff:                getEnvAsDuration("RETRY_MAX_BACKOFF", 2*time.Second),
+
+		// Peer instance selection
+		LoadBalancingStrategy:    getEnv("LOAD_BALANCING_STRATEGY", "round_robin"),
+		ServiceInstanceMaxAge:    getEnvAsDuration("SERVICE_INSTANCE_MAX_AGE", 90*time.Second),
+		DiscoveryRefreshInterval: getEnvAsDuration("DISCOVERY_REFRESH_INTERVAL", 15*time.Second),
+
+		// Inter-service connection pooling
+		ConnectionPoolSize:    getEnvAsInt("CONNECTION_POOL_SIZE", 4),
+		ConnectionIdleTimeout: getEnvAsDuration("CONNECTION_IDLE_TIMEOUT", 5*time.Minute),
+
+		// Settlement notifications
+		NotificationPeers:               getEnv("NOTIFICATION_PEERS", ""),
+		NotificationWebhooks:            getEnv("NOTIFICATION_WEBHOOKS", ""),
+		NotificationOutboxPath:          getEnv("NOTIFICATION_OUTBOX_PATH", "data/notification-outbox.jsonl"),
+		NotificationMaxAttempts:         getEnvAsInt("NOTIFICATION_MAX_ATTEMPTS", 10),
+		NotificationRetryInitialBackoff: getEnvAsDuration("NOTIFICATION_RETRY_INITIAL_BACKOFF", time.Second),
+		NotificationRetryMaxBackoff:     getEnvAsDuration("NOTIFICATION_RETRY_MAX_BACKOFF", 5*time.Minute),
+		NotificationPollInterval:        getEnvAsDuration("NOTIFICATION_POLL_INTERVAL", time.Second),
+		NotificationDeliveryTimeout:     getEnvAsDuration("NOTIFICATION_DELIVERY_TIMEOUT", 5*time.Second),
+
+		// Audit trail
+		AuditSink:         getEnv("AUDIT_SINK", "jsonl"),
+		AuditJSONLPath:    getEnv("AUDIT_JSONL_PATH", "data/audit.jsonl"),
+		AuditRedisStream:  getEnv("AUDIT_REDIS_STREAM", "audit:custodian"),
+		AuditRedisMaxLen:  int64(getEnvAsInt("AUDIT_REDIS_MAX_LEN", 100000)),
+		AuditBufferSize:   getEnvAsInt("AUDIT_BUFFER_SIZE", 1024),
+		AuditWriteTimeout: getEnvAsDuration("AUDIT_WRITE_TIMEOUT", 5*time.Second),
+
+		// Domain event stream
+		EventStreamEnabled:      getEnvAsBool("EVENT_STREAM_ENABLED", false),
+		EventStreamName:         getEnv("EVENT_STREAM_NAME", ""),
+		EventStreamMaxLen:       int64(getEnvAsInt("EVENT_STREAM_MAX_LEN", 100000)),
+		EventStreamGroups:       getEnv("EVENT_STREAM_GROUPS", ""),
+		EventStreamBufferSize:   getEnvAsInt("EVENT_STREAM_BUFFER_SIZE", 4096),
+		EventStreamWriteTimeout: getEnvAsDuration("EVENT_STREAM_WRITE_TIMEOUT", 5*time.Second),
+
+		// Settlement instructions
+		SettlementSchedulerInterval: getEnvAsDuration("SETTLEMENT_SCHEDULER_INTERVAL", time.Second),
+		SettlementFailDeadline:      getEnvAsDuration("SETTLEMENT_FAIL_DEADLINE", 72*time.Hour),
+		SettlementBilateralApproval: getEnvAsBool("SETTLEMENT_BILATERAL_APPROVAL", false),
+
+		// Bilateral settlement matching
+		SettlementMatchingRequired: getEnvAsBool("SETTLEMENT_MATCHING_REQUIRED", false),
+		MatchingAmountTolerance:    getEnvAsFloat("MATCHING_AMOUNT_TOLERANCE", 0.0001),
+		MatchingDateTolerance:      getEnvAsDuration("MATCHING_DATE_TOLERANCE", 0),
+
+		// Approval policies
+		ApprovalApprovers:         getEnv("APPROVAL_APPROVERS", ""),
+		ApprovalQuorum:            getEnvAsInt("APPROVAL_QUORUM", 2),
+		ApprovalThresholds:        getEnv("APPROVAL_THRESHOLDS", ""),
+		ApprovalAccountQuorums:    getEnv("APPROVAL_ACCOUNT_QUORUMS", ""),
+		ApprovalAccountThresholds: getEnv("APPROVAL_ACCOUNT_THRESHOLDS", ""),
+		ApprovalExpiry:            getEnvAsDuration("APPROVAL_EXPIRY", 24*time.Hour),
+
+		// Withdrawal address whitelisting
+		WithdrawalAddressCoolingOff: getEnvAsDuration("WITHDRAWAL_ADDRESS_COOLING_OFF", 24*time.Hour),
+
+		// Transfer limits
+		TransferLimitPerTransaction: getEnv("TRANSFER_LIMIT_PER_TRANSACTION", ""),
+		TransferLimitDaily:          getEnv("TRANSFER_LIMIT_DAILY", ""),
+		TransferLimitRolling:        getEnv("TRANSFER_LIMIT_ROLLING", ""),
+		TransferLimitRollingWindow:  getEnvAsDuration("TRANSFER_LIMIT_ROLLING_WINDOW", time.Hour),
+
+		// Compliance screening
+		ComplianceScreeningEnabled:        getEnvAsBool("COMPLIANCE_SCREENING_ENABLED", false),
+		ComplianceSanctionedAddressesFile: getEnv("COMPLIANCE_SANCTIONED_ADDRESSES_FILE", ""),
+		ComplianceSanctionedEntitiesFile:  getEnv("COMPLIANCE_SANCTIONED_ENTITIES_FILE", ""),
+		ComplianceTravelRuleThresholds:    getEnv("COMPLIANCE_TRAVEL_RULE_THRESHOLDS", ""),
+
+		// Simulated blockchains
+		ChainSimulationEnabled: getEnvAsBool("CHAIN_SIMULATION_ENABLED", false),
+		ChainNetworks:          getEnv("CHAIN_NETWORKS", "BTC,ETH"),
+		ChainBlockIntervals:    getEnv("CHAIN_BLOCK_INTERVALS", "BTC=1m;ETH=12s"),
+		ChainConfirmations:     getEnv("CHAIN_CONFIRMATIONS", "BTC=3;ETH=12"),
+		ChainFees:              getEnv("CHAIN_FEES", "BTC=0.0001;ETH=0.001"),
+		ChainMempoolInclusion:  getEnvAsFloat("CHAIN_MEMPOOL_INCLUSION", 0.9),
+		ChainReorgProbability:  getEnvAsFloat("CHAIN_REORG_PROBABILITY", 0.02),
+		ChainReorgMaxDepth:     getEnvAsInt("CHAIN_REORG_MAX_DEPTH", 2),
+		ChainSeed:              getEnvAsInt("CHAIN_SEED", 0),
+
+		// Simulated fiat rails
+		FiatRailsEnabled:       getEnvAsBool("FIAT_RAILS_ENABLED", false),
+		FiatRailAssets:         getEnv("FIAT_RAIL_ASSETS", "USD"),
+		FiatRailDefault:        getEnv("FIAT_RAIL_DEFAULT", "fedwire"),
+		FiatRailBatchWindows:   getEnv("FIAT_RAIL_BATCH_WINDOWS", "fedwire=09:00,12:00,15:00,18:00;ach=10:00,16:00"),
+		FiatRailSettlementDays: getEnv("FIAT_RAIL_SETTLEMENT_DAYS", "fedwire=0;ach=1"),
+		FiatRailReturnAccounts: getEnv("FIAT_RAIL_RETURN_ACCOUNTS", ""),
+		FiatRailReturnRate:     getEnvAsFloat("FIAT_RAIL_RETURN_RATE", 0),
+		FiatRailSeed:           getEnvAsInt("FIAT_RAIL_SEED", 0),
+
+		// Wallet tiers
+		WalletTieringEnabled:    getEnvAsBool("WALLET_TIERING_ENABLED", false),
+		WalletTierAssets:        getEnv("WALLET_TIER_ASSETS", "BTC,ETH"),
+		WalletTierTargets:       getEnv("WALLET_TIER_TARGETS", "default=5,15,80"),
+		WalletTierTransferTimes: getEnv("WALLET_TIER_TRANSFER_TIMES", "hot=5m;warm=1h;cold=24h"),
+		WalletTierTolerance:     getEnvAsFloat("WALLET_TIER_TOLERANCE", 0.02),
+
+		// Business calendars
+		BusinessDayAssets:  getEnv("BUSINESS_DAY_ASSETS", "USD"),
+		SettlementCutOffs:  getEnv("SETTLEMENT_CUT_OFFS", "USD=21:00"),
+		SettlementHolidays: getEnv("SETTLEMENT_HOLIDAYS", ""),
+
+		// Exchange trade ingestion
+		TradeIngestionEnabled:   getEnvAsBool("TRADE_INGESTION_ENABLED", false),
+		TradeStreamName:         getEnv("TRADE_STREAM_NAME", "exchange:trades"),
+		TradeStreamGroup:        getEnv("TRADE_STREAM_GROUP", "custodian-simulator"),
+		TradeStreamBatchSize:    getEnvAsInt("TRADE_STREAM_BATCH_SIZE", 100),
+		TradeStreamBlockTimeout: getEnvAsDuration("TRADE_STREAM_BLOCK_TIMEOUT", 2*time.Second),
+		TradeAccountMap:         getEnv("TRADE_ACCOUNT_MAP", ""),
+		TradeSettlementCycles:   getEnv("TRADE_SETTLEMENT_CYCLES", "default=0"),
+		TradeDedupeWindow:       getEnvAsInt("TRADE_DEDUPE_WINDOW", 100000),
+
+		// Transport security
+		TLSEnabled:              getEnvAsBool("TLS_ENABLED", false),
+		TLSCertFile:             getEnv("TLS_CERT_FILE", "certs/custodian-simulator.crt"),
+		TLSKeyFile:              getEnv("TLS_KEY_FILE", "certs/custodian-simulator.key"),
+		TLSCAFile:               getEnv("TLS_CA_FILE", "certs/ca.crt"),
+		AuthorizationPolicy:     getEnv("AUTHZ_POLICY", "exchange-simulator=read|write;risk-monitor=read;audit-correlator=read"),
 	}
 
 	// Backward compatibility: Default ServiceInstanceName to ServiceName
@@ -73,6 +331,11 @@ func Load() *Config {
 		_ = err
 	}
 
+	// Each instance publishes to its own stream unless one is named explicitly
+	if cfg.EventStreamName == "" {
+		cfg.EventStreamName = "custodian:events:" + cfg.ServiceInstanceName
+	}
+
 	return cfg
 }
 
@@ -149,6 +412,24 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
 func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
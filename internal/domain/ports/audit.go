@@ -0,0 +1,51 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// Audit outcomes
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+)
+
+// AuditBalance records one account/asset position before and after an operation.
+// Holds change the available balance only; settlements and deposits change both.
+type AuditBalance struct {
+	AccountID       string  `json:"account_id"`
+	AssetID         string  `json:"asset_id"`
+	BalanceBefore   float64 `json:"balance_before"`
+	BalanceAfter    float64 `json:"balance_after"`
+	AvailableBefore float64 `json:"available_before"`
+	AvailableAfter  float64 `json:"available_after"`
+}
+
+// AuditEvent is one custody action for the audit trail. The domain fills in what
+// happened; the AuditPort implementation adds identity, service and request
+// context (who, correlation ID, trace ID) from ctx.
+type AuditEvent struct {
+	ID            string    `json:"id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Service       string    `json:"service"`
+	Instance      string    `json:"instance"`
+	Actor         string    `json:"actor"`
+	ActorVerified bool      `json:"actor_verified"`
+	CorrelationID string    `json:"correlation_id,omitempty"`
+	TraceID       string    `json:"trace_id,omitempty"`
+
+	Action       string            `json:"action"` // e.g. "settlement.process"
+	Outcome      string            `json:"outcome"`
+	ResourceType string            `json:"resource_type"`
+	ResourceID   string            `json:"resource_id"`
+	Balances     []AuditBalance    `json:"balances,omitempty"`
+	Details      map[string]string `json:"details,omitempty"`
+	Error        string            `json:"error,omitempty"`
+}
+
+// AuditPort defines the interface for emitting audit events
+// Record must not block the caller on a slow sink
+type AuditPort interface {
+	Record(ctx context.Context, event AuditEvent)
+}
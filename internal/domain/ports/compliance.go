@@ -0,0 +1,50 @@
+package ports
+
+import "context"
+
+// Compliance screening decisions
+const (
+	ComplianceApprove = "approve"
+	ComplianceReject  = "reject"
+	ComplianceHold    = "hold" // Held for a compliance officer to release or reject
+)
+
+// TravelRuleData identifies the originator and beneficiary of a transfer, as the
+// travel rule requires above a threshold
+type TravelRuleData struct {
+	OriginatorName     string `json:"originator_name"`
+	OriginatorAccount  string `json:"originator_account"`
+	BeneficiaryName    string `json:"beneficiary_name"`
+	BeneficiaryAccount string `json:"beneficiary_account"`
+}
+
+// Complete reports whether every travel rule field is present
+func (d *TravelRuleData) Complete() bool {
+	return d != nil && d.OriginatorName != "" && d.OriginatorAccount != "" &&
+		d.BeneficiaryName != "" && d.BeneficiaryAccount != ""
+}
+
+// ComplianceSubject is an operation to screen before funds move
+type ComplianceSubject struct {
+	Operation   string          `json:"operation"` // "transfer" or "withdrawal"
+	ResourceID  string          `json:"resource_id"`
+	FromAccount string          `json:"from_account"`
+	ToAccount   string          `json:"to_account,omitempty"` // Transfers only
+	AssetID     string          `json:"asset_id"`
+	Amount      float64         `json:"amount"`
+	Network     string          `json:"network,omitempty"` // Withdrawals only
+	Address     string          `json:"address,omitempty"` // Withdrawals only
+	TravelRule  *TravelRuleData `json:"travel_rule,omitempty"`
+}
+
+// ComplianceDecision is the outcome of screening one operation
+type ComplianceDecision struct {
+	Decision string   `json:"decision"`
+	Reasons  []string `json:"reasons,omitempty"`
+}
+
+// ComplianceScreenerPort defines the interface for screening transfers and
+// withdrawals before they execute
+type ComplianceScreenerPort interface {
+	Screen(ctx context.Context, subject ComplianceSubject) ComplianceDecision
+}
@@ -0,0 +1,69 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// Domain event types published for other services to react to
+const (
+	DomainEventAccountCreated      = "AccountCreated"
+	DomainEventBalanceChanged      = "BalanceChanged"
+	DomainEventSettlementSettled   = "SettlementSettled"
+	DomainEventSettlementFailed    = "SettlementFailed"
+	DomainEventSettlementExpired   = "SettlementExpired"
+	DomainEventSettlementCancelled = "SettlementCancelled"
+)
+
+// DomainEventSchemaVersion is the version of the envelope and payloads below.
+// It is bumped on incompatible changes; consumers should skip versions they do
+// not understand rather than guess.
+const DomainEventSchemaVersion = 1
+
+// DomainEvent is the envelope of a published custody event. The domain sets
+// Type, AggregateID and Data; the publisher stamps the rest.
+type DomainEvent struct {
+	ID            string      `json:"id"`
+	Type          string      `json:"type"`
+	SchemaVersion int         `json:"schema_version"`
+	Source        string      `json:"source"`       // Publishing service instance
+	AggregateID   string      `json:"aggregate_id"` // Account or settlement ID; events for one aggregate are ordered
+	CorrelationID string      `json:"correlation_id,omitempty"`
+	OccurredAt    time.Time   `json:"occurred_at"`
+	Data          interface{} `json:"data"` // One of the *Data payloads, matching Type
+}
+
+// AccountCreatedData is the payload of AccountCreated
+type AccountCreatedData struct {
+	AccountID   string `json:"account_id"`
+	AccountType string `json:"account_type"`
+	Status      string `json:"status"`
+}
+
+// BalanceChangedData is the payload of BalanceChanged
+type BalanceChangedData struct {
+	AccountID    string  `json:"account_id"`
+	AssetID      string  `json:"asset_id"`
+	Delta        float64 `json:"delta"`
+	Balance      float64 `json:"balance"`
+	SettlementID string  `json:"settlement_id,omitempty"`
+}
+
+// SettlementEventData is the payload of the Settlement* events
+type SettlementEventData struct {
+	SettlementID  string  `json:"settlement_id"`
+	FromAccountID string  `json:"from_account_id"`
+	ToAccountID   string  `json:"to_account_id"`
+	AssetID       string  `json:"asset_id"`
+	Amount        float64 `json:"amount"`
+	SettledAmount float64 `json:"settled_amount,omitempty"` // Less than Amount while partially settled
+	Status        string  `json:"status"`
+	Reason        string  `json:"reason,omitempty"`
+	ReasonCode    string  `json:"reason_code,omitempty"`
+}
+
+// DomainEventPublisherPort defines the interface for publishing domain events
+// Publish must not block the caller on a slow or unavailable broker
+type DomainEventPublisherPort interface {
+	PublishDomainEvent(ctx context.Context, event DomainEvent)
+}
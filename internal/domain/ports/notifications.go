@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// SettlementNotification describes a settlement status change reported to other services
+type SettlementNotification struct {
+	SettlementID  string    `json:"settlement_id"`
+	Status        string    `json:"status"`
+	FromAccountID string    `json:"from_account_id"`
+	ToAccountID   string    `json:"to_account_id"`
+	AssetID       string    `json:"asset_id"`
+	Amount        float64   `json:"amount"`
+	Reason        string    `json:"reason,omitempty"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+// SettlementNotifierPort defines the interface for telling the rest of the ecosystem
+// about settlement status changes. Implementations must record the notification
+// durably before returning and deliver it asynchronously, so callers are never
+// blocked on a slow or unavailable recipient.
+type SettlementNotifierPort interface {
+	NotifySettlement(ctx context.Context, notification SettlementNotification) error
+}
@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Exchange event types carrying executions to settle
+const (
+	TradeEventExecuted = "TradeExecuted"
+	TradeEventFilled   = "OrderFilled"
+)
+
+// ErrDuplicateTrade is returned by a TradeEventHandler for a trade it has already handled
+var ErrDuplicateTrade = errors.New("duplicate trade")
+
+// TradeEvent is one execution reported by the exchange. For fills TradeID is the
+// fill ID, so partial executions of one order settle independently.
+type TradeEvent struct {
+	TradeID         string    `json:"trade_id"`
+	Symbol          string    `json:"symbol"` // BASE/QUOTE, e.g. "BTC/USD"
+	Quantity        float64   `json:"quantity"`
+	Price           float64   `json:"price"`
+	BuyerAccountID  string    `json:"buyer_account_id"`
+	SellerAccountID string    `json:"seller_account_id"`
+	ExecutedAt      time.Time `json:"executed_at"`
+}
+
+// TradeEventHandler defines the interface for turning exchange executions into settlements
+type TradeEventHandler interface {
+	HandleTradeEvent(ctx context.Context, trade TradeEvent) error
+}
@@ -4,16 +4,21 @@ package internal
 
 import (
 	"context"
+	"errors"
+	"io"
 	"net"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
 	grpcserver "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/presentation/grpc"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
 )
 
 // TestCustodianGRPCServer_RedPhase defines the expected behaviors for enhanced gRPC server
@@ -72,6 +77,67 @@ func TestCustodianGRPCServer_HealthService(t *testing.T) {
 	})
 }
 
+func TestCustodianGRPCServer_Readiness(t *testing.T) {
+	t.Run("stops_serving_while_a_readiness_check_fails", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{
+			ServiceName:         "custodian-simulator",
+			HealthCheckInterval: 20 * time.Millisecond,
+		}
+
+		logger := logrus.New()
+		logger.SetOutput(io.Discard)
+		server := grpcserver.NewCustodianGRPCServerWithDependencies(cfg, services.NewCustodianService(cfg, logger), logger, nil)
+
+		var failing atomic.Bool
+		server.AddReadinessCheck("dependency", func(ctx context.Context) error {
+			if failing.Load() {
+				return errors.New("dependency unreachable")
+			}
+			return nil
+		})
+
+		lis, err := net.Listen("tcp", ":0")
+		if err != nil {
+			t.Fatalf("Failed to listen: %v", err)
+		}
+		go func() {
+			_ = server.Serve(lis)
+		}()
+		defer server.GracefulStop()
+
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer conn.Close()
+		healthClient := grpc_health_v1.NewHealthClient(conn)
+
+		waitForStatus := func(want grpc_health_v1.HealthCheckResponse_ServingStatus) {
+			deadline := time.Now().Add(2 * time.Second)
+			for {
+				resp, err := healthClient.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "custodian-simulator"})
+				if err == nil && resp.Status == want {
+					return
+				}
+				if time.Now().After(deadline) {
+					t.Fatalf("Expected %v, last got %v (%v)", want, resp.GetStatus(), err)
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+
+		waitForStatus(grpc_health_v1.HealthCheckResponse_SERVING)
+
+		failing.Store(true)
+		waitForStatus(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+		failing.Store(false)
+		waitForStatus(grpc_health_v1.HealthCheckResponse_SERVING)
+	})
+}
+
 func TestCustodianGRPCServer_CustodyService(t *testing.T) {
 	t.Run("accepts_custody_operations", func(t *testing.T) {
 		t.Parallel()
@@ -191,6 +257,117 @@ func TestCustodianGRPCServer_Metrics(t *testing.T) {
 			t.Error("Service status should be available")
 		}
 	})
+
+	t.Run("tracks_connections_and_requests", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{
+			ServiceName: "custodian-simulator",
+			GRPCPort:    0,
+		}
+
+		server := NewCustodianGRPCServer(cfg)
+
+		lis, err := net.Listen("tcp", ":0")
+		if err != nil {
+			t.Fatalf("Failed to listen: %v", err)
+		}
+
+		go func() {
+			if err := server.Serve(lis); err != nil {
+				t.Logf("Server serve error: %v", err)
+			}
+		}()
+		defer server.GracefulStop()
+
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		healthClient := grpc_health_v1.NewHealthClient(conn)
+		for i := 0; i < 3; i++ {
+			if _, err := healthClient.Check(ctx, &grpc_health_v1.HealthCheckRequest{}); err != nil {
+				t.Fatalf("Health check failed: %v", err)
+			}
+		}
+
+		metrics := server.GetMetrics()
+
+		if metrics.TotalRequests < 3 {
+			t.Errorf("Expected at least 3 total requests, got %d", metrics.TotalRequests)
+		}
+
+		if metrics.ActiveConnections != 1 {
+			t.Errorf("Expected 1 active connection, got %d", metrics.ActiveConnections)
+		}
+	})
+}
+
+func TestCustodianGRPCServer_Shutdown(t *testing.T) {
+	t.Run("forces_stop_when_shutdown_context_expires", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{
+			ServiceName: "custodian-simulator",
+			GRPCPort:    0,
+		}
+
+		logger := logrus.New()
+		logger.SetOutput(io.Discard)
+		custodianSvc := services.NewCustodianService(cfg, logger)
+
+		server := grpcserver.NewCustodianGRPCServerWithDependencies(cfg, custodianSvc, logger, nil)
+
+		lis, err := net.Listen("tcp", ":0")
+		if err != nil {
+			t.Fatalf("Failed to listen: %v", err)
+		}
+
+		go func() {
+			_ = server.Serve(lis)
+		}()
+
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer conn.Close()
+
+		// A health Watch stream stays open until the client or server ends it
+		watchCtx, watchCancel := context.WithCancel(context.Background())
+		defer watchCancel()
+
+		stream, err := grpc_health_v1.NewHealthClient(conn).Watch(watchCtx, &grpc_health_v1.HealthCheckRequest{})
+		if err != nil {
+			t.Fatalf("Failed to open watch stream: %v", err)
+		}
+		if _, err := stream.Recv(); err != nil {
+			t.Fatalf("Failed to receive initial health status: %v", err)
+		}
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err = server.Shutdown(shutdownCtx)
+
+		if err == nil {
+			t.Error("Expected shutdown to report the expired context while a stream was open")
+		}
+
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("Expected shutdown to honour the context deadline, took %v", elapsed)
+		}
+
+		if metrics := server.GetMetrics(); metrics.ServiceStatus["custodian"] != "not_serving" {
+			t.Errorf("Expected custodian status not_serving after shutdown, got %s", metrics.ServiceStatus["custodian"])
+		}
+	})
 }
 
 // CustodianGRPCServer interface that needs to be implemented
@@ -203,4 +380,4 @@ type CustodianGRPCServer interface {
 // NewCustodianGRPCServer creates a new custodian gRPC server
 func NewCustodianGRPCServer(cfg *config.Config) CustodianGRPCServer {
 	return grpcserver.NewCustodianGRPCServer(cfg)
-}
\ No newline at end of file
+}
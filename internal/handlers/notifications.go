@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/notifications"
+)
+
+// NotificationStatusSource exposes outbox delivery status (implemented by notifications.SettlementNotifier)
+type NotificationStatusSource interface {
+	Notification(id string) (notifications.Notification, bool)
+	Notifications(status notifications.DeliveryStatus) []notifications.Notification
+}
+
+// NotificationHandler serves the delivery status of outbound settlement notifications
+type NotificationHandler struct {
+	source NotificationStatusSource
+}
+
+func NewNotificationHandler(source NotificationStatusSource) *NotificationHandler {
+	return &NotificationHandler{
+		source: source,
+	}
+}
+
+// List returns notifications, optionally filtered with ?status=pending|delivered|failed
+func (h *NotificationHandler) List(c *gin.Context) {
+	status := notifications.DeliveryStatus(c.Query("status"))
+	switch status {
+	case "", notifications.DeliveryPending, notifications.DeliveryDelivered, notifications.DeliveryFailed:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status must be pending, delivered or failed"})
+		return
+	}
+
+	list := h.source.Notifications(status)
+	c.JSON(http.StatusOK, gin.H{
+		"notifications": list,
+		"count":         len(list),
+	})
+}
+
+// Get returns one notification by ID
+func (h *NotificationHandler) Get(c *gin.Context) {
+	notification, exists := h.source.Notification(c.Param("id"))
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "notification not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, notification)
+}
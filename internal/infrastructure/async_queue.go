@@ -0,0 +1,78 @@
+package infrastructure
+
+import "sync"
+
+// Reasons AsyncQueue passes to its drop function
+const (
+	DropReasonQueueFull = "queue full"
+	DropReasonStopped   = "stopped"
+)
+
+// AsyncQueue hands items to a single background worker in the order they were
+// enqueued. Enqueue never blocks: when the queue is full, or once stopped, the item
+// is passed to the drop function instead, so callers on a hot path are never held
+// up by a slow destination.
+type AsyncQueue[T any] struct {
+	process func(T)
+	drop    func(item T, reason string)
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan T
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+// NewAsyncQueue creates a queue holding up to size items; sizes below one hold one
+func NewAsyncQueue[T any](size int, process func(T), drop func(item T, reason string)) *AsyncQueue[T] {
+	if size <= 0 {
+		size = 1
+	}
+
+	return &AsyncQueue[T]{
+		process: process,
+		drop:    drop,
+		queue:   make(chan T, size),
+	}
+}
+
+// Enqueue queues item for the worker without blocking
+func (q *AsyncQueue[T]) Enqueue(item T) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		q.drop(item, DropReasonStopped)
+		return
+	}
+
+	select {
+	case q.queue <- item:
+	default:
+		q.drop(item, DropReasonQueueFull)
+	}
+}
+
+// Start runs the worker that processes queued items
+func (q *AsyncQueue[T]) Start() {
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+		for item := range q.queue {
+			q.process(item)
+		}
+	}()
+}
+
+// Stop refuses new items and returns once everything already queued is processed.
+// It is safe to call more than once.
+func (q *AsyncQueue[T]) Stop() {
+	q.once.Do(func() {
+		q.mu.Lock()
+		q.closed = true
+		close(q.queue)
+		q.mu.Unlock()
+
+		q.wg.Wait()
+	})
+}
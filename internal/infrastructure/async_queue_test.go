@@ -0,0 +1,60 @@
+//go:build unit
+
+package infrastructure_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+)
+
+// TestAsyncQueue verifies items are processed in order and dropped instead of blocking
+// Following BDD Given/When/Then pattern
+func TestAsyncQueue(t *testing.T) {
+	t.Run("processes_queued_items_in_order_before_stopping", func(t *testing.T) {
+		// Given: A started queue
+		var processed []int
+		queue := infrastructure.NewAsyncQueue(10, func(item int) {
+			processed = append(processed, item)
+		}, func(item int, reason string) {
+			t.Errorf("Unexpected drop of %d: %s", item, reason)
+		})
+		queue.Start()
+
+		// When: Items are queued and the queue is stopped
+		for i := 1; i <= 3; i++ {
+			queue.Enqueue(i)
+		}
+		queue.Stop()
+
+		// Then: Every item was processed in order
+		if len(processed) != 3 || processed[0] != 1 || processed[2] != 3 {
+			t.Errorf("Expected 1, 2, 3 processed in order, got %v", processed)
+		}
+	})
+
+	t.Run("drops_when_full_or_stopped", func(t *testing.T) {
+		// Given: A queue of one whose worker is not running
+		var mu sync.Mutex
+		drops := map[string]int{}
+		queue := infrastructure.NewAsyncQueue(1, func(int) {}, func(_ int, reason string) {
+			mu.Lock()
+			defer mu.Unlock()
+			drops[reason]++
+		})
+
+		// When: Two items are queued, then one more after stopping
+		queue.Enqueue(1)
+		queue.Enqueue(2)
+		queue.Start()
+		queue.Stop()
+		queue.Enqueue(3)
+		queue.Stop()
+
+		// Then: The overflow and the late item are dropped with their reasons
+		if drops[infrastructure.DropReasonQueueFull] != 1 || drops[infrastructure.DropReasonStopped] != 1 {
+			t.Errorf("Expected one drop for each reason, got %v", drops)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
+)
+
+// Recorder implements ports.AuditPort. It stamps each event with an ID, the time,
+// the service instance and the request context (actor, correlation ID, trace ID),
+// then queues it for a background worker that writes it to the sink; see
+// infrastructure.AsyncQueue for what happens when the queue is full.
+type Recorder struct {
+	sink         Sink
+	service      string
+	instance     string
+	writeTimeout time.Duration
+	logger       *logrus.Logger
+	metricsPort  ports.MetricsPort
+
+	now      func() time.Time
+	sequence uint64
+
+	queue     *infrastructure.AsyncQueue[ports.AuditEvent]
+	closeOnce sync.Once
+}
+
+func NewRecorder(cfg *config.Config, sink Sink, logger *logrus.Logger) *Recorder {
+	writeTimeout := cfg.AuditWriteTimeout
+	if writeTimeout <= 0 {
+		writeTimeout = 5 * time.Second
+	}
+
+	r := &Recorder{
+		sink:         sink,
+		service:      cfg.ServiceName,
+		instance:     cfg.ServiceInstanceName,
+		writeTimeout: writeTimeout,
+		logger:       logger,
+		metricsPort:  cfg.GetMetricsPort(),
+		now:          time.Now,
+	}
+	r.queue = infrastructure.NewAsyncQueue(cfg.AuditBufferSize, r.write, r.dropped)
+	return r
+}
+
+// SetClock replaces the time source (tests)
+func (r *Recorder) SetClock(now func() time.Time) {
+	r.now = now
+}
+
+// Record enriches the event from ctx and queues it for the sink without blocking
+func (r *Recorder) Record(ctx context.Context, event ports.AuditEvent) {
+	r.queue.Enqueue(r.enrich(ctx, event))
+}
+
+// Start runs the worker that drains the queue into the sink
+func (r *Recorder) Start() {
+	r.queue.Start()
+}
+
+// Stop refuses new events, writes everything already queued and closes the sink
+func (r *Recorder) Stop() {
+	r.queue.Stop()
+	r.closeOnce.Do(func() {
+		if err := r.sink.Close(); err != nil {
+			r.logger.WithError(err).WithField("sink", r.sink.Name()).Error("Failed to close audit sink")
+		}
+	})
+}
+
+func (r *Recorder) enrich(ctx context.Context, event ports.AuditEvent) ports.AuditEvent {
+	now := r.now()
+	event.ID = fmt.Sprintf("AUDIT_%d_%d", now.UnixNano(), atomic.AddUint64(&r.sequence, 1))
+	event.Timestamp = now
+	event.Service = r.service
+	event.Instance = r.instance
+
+	actor := observability.ActorFromContext(ctx)
+	event.Actor = actor.ID
+	event.ActorVerified = actor.Verified
+	event.CorrelationID = observability.CorrelationIDFromContext(ctx)
+	event.TraceID = observability.TraceIDFromContext(ctx)
+
+	return event
+}
+
+func (r *Recorder) write(event ports.AuditEvent) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.writeTimeout)
+	defer cancel()
+
+	result := "success"
+	if err := r.sink.Write(ctx, event); err != nil {
+		result = "failure"
+		r.logger.WithError(err).WithFields(logrus.Fields{
+			"sink":     r.sink.Name(),
+			"event_id": event.ID,
+			"action":   event.Action,
+		}).Warn("Failed to write audit event")
+	}
+
+	if r.metricsPort != nil {
+		r.metricsPort.IncCounter("audit_events_total", map[string]string{
+			"sink":   r.sink.Name(),
+			"result": result,
+		})
+	}
+}
+
+func (r *Recorder) dropped(event ports.AuditEvent, reason string) {
+	r.logger.WithFields(logrus.Fields{
+		"event_id": event.ID,
+		"action":   event.Action,
+		"reason":   reason,
+	}).Warn("Dropped audit event")
+
+	if r.metricsPort != nil {
+		r.metricsPort.IncCounter("audit_events_dropped_total", map[string]string{
+			"sink": r.sink.Name(),
+		})
+	}
+}
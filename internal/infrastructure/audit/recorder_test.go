@@ -0,0 +1,189 @@
+//go:build unit
+
+package audit_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/audit"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
+)
+
+// memorySink collects written events
+type memorySink struct {
+	mu     sync.Mutex
+	events []ports.AuditEvent
+}
+
+func (m *memorySink) Name() string { return "memory" }
+
+func (m *memorySink) Write(_ context.Context, event ports.AuditEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *memorySink) Close() error { return nil }
+
+// fakeAuditClients hands out a client that remembers the last event
+type fakeAuditClients struct {
+	infrastructure.AuditCorrelatorClientInterface
+	recorded []ports.AuditEvent
+}
+
+func (f *fakeAuditClients) GetAuditCorrelatorClient(context.Context) (infrastructure.AuditCorrelatorClientInterface, error) {
+	return f, nil
+}
+
+func (f *fakeAuditClients) RecordAuditEvent(_ context.Context, event ports.AuditEvent) error {
+	f.recorded = append(f.recorded, event)
+	return nil
+}
+
+// TestRecorder verifies event enrichment, sinks and back-pressure handling
+// Following BDD Given/When/Then pattern
+func TestRecorder(t *testing.T) {
+	t.Run("enriches_events_and_appends_them_to_jsonl", func(t *testing.T) {
+		// Given: A recorder writing to a JSONL file
+		path := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+		sink, err := audit.OpenJSONLSink(path)
+		if err != nil {
+			t.Fatalf("OpenJSONLSink failed: %v", err)
+		}
+		recorder := audit.NewRecorder(testConfig(16), sink, quietLogger())
+		fixed := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+		recorder.SetClock(func() time.Time { return fixed })
+		recorder.Start()
+
+		// And: A request context identifying the caller
+		ctx := observability.WithCorrelationID(context.Background(), "corr-42")
+		ctx = observability.WithTraceID(ctx, "4bf92f3577b34da6a3ce929d0e0e4736")
+		ctx = observability.WithActor(ctx, observability.Actor{ID: "exchange-simulator", Verified: true})
+
+		// When: An event is recorded and the recorder is stopped
+		recorder.Record(ctx, ports.AuditEvent{Action: "account.deposit", Outcome: ports.AuditOutcomeSuccess, ResourceID: "ACCT_1"})
+		recorder.Stop()
+
+		// Then: The file holds one fully enriched event
+		events := readJSONL(t, path)
+		if len(events) != 1 {
+			t.Fatalf("Expected 1 event, got %d", len(events))
+		}
+		event := events[0]
+		if event.ID == "" || !event.Timestamp.Equal(fixed) {
+			t.Errorf("Expected ID and timestamp to be set, got %q at %v", event.ID, event.Timestamp)
+		}
+		if event.Service != "custodian-simulator" || event.Instance != "custodian-Komainu" {
+			t.Errorf("Unexpected service/instance %s/%s", event.Service, event.Instance)
+		}
+		if event.Actor != "exchange-simulator" || !event.ActorVerified {
+			t.Errorf("Expected verified actor exchange-simulator, got %s/%v", event.Actor, event.ActorVerified)
+		}
+		if event.CorrelationID != "corr-42" || event.TraceID != "4bf92f3577b34da6a3ce929d0e0e4736" {
+			t.Errorf("Unexpected correlation/trace IDs %s/%s", event.CorrelationID, event.TraceID)
+		}
+	})
+
+	t.Run("records_anonymous_actor_without_request_identity", func(t *testing.T) {
+		// Given: A running recorder
+		sink := &memorySink{}
+		recorder := audit.NewRecorder(testConfig(16), sink, quietLogger())
+		recorder.Start()
+
+		// When: An event is recorded from a bare context
+		recorder.Record(context.Background(), ports.AuditEvent{Action: "account.create"})
+		recorder.Stop()
+
+		// Then: The actor is anonymous and unverified
+		if len(sink.events) != 1 || sink.events[0].Actor != "anonymous" || sink.events[0].ActorVerified {
+			t.Errorf("Expected one anonymous event, got %+v", sink.events)
+		}
+	})
+
+	t.Run("drops_events_instead_of_blocking_when_queue_is_full", func(t *testing.T) {
+		// Given: A recorder with room for one event whose worker has not started
+		sink := &memorySink{}
+		recorder := audit.NewRecorder(testConfig(1), sink, quietLogger())
+
+		// When: Three events are recorded
+		for i := 0; i < 3; i++ {
+			recorder.Record(context.Background(), ports.AuditEvent{Action: "account.deposit"})
+		}
+		recorder.Start()
+		recorder.Stop()
+
+		// Then: Only the queued event reaches the sink
+		if len(sink.events) != 1 {
+			t.Errorf("Expected 1 event written, got %d", len(sink.events))
+		}
+
+		// And: Recording after Stop is a no-op rather than a panic
+		recorder.Record(context.Background(), ports.AuditEvent{Action: "account.deposit"})
+	})
+
+	t.Run("grpc_sink_forwards_to_audit_correlator", func(t *testing.T) {
+		// Given: A recorder writing through the audit-correlator client
+		clients := &fakeAuditClients{}
+		recorder := audit.NewRecorder(testConfig(16), audit.NewGRPCSink(clients), quietLogger())
+		recorder.Start()
+
+		// When: An event is recorded
+		recorder.Record(context.Background(), ports.AuditEvent{Action: "settlement.process", ResourceID: "SETTLE_1"})
+		recorder.Stop()
+
+		// Then: The correlator client receives it
+		if len(clients.recorded) != 1 || clients.recorded[0].ResourceID != "SETTLE_1" {
+			t.Errorf("Expected SETTLE_1 to be forwarded, got %+v", clients.recorded)
+		}
+	})
+}
+
+func testConfig(bufferSize int) *config.Config {
+	return &config.Config{
+		ServiceName:         "custodian-simulator",
+		ServiceInstanceName: "custodian-Komainu",
+		AuditBufferSize:     bufferSize,
+		AuditWriteTimeout:   time.Second,
+	}
+}
+
+func readJSONL(t *testing.T, path string) []ports.AuditEvent {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open %s: %v", path, err)
+	}
+	defer file.Close()
+
+	var events []ports.AuditEvent
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event ports.AuditEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("Invalid JSONL line %q: %v", scanner.Text(), err)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func quietLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
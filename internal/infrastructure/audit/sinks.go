@@ -0,0 +1,163 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+)
+
+// Sink is a destination for audit events
+type Sink interface {
+	// Name identifies the sink in metrics and logs
+	Name() string
+	Write(ctx context.Context, event ports.AuditEvent) error
+	Close() error
+}
+
+// JSONLSink appends one JSON object per line to a local file
+type JSONLSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// OpenJSONLSink opens path for appending, creating it and its directory if needed
+func OpenJSONLSink(path string) (*JSONLSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create audit directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+
+	return &JSONLSink{file: file}, nil
+}
+
+func (s *JSONLSink) Name() string {
+	return "jsonl"
+}
+
+func (s *JSONLSink) Write(ctx context.Context, event ports.AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event %s: %w", event.ID, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return fmt.Errorf("audit log is closed")
+	}
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to append audit event %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+func (s *JSONLSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	if err := s.file.Sync(); err != nil {
+		s.file.Close()
+		s.file = nil
+		return fmt.Errorf("failed to sync audit log: %w", err)
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// RedisStreamSink adds each event to a Redis stream as a single "event" JSON field
+type RedisStreamSink struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+// NewRedisStreamSink writes to stream, trimming it to roughly maxLen entries when maxLen > 0
+func NewRedisStreamSink(client *redis.Client, stream string, maxLen int64) *RedisStreamSink {
+	return &RedisStreamSink{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+func (s *RedisStreamSink) Name() string {
+	return "redis"
+}
+
+func (s *RedisStreamSink) Write(ctx context.Context, event ports.AuditEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event %s: %w", event.ID, err)
+	}
+
+	args := &redis.XAddArgs{
+		Stream: s.stream,
+		Values: map[string]interface{}{
+			"event_id": event.ID,
+			"action":   event.Action,
+			"event":    string(payload),
+		},
+	}
+	if s.maxLen > 0 {
+		args.MaxLen = s.maxLen
+		args.Approx = true
+	}
+
+	if err := s.client.XAdd(ctx, args).Err(); err != nil {
+		return fmt.Errorf("failed to add audit event %s to stream %s: %w", event.ID, s.stream, err)
+	}
+	return nil
+}
+
+func (s *RedisStreamSink) Close() error {
+	return s.client.Close()
+}
+
+// AuditCorrelatorClients resolves the audit-correlator client
+// Implemented by infrastructure.DefaultInterServiceClientManager
+type AuditCorrelatorClients interface {
+	GetAuditCorrelatorClient(ctx context.Context) (infrastructure.AuditCorrelatorClientInterface, error)
+}
+
+// GRPCSink sends events to the audit-correlator over RecordAuditEvent
+type GRPCSink struct {
+	clients AuditCorrelatorClients
+}
+
+func NewGRPCSink(clients AuditCorrelatorClients) *GRPCSink {
+	return &GRPCSink{clients: clients}
+}
+
+func (s *GRPCSink) Name() string {
+	return "grpc"
+}
+
+func (s *GRPCSink) Write(ctx context.Context, event ports.AuditEvent) error {
+	client, err := s.clients.GetAuditCorrelatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	return client.RecordAuditEvent(ctx, event)
+}
+
+// Close is a no-op; the client manager is owned by the caller
+func (s *GRPCSink) Close() error {
+	return nil
+}
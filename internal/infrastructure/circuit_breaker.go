@@ -0,0 +1,162 @@
+package infrastructure
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a per-service circuit breaker
+type CircuitState string
+
+const (
+	// CircuitClosed lets all calls through and counts consecutive failures
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen rejects calls until the open timeout elapses
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a limited number of probe calls through
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// ErrCircuitOpen is returned without contacting the peer while its breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreakerConfig controls when a breaker trips and recovers
+type CircuitBreakerConfig struct {
+	FailureThreshold int           // Consecutive failures that open the circuit
+	OpenTimeout      time.Duration // Time spent open before allowing probes
+	HalfOpenMaxCalls int           // Concurrent probes allowed, all must succeed to close
+}
+
+// CircuitBreaker implements the closed/open/half-open state machine for one peer service
+type CircuitBreaker struct {
+	config CircuitBreakerConfig
+
+	mu               sync.Mutex
+	state            CircuitState
+	failures         int
+	openedAt         time.Time
+	halfOpenInFlight int
+	halfOpenSuccess  int
+
+	now           func() time.Time
+	onStateChange func(from, to CircuitState)
+}
+
+func NewCircuitBreaker(cfg CircuitBreakerConfig) *CircuitBreaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 5
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = 30 * time.Second
+	}
+	if cfg.HalfOpenMaxCalls <= 0 {
+		cfg.HalfOpenMaxCalls = 1
+	}
+
+	return &CircuitBreaker{
+		config: cfg,
+		state:  CircuitClosed,
+		now:    time.Now,
+	}
+}
+
+// SetClock replaces the time source (tests)
+func (cb *CircuitBreaker) SetClock(now func() time.Time) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.now = now
+}
+
+// OnStateChange registers a callback invoked (under the breaker lock) on every transition
+func (cb *CircuitBreaker) OnStateChange(fn func(from, to CircuitState)) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.onStateChange = fn
+}
+
+// Allow reports whether a call may proceed; callers that get nil must report
+// the outcome with RecordSuccess or RecordFailure
+func (cb *CircuitBreaker) Allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CircuitOpen && cb.now().Sub(cb.openedAt) >= cb.config.OpenTimeout {
+		cb.transitionLocked(CircuitHalfOpen)
+	}
+
+	switch cb.state {
+	case CircuitOpen:
+		return ErrCircuitOpen
+	case CircuitHalfOpen:
+		if cb.halfOpenInFlight >= cb.config.HalfOpenMaxCalls {
+			return ErrCircuitOpen
+		}
+		cb.halfOpenInFlight++
+	}
+
+	return nil
+}
+
+// RecordSuccess reports a successful call
+func (cb *CircuitBreaker) RecordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case CircuitClosed:
+		cb.failures = 0
+	case CircuitHalfOpen:
+		cb.halfOpenInFlight--
+		cb.halfOpenSuccess++
+		if cb.halfOpenSuccess >= cb.config.HalfOpenMaxCalls {
+			cb.transitionLocked(CircuitClosed)
+		}
+	}
+}
+
+// RecordFailure reports a failed call; a failed probe reopens the circuit immediately
+func (cb *CircuitBreaker) RecordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case CircuitClosed:
+		cb.failures++
+		if cb.failures >= cb.config.FailureThreshold {
+			cb.transitionLocked(CircuitOpen)
+		}
+	case CircuitHalfOpen:
+		cb.transitionLocked(CircuitOpen)
+	}
+}
+
+// State returns the current state, moving open breakers whose timeout elapsed to half-open
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CircuitOpen && cb.now().Sub(cb.openedAt) >= cb.config.OpenTimeout {
+		cb.transitionLocked(CircuitHalfOpen)
+	}
+	return cb.state
+}
+
+func (cb *CircuitBreaker) transitionLocked(to CircuitState) {
+	from := cb.state
+	if from == to {
+		return
+	}
+
+	cb.state = to
+	cb.failures = 0
+	cb.halfOpenInFlight = 0
+	cb.halfOpenSuccess = 0
+	if to == CircuitOpen {
+		cb.openedAt = cb.now()
+	}
+
+	if cb.onStateChange != nil {
+		cb.onStateChange(from, to)
+	}
+}
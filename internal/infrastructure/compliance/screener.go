@@ -0,0 +1,145 @@
+package compliance
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Reasons given with screening decisions
+const (
+	ReasonSanctionedAddress = "sanctioned_address"
+	ReasonSanctionedEntity  = "sanctioned_entity"
+	ReasonTravelRuleMissing = "travel_rule_missing"
+)
+
+// ListScreener screens operations against sanctioned address and entity lists
+// loaded from local files, and holds transfers above the travel rule threshold
+// that lack originator and beneficiary data
+type ListScreener struct {
+	addresses  map[string]bool // Lower-cased
+	entities   map[string]bool // Lower-cased account IDs and names
+	thresholds map[string]float64
+	logger     *logrus.Logger
+}
+
+// NewListScreener loads the sanctions lists and travel rule thresholds in cfg
+func NewListScreener(cfg *config.Config, logger *logrus.Logger) (*ListScreener, error) {
+	addresses, err := LoadList(cfg.ComplianceSanctionedAddressesFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sanctioned addresses: %w", err)
+	}
+	entities, err := LoadList(cfg.ComplianceSanctionedEntitiesFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sanctioned entities: %w", err)
+	}
+	thresholds, err := parseThresholds(cfg.ComplianceTravelRuleThresholds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid travel rule thresholds: %w", err)
+	}
+
+	logger.WithFields(logrus.Fields{
+		"sanctioned_addresses": len(addresses),
+		"sanctioned_entities":  len(entities),
+	}).Info("Compliance sanctions lists loaded")
+
+	return &ListScreener{
+		addresses:  addresses,
+		entities:   entities,
+		thresholds: thresholds,
+		logger:     logger,
+	}, nil
+}
+
+// LoadList reads one entry per line, ignoring blank lines and # comments. An
+// empty path is an empty list.
+func LoadList(path string) (map[string]bool, error) {
+	list := make(map[string]bool)
+	if path == "" {
+		return list, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry, _, _ := strings.Cut(scanner.Text(), "#")
+		if entry = strings.TrimSpace(entry); entry != "" {
+			list[strings.ToLower(entry)] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Screen rejects operations touching a sanctioned address or entity and holds
+// those missing required travel rule data
+func (s *ListScreener) Screen(ctx context.Context, subject ports.ComplianceSubject) ports.ComplianceDecision {
+	var reasons []string
+	if subject.Address != "" && s.addresses[strings.ToLower(subject.Address)] {
+		reasons = append(reasons, ReasonSanctionedAddress)
+	}
+
+	parties := []string{subject.FromAccount, subject.ToAccount}
+	if subject.TravelRule != nil {
+		parties = append(parties,
+			subject.TravelRule.OriginatorName, subject.TravelRule.OriginatorAccount,
+			subject.TravelRule.BeneficiaryName, subject.TravelRule.BeneficiaryAccount)
+	}
+	for _, party := range parties {
+		if party != "" && s.entities[strings.ToLower(party)] {
+			reasons = append(reasons, ReasonSanctionedEntity)
+			break
+		}
+	}
+	if len(reasons) > 0 {
+		return ports.ComplianceDecision{Decision: ports.ComplianceReject, Reasons: reasons}
+	}
+
+	if threshold, ok := s.travelRuleThreshold(subject.AssetID); ok && subject.Amount >= threshold && !subject.TravelRule.Complete() {
+		return ports.ComplianceDecision{Decision: ports.ComplianceHold, Reasons: []string{ReasonTravelRuleMissing}}
+	}
+
+	return ports.ComplianceDecision{Decision: ports.ComplianceApprove}
+}
+
+func (s *ListScreener) travelRuleThreshold(assetID string) (float64, bool) {
+	if threshold, ok := s.thresholds[assetID]; ok {
+		return threshold, true
+	}
+	threshold, ok := s.thresholds["default"]
+	return threshold, ok
+}
+
+// parseThresholds parses "ASSET=amount;default=amount"
+func parseThresholds(spec string) (map[string]float64, error) {
+	thresholds := make(map[string]float64)
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		asset, value, ok := strings.Cut(entry, "=")
+		amount, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if !ok || strings.TrimSpace(asset) == "" || err != nil || amount < 0 {
+			return nil, fmt.Errorf("invalid entry %q, expected ASSET=amount", entry)
+		}
+		thresholds[strings.TrimSpace(asset)] = amount
+	}
+	return thresholds, nil
+}
@@ -0,0 +1,110 @@
+//go:build unit
+
+package compliance_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/compliance"
+)
+
+// TestListScreener verifies screening against sanctions lists and the travel rule
+// Following BDD Given/When/Then pattern
+func TestListScreener(t *testing.T) {
+	ctx := context.Background()
+
+	// Given: Sanctions lists on disk and a 1 BTC travel rule threshold
+	dir := t.TempDir()
+	addresses := filepath.Join(dir, "addresses.txt")
+	entities := filepath.Join(dir, "entities.txt")
+	writeList(t, addresses, "# OFAC sample\nbc1qSanctioned\n\n")
+	writeList(t, entities, "ACCT_BLOCKED  # frozen account\nEvil Corp\n")
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	screener, err := compliance.NewListScreener(&config.Config{
+		ComplianceSanctionedAddressesFile: addresses,
+		ComplianceSanctionedEntitiesFile:  entities,
+		ComplianceTravelRuleThresholds:    "BTC=1",
+	}, logger)
+	if err != nil {
+		t.Fatalf("NewListScreener failed: %v", err)
+	}
+
+	travelRule := &ports.TravelRuleData{
+		OriginatorName: "Alice", OriginatorAccount: "ACCT_A", BeneficiaryName: "Bob", BeneficiaryAccount: "ACCT_B",
+	}
+
+	tests := []struct {
+		name     string
+		subject  ports.ComplianceSubject
+		decision string
+		reason   string
+	}{
+		{
+			name:     "approves_small_transfers",
+			subject:  ports.ComplianceSubject{FromAccount: "ACCT_A", ToAccount: "ACCT_B", AssetID: "BTC", Amount: 0.5},
+			decision: ports.ComplianceApprove,
+		},
+		{
+			name:     "rejects_sanctioned_addresses_case_insensitively",
+			subject:  ports.ComplianceSubject{FromAccount: "ACCT_A", AssetID: "BTC", Amount: 0.1, Address: "BC1QSANCTIONED"},
+			decision: ports.ComplianceReject,
+			reason:   compliance.ReasonSanctionedAddress,
+		},
+		{
+			name:     "rejects_sanctioned_accounts",
+			subject:  ports.ComplianceSubject{FromAccount: "ACCT_A", ToAccount: "ACCT_BLOCKED", AssetID: "ETH", Amount: 1},
+			decision: ports.ComplianceReject,
+			reason:   compliance.ReasonSanctionedEntity,
+		},
+		{
+			name: "rejects_sanctioned_travel_rule_parties",
+			subject: ports.ComplianceSubject{FromAccount: "ACCT_A", ToAccount: "ACCT_B", AssetID: "BTC", Amount: 2,
+				TravelRule: &ports.TravelRuleData{OriginatorName: "Alice", OriginatorAccount: "ACCT_A", BeneficiaryName: "evil corp", BeneficiaryAccount: "ACCT_B"}},
+			decision: ports.ComplianceReject,
+			reason:   compliance.ReasonSanctionedEntity,
+		},
+		{
+			name:     "holds_large_transfers_without_travel_rule_data",
+			subject:  ports.ComplianceSubject{FromAccount: "ACCT_A", ToAccount: "ACCT_B", AssetID: "BTC", Amount: 2},
+			decision: ports.ComplianceHold,
+			reason:   compliance.ReasonTravelRuleMissing,
+		},
+		{
+			name:     "approves_large_transfers_with_travel_rule_data",
+			subject:  ports.ComplianceSubject{FromAccount: "ACCT_A", ToAccount: "ACCT_B", AssetID: "BTC", Amount: 2, TravelRule: travelRule},
+			decision: ports.ComplianceApprove,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When: The operation is screened
+			decision := screener.Screen(ctx, tt.subject)
+
+			// Then: The expected decision and reason come back
+			if decision.Decision != tt.decision {
+				t.Errorf("Expected %s, got %s (%v)", tt.decision, decision.Decision, decision.Reasons)
+			}
+			if tt.reason != "" && (len(decision.Reasons) == 0 || decision.Reasons[0] != tt.reason) {
+				t.Errorf("Expected reason %s, got %v", tt.reason, decision.Reasons)
+			}
+		})
+	}
+}
+
+func writeList(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+}
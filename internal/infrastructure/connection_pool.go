@@ -0,0 +1,185 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// PoolDialFunc opens a new connection for a pool; opts must be passed through to grpc.Dial
+type PoolDialFunc func(ctx context.Context, opts ...grpc.DialOption) (*grpc.ClientConn, error)
+
+// ConnectionPool holds up to maxSize connections to one peer service. A connection is
+// added only when every pooled connection has unary calls in flight, so quiet services
+// keep a single connection while busy ones spread calls over several HTTP/2 connections.
+type ConnectionPool struct {
+	connections []*pooledConnection
+	index       int
+	mutex       sync.Mutex
+	maxSize     int
+	idleTimeout time.Duration
+	dial        PoolDialFunc
+
+	onClose func(reason string)
+}
+
+type pooledConnection struct {
+	conn     *grpc.ClientConn
+	inFlight int64
+	lastUsed int64 // UnixNano
+}
+
+// NewConnectionPool creates an empty pool; connections are dialed on demand
+func NewConnectionPool(maxSize int, idleTimeout time.Duration, dial PoolDialFunc) *ConnectionPool {
+	if maxSize <= 0 {
+		maxSize = 1
+	}
+
+	return &ConnectionPool{
+		maxSize:     maxSize,
+		idleTimeout: idleTimeout,
+		dial:        dial,
+	}
+}
+
+// OnClose registers a callback invoked for every connection the pool closes, with the
+// reason "unhealthy", "idle" or "closed"
+func (p *ConnectionPool) OnClose(fn func(reason string)) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.onClose = fn
+}
+
+// Get returns the least busy healthy connection, evicting failed connections and
+// dialing a new one when the pool is empty or fully busy and below maxSize
+func (p *ConnectionPool) Get(ctx context.Context) (*grpc.ClientConn, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.evictUnhealthyLocked()
+
+	best := p.leastBusyLocked()
+	if best == nil || (atomic.LoadInt64(&best.inFlight) > 0 && len(p.connections) < p.maxSize) {
+		pc := &pooledConnection{}
+		conn, err := p.dial(ctx, grpc.WithChainUnaryInterceptor(pc.trackUnary))
+		if err != nil {
+			if best == nil {
+				return nil, err
+			}
+			// Growing failed; the existing connections still work
+			best.touch()
+			return best.conn, nil
+		}
+		pc.conn = conn
+		p.connections = append(p.connections, pc)
+		best = pc
+	}
+
+	best.touch()
+	return best.conn, nil
+}
+
+// ReapIdle closes connections with nothing in flight that have not been used since
+// idleTimeout before now, returning how many were closed
+func (p *ConnectionPool) ReapIdle(now time.Time) int {
+	if p.idleTimeout <= 0 {
+		return 0
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	cutoff := now.Add(-p.idleTimeout).UnixNano()
+	return p.removeLocked("idle", func(pc *pooledConnection) bool {
+		return atomic.LoadInt64(&pc.inFlight) == 0 && atomic.LoadInt64(&pc.lastUsed) < cutoff
+	})
+}
+
+// Size returns the number of open pooled connections
+func (p *ConnectionPool) Size() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.connections)
+}
+
+// Close closes every pooled connection
+func (p *ConnectionPool) Close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.removeLocked("closed", func(*pooledConnection) bool { return true })
+}
+
+func (p *ConnectionPool) evictUnhealthyLocked() {
+	p.removeLocked("unhealthy", func(pc *pooledConnection) bool {
+		state := pc.conn.GetState()
+		return state == connectivity.Shutdown || state == connectivity.TransientFailure
+	})
+}
+
+func (p *ConnectionPool) removeLocked(reason string, remove func(*pooledConnection) bool) int {
+	kept := p.connections[:0]
+	removed := 0
+	for _, pc := range p.connections {
+		if !remove(pc) {
+			kept = append(kept, pc)
+			continue
+		}
+
+		_ = pc.conn.Close()
+		removed++
+		if p.onClose != nil {
+			p.onClose(reason)
+		}
+	}
+
+	for i := len(kept); i < len(p.connections); i++ {
+		p.connections[i] = nil
+	}
+	p.connections = kept
+	return removed
+}
+
+// leastBusyLocked returns the connection with the fewest calls in flight, rotating the
+// starting point so idle connections share load; nil when the pool is empty
+func (p *ConnectionPool) leastBusyLocked() *pooledConnection {
+	n := len(p.connections)
+	if n == 0 {
+		return nil
+	}
+
+	p.index = (p.index + 1) % n
+	best := p.connections[p.index]
+	for i := 1; i < n; i++ {
+		candidate := p.connections[(p.index+i)%n]
+		if atomic.LoadInt64(&candidate.inFlight) < atomic.LoadInt64(&best.inFlight) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+func (pc *pooledConnection) touch() {
+	atomic.StoreInt64(&pc.lastUsed, time.Now().UnixNano())
+}
+
+func (pc *pooledConnection) trackUnary(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	atomic.AddInt64(&pc.inFlight, 1)
+	defer func() {
+		atomic.AddInt64(&pc.inFlight, -1)
+		pc.touch()
+	}()
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
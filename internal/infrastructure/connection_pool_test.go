@@ -0,0 +1,140 @@
+//go:build unit
+
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+
+	exchangev1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/exchange/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+)
+
+// TestConnectionPool verifies growth under load, health eviction and idle reaping
+// Following BDD Given/When/Then pattern
+func TestConnectionPool(t *testing.T) {
+	newPool := func(t *testing.T, peer *countingExchange, maxSize int) (*infrastructure.ConnectionPool, *int) {
+		addr := startExchangePeer(t, peer)
+		dials := 0
+		pool := infrastructure.NewConnectionPool(maxSize, time.Minute, func(_ context.Context, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+			dials++
+			return grpc.Dial(addr, append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))...)
+		})
+		t.Cleanup(pool.Close)
+		return pool, &dials
+	}
+
+	t.Run("reuses_idle_connection", func(t *testing.T) {
+		// Given: A pool that has served a completed call
+		peer := newCountingExchange()
+		pool, dials := newPool(t, peer, 4)
+		conn, err := pool.Get(context.Background())
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		if _, err := exchangev1.NewExchangeSimulatorServiceClient(conn).GetTradingStatus(context.Background(), &exchangev1.GetTradingStatusRequest{}); err != nil {
+			t.Fatalf("GetTradingStatus failed: %v", err)
+		}
+
+		// When: Another connection is requested with nothing in flight
+		again, _ := pool.Get(context.Background())
+
+		// Then: The same connection is returned and no new one is dialed
+		if again != conn || *dials != 1 || pool.Size() != 1 {
+			t.Errorf("Expected one reused connection, got size=%d dials=%d", pool.Size(), *dials)
+		}
+	})
+
+	t.Run("grows_while_all_connections_are_busy_up_to_max", func(t *testing.T) {
+		// Given: A pool of at most 2 whose first connection has a call in flight
+		peer := newCountingExchange()
+		peer.blocking.Store(true)
+		pool, dials := newPool(t, peer, 2)
+
+		for i := 0; i < 3; i++ {
+			conn, err := pool.Get(context.Background())
+			if err != nil {
+				t.Fatalf("Get failed: %v", err)
+			}
+			// When: Each returned connection is kept busy
+			go func() {
+				_, _ = exchangev1.NewExchangeSimulatorServiceClient(conn).GetTradingStatus(context.Background(), &exchangev1.GetTradingStatusRequest{})
+			}()
+			<-peer.arrived
+		}
+
+		// Then: The pool grew to its limit and stopped there
+		if pool.Size() != 2 || *dials != 2 {
+			t.Errorf("Expected pool capped at 2, got size=%d dials=%d", pool.Size(), *dials)
+		}
+	})
+
+	t.Run("evicts_shut_down_connections", func(t *testing.T) {
+		// Given: A pooled connection that has been closed underneath the pool
+		peer := newCountingExchange()
+		pool, dials := newPool(t, peer, 2)
+		conn, _ := pool.Get(context.Background())
+		conn.Close()
+
+		// When: A connection is requested
+		replacement, err := pool.Get(context.Background())
+
+		// Then: The dead connection is replaced
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		if replacement == conn || pool.Size() != 1 || *dials != 2 {
+			t.Errorf("Expected a fresh connection, got size=%d dials=%d", pool.Size(), *dials)
+		}
+	})
+
+	t.Run("reaps_idle_connections", func(t *testing.T) {
+		// Given: A pooled connection last used now
+		peer := newCountingExchange()
+		pool, _ := newPool(t, peer, 2)
+		conn, _ := pool.Get(context.Background())
+
+		// When: Reaping runs before and after the idle timeout
+		early := pool.ReapIdle(time.Now())
+		late := pool.ReapIdle(time.Now().Add(2 * time.Minute))
+
+		// Then: Only the late pass closes it
+		if early != 0 || late != 1 || pool.Size() != 0 {
+			t.Errorf("Expected reaping only after timeout, got early=%d late=%d size=%d", early, late, pool.Size())
+		}
+		if conn.GetState() != connectivity.Shutdown {
+			t.Errorf("Expected reaped connection to be closed, got %s", conn.GetState())
+		}
+	})
+
+	t.Run("manager_stats_track_pooled_connections", func(t *testing.T) {
+		// Given: A manager with one discovered exchange instance
+		peer := newCountingExchange()
+		discovery := &staticDiscovery{}
+		discovery.set(exchangeInstance(t, startExchangePeer(t, peer), "healthy", time.Now()))
+		manager := infrastructure.NewInterServiceClientManager(&config.Config{LogLevel: "error", ConnectionPoolSize: 2})
+		manager.SetServiceDiscovery(discovery)
+
+		// When: A client is obtained and the manager is cleaned up
+		if _, err := manager.GetExchangeSimulatorClient(context.Background()); err != nil {
+			t.Fatalf("GetExchangeSimulatorClient failed: %v", err)
+		}
+		before := manager.GetConnectionStats()
+		_ = manager.Cleanup(context.Background())
+		after := manager.GetConnectionStats()
+
+		// Then: Active connections reflect what is open at each point
+		if before.ActiveConnections != 1 || before.TotalConnections != 1 || before.PoolSizes["exchange-simulator"] != 1 {
+			t.Errorf("Unexpected stats before cleanup: %+v", before)
+		}
+		if after.ActiveConnections != 0 || after.ClosedConnections != 1 {
+			t.Errorf("Unexpected stats after cleanup: %+v", after)
+		}
+	})
+}
@@ -0,0 +1,159 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/resolver"
+)
+
+// discoveryScheme is the target scheme resolved through service discovery, e.g. discovery:///exchange-simulator
+const discoveryScheme = "discovery"
+
+// discoveryResolverBuilder resolves peer service names to the healthy, fresh instances
+// registered in service discovery, re-resolving periodically so connections follow churn
+type discoveryResolverBuilder struct {
+	discovery       ServiceDiscoveryInterface
+	maxAge          time.Duration
+	refreshInterval time.Duration
+	logger          *logrus.Logger
+}
+
+func newDiscoveryResolverBuilder(discovery ServiceDiscoveryInterface, maxAge, refreshInterval time.Duration, logger *logrus.Logger) *discoveryResolverBuilder {
+	if refreshInterval <= 0 {
+		refreshInterval = 15 * time.Second
+	}
+
+	return &discoveryResolverBuilder{
+		discovery:       discovery,
+		maxAge:          maxAge,
+		refreshInterval: refreshInterval,
+		logger:          logger,
+	}
+}
+
+func (b *discoveryResolverBuilder) Scheme() string {
+	return discoveryScheme
+}
+
+func (b *discoveryResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	serviceName := target.Endpoint()
+	if serviceName == "" {
+		return nil, fmt.Errorf("discovery target %q has no service name", target.URL.String())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &discoveryResolver{
+		builder:     b,
+		serviceName: serviceName,
+		cc:          cc,
+		ctx:         ctx,
+		cancel:      cancel,
+		resolveNow:  make(chan struct{}, 1),
+	}
+
+	r.wg.Add(1)
+	go r.watch()
+
+	return r, nil
+}
+
+type discoveryResolver struct {
+	builder     *discoveryResolverBuilder
+	serviceName string
+	cc          resolver.ClientConn
+
+	ctx        context.Context
+	cancel     context.CancelFunc
+	resolveNow chan struct{}
+	wg         sync.WaitGroup
+
+	lastAddresses []string
+}
+
+// ResolveNow is called by gRPC when a connection fails; it triggers an immediate refresh
+func (r *discoveryResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolveNow <- struct{}{}:
+	default:
+	}
+}
+
+func (r *discoveryResolver) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+func (r *discoveryResolver) watch() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.builder.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		r.resolve()
+
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.resolveNow:
+		}
+	}
+}
+
+func (r *discoveryResolver) resolve() {
+	instances, err := r.builder.discovery.DiscoverServices(r.ctx, r.serviceName)
+	if err != nil {
+		if r.ctx.Err() != nil {
+			return
+		}
+		// Keep using the last known instances while discovery itself is unavailable
+		r.builder.logger.WithError(err).WithField("service", r.serviceName).Warn("Failed to refresh service instances")
+		if len(r.lastAddresses) == 0 {
+			r.cc.ReportError(fmt.Errorf("service discovery failed for %s: %w", r.serviceName, err))
+		}
+		return
+	}
+
+	healthy := FilterHealthyInstances(instances, r.builder.maxAge, time.Now())
+	addresses := make([]string, 0, len(healthy))
+	seen := make(map[string]bool, len(healthy))
+	for _, instance := range healthy {
+		address := fmt.Sprintf("%s:%d", instance.Host, instance.GRPCPort)
+		if !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	if len(addresses) == 0 {
+		r.lastAddresses = nil
+		r.cc.ReportError(fmt.Errorf("no healthy instances of %s (%d discovered)", r.serviceName, len(instances)))
+		return
+	}
+
+	if slices.Equal(addresses, r.lastAddresses) {
+		return
+	}
+
+	state := resolver.State{Addresses: make([]resolver.Address, 0, len(addresses))}
+	for _, address := range addresses {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: address})
+	}
+	if err := r.cc.UpdateState(state); err != nil {
+		r.builder.logger.WithError(err).WithField("service", r.serviceName).Warn("Failed to apply resolved instances")
+	}
+	r.lastAddresses = addresses
+
+	r.builder.logger.WithFields(logrus.Fields{
+		"service":   r.serviceName,
+		"instances": addresses,
+	}).Info("Service instances updated")
+}
@@ -0,0 +1,225 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
+)
+
+// Stream entry fields. The envelope is flattened so consumers can filter on type
+// and schema version without decoding the payload, which is JSON in "data".
+const (
+	FieldEventID       = "event_id"
+	FieldType          = "type"
+	FieldSchemaVersion = "schema_version"
+	FieldSource        = "source"
+	FieldAggregateID   = "aggregate_id"
+	FieldCorrelationID = "correlation_id"
+	FieldOccurredAt    = "occurred_at"
+	FieldData          = "data"
+)
+
+// StreamPublisher implements ports.DomainEventPublisherPort on a Redis stream.
+// Entry IDs are assigned by Redis, so they increase monotonically and consumer
+// groups can read with XREADGROUP and acknowledge with XACK; event_id is stable
+// for deduplication. A single background worker appends events in the order they
+// were published; see infrastructure.AsyncQueue for what happens when it falls behind.
+type StreamPublisher struct {
+	client       *redis.Client
+	stream       string
+	maxLen       int64
+	source       string
+	writeTimeout time.Duration
+	logger       *logrus.Logger
+	metricsPort  ports.MetricsPort
+
+	now      func() time.Time
+	sequence uint64
+
+	queue     *infrastructure.AsyncQueue[ports.DomainEvent]
+	closeOnce sync.Once
+}
+
+func NewStreamPublisher(cfg *config.Config, client *redis.Client, logger *logrus.Logger) *StreamPublisher {
+	writeTimeout := cfg.EventStreamWriteTimeout
+	if writeTimeout <= 0 {
+		writeTimeout = 5 * time.Second
+	}
+
+	p := &StreamPublisher{
+		client:       client,
+		stream:       cfg.EventStreamName,
+		maxLen:       cfg.EventStreamMaxLen,
+		source:       cfg.ServiceInstanceName,
+		writeTimeout: writeTimeout,
+		logger:       logger,
+		metricsPort:  cfg.GetMetricsPort(),
+		now:          time.Now,
+	}
+	p.queue = infrastructure.NewAsyncQueue(cfg.EventStreamBufferSize, p.write, p.dropped)
+	return p
+}
+
+// SetClock replaces the time source (tests)
+func (p *StreamPublisher) SetClock(now func() time.Time) {
+	p.now = now
+}
+
+// Stream returns the name of the stream events are published to
+func (p *StreamPublisher) Stream() string {
+	return p.stream
+}
+
+// EnsureGroups creates the named consumer groups (and the stream) if they do not
+// exist yet. New groups start from the beginning of the stream, so consumers that
+// come up later still see every retained event.
+func (p *StreamPublisher) EnsureGroups(ctx context.Context, groups []string) error {
+	for _, group := range groups {
+		err := p.client.XGroupCreateMkStream(ctx, p.stream, group, "0").Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return fmt.Errorf("failed to create consumer group %s on %s: %w", group, p.stream, err)
+		}
+	}
+	return nil
+}
+
+// PublishDomainEvent stamps the envelope and queues the event without blocking
+func (p *StreamPublisher) PublishDomainEvent(ctx context.Context, event ports.DomainEvent) {
+	now := p.now()
+	event.ID = fmt.Sprintf("EVT_%d_%d", now.UnixNano(), atomic.AddUint64(&p.sequence, 1))
+	event.SchemaVersion = ports.DomainEventSchemaVersion
+	event.Source = p.source
+	event.CorrelationID = observability.CorrelationIDFromContext(ctx)
+	event.OccurredAt = now
+
+	p.queue.Enqueue(event)
+}
+
+// Ping checks that Redis is reachable, for readiness
+func (p *StreamPublisher) Ping(ctx context.Context) error {
+	return p.client.Ping(ctx).Err()
+}
+
+// Start runs the worker that appends queued events to the stream
+func (p *StreamPublisher) Start() {
+	p.queue.Start()
+}
+
+// Stop refuses new events, appends everything already queued and closes the client
+func (p *StreamPublisher) Stop() {
+	p.queue.Stop()
+	p.closeOnce.Do(func() {
+		if err := p.client.Close(); err != nil {
+			p.logger.WithError(err).Error("Failed to close event stream client")
+		}
+	})
+}
+
+func (p *StreamPublisher) write(event ports.DomainEvent) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.writeTimeout)
+	defer cancel()
+
+	result := "success"
+	values, err := EncodeDomainEvent(event)
+	if err == nil {
+		args := &redis.XAddArgs{
+			Stream: p.stream,
+			Values: values,
+		}
+		if p.maxLen > 0 {
+			args.MaxLen = p.maxLen
+			args.Approx = true
+		}
+		err = p.client.XAdd(ctx, args).Err()
+	}
+	if err != nil {
+		result = "failure"
+		p.logger.WithError(err).WithFields(logrus.Fields{
+			"stream":   p.stream,
+			"event_id": event.ID,
+			"type":     event.Type,
+		}).Warn("Failed to publish domain event")
+	}
+
+	if p.metricsPort != nil {
+		p.metricsPort.IncCounter("domain_events_published_total", map[string]string{
+			"type":   event.Type,
+			"result": result,
+		})
+	}
+}
+
+func (p *StreamPublisher) dropped(event ports.DomainEvent, reason string) {
+	p.logger.WithFields(logrus.Fields{
+		"event_id": event.ID,
+		"type":     event.Type,
+		"reason":   reason,
+	}).Warn("Dropped domain event")
+
+	if p.metricsPort != nil {
+		p.metricsPort.IncCounter("domain_events_dropped_total", map[string]string{
+			"type": event.Type,
+		})
+	}
+}
+
+// EncodeDomainEvent flattens an event into stream entry fields
+func EncodeDomainEvent(event ports.DomainEvent) (map[string]interface{}, error) {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s payload: %w", event.Type, err)
+	}
+
+	return map[string]interface{}{
+		FieldEventID:       event.ID,
+		FieldType:          event.Type,
+		FieldSchemaVersion: strconv.Itoa(event.SchemaVersion),
+		FieldSource:        event.Source,
+		FieldAggregateID:   event.AggregateID,
+		FieldCorrelationID: event.CorrelationID,
+		FieldOccurredAt:    event.OccurredAt.UTC().Format(time.RFC3339Nano),
+		FieldData:          string(data),
+	}, nil
+}
+
+// DecodeDomainEvent rebuilds an event from stream entry fields; Data is left as
+// json.RawMessage for the consumer to decode according to Type and SchemaVersion
+func DecodeDomainEvent(values map[string]interface{}) (ports.DomainEvent, error) {
+	field := func(name string) string {
+		value, _ := values[name].(string)
+		return value
+	}
+
+	version, err := strconv.Atoi(field(FieldSchemaVersion))
+	if err != nil {
+		return ports.DomainEvent{}, fmt.Errorf("invalid schema version %q", field(FieldSchemaVersion))
+	}
+	occurredAt, err := time.Parse(time.RFC3339Nano, field(FieldOccurredAt))
+	if err != nil {
+		return ports.DomainEvent{}, fmt.Errorf("invalid occurred_at %q", field(FieldOccurredAt))
+	}
+
+	return ports.DomainEvent{
+		ID:            field(FieldEventID),
+		Type:          field(FieldType),
+		SchemaVersion: version,
+		Source:        field(FieldSource),
+		AggregateID:   field(FieldAggregateID),
+		CorrelationID: field(FieldCorrelationID),
+		OccurredAt:    occurredAt,
+		Data:          json.RawMessage(field(FieldData)),
+	}, nil
+}
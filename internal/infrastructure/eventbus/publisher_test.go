@@ -0,0 +1,182 @@
+//go:build unit
+
+package eventbus_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/eventbus"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestStreamPublisher verifies custody events reach the Redis stream in a versioned envelope
+// Following BDD Given/When/Then pattern
+func TestStreamPublisher(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("publishes_custody_lifecycle_events_in_order", func(t *testing.T) {
+		// Given: A custodian service publishing to a per-instance stream
+		standIn, client := startRedisStandIn(t)
+		cfg := testConfig("custodian:events:custodian-Komainu")
+		publisher := eventbus.NewStreamPublisher(cfg, client, quietLogger())
+		publisher.Start()
+		svc := services.NewCustodianService(cfg, quietLogger())
+		svc.SetDomainEventPublisher(publisher)
+
+		// When: Accounts are created, funded and settled, and one settlement fails
+		reqCtx := observability.WithCorrelationID(ctx, "corr-7")
+		from, _ := svc.CreateAccount(reqCtx, "TRADING")
+		to, _ := svc.CreateAccount(reqCtx, "TRADING")
+		_, _ = svc.Deposit(reqCtx, from.ID, "BTC", 2)
+		_, _ = svc.SubmitSettlementInstruction(reqCtx, services.Settlement{ID: "SETTLE_OK", FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 1})
+		_, _ = svc.SubmitSettlementInstruction(reqCtx, services.Settlement{ID: "SETTLE_FAIL", FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 5})
+		publisher.Stop()
+
+		// Then: The stream holds every event in publication order
+		entries := standIn.entries("custodian:events:custodian-Komainu")
+		want := []string{
+			ports.DomainEventAccountCreated,
+			ports.DomainEventAccountCreated,
+			ports.DomainEventBalanceChanged,
+			ports.DomainEventBalanceChanged,
+			ports.DomainEventBalanceChanged,
+			ports.DomainEventSettlementSettled,
+			ports.DomainEventSettlementFailed,
+		}
+		if len(entries) != len(want) {
+			t.Fatalf("Expected %d events, got %d: %v", len(want), len(entries), entries)
+		}
+		for i, entry := range entries {
+			if entry[eventbus.FieldType] != want[i] {
+				t.Errorf("Event %d: expected %s, got %s", i, want[i], entry[eventbus.FieldType])
+			}
+		}
+
+		// And: The envelope carries the schema version, source and correlation ID
+		failed := toValues(entries[6])
+		event, err := eventbus.DecodeDomainEvent(failed)
+		if err != nil {
+			t.Fatalf("DecodeDomainEvent failed: %v", err)
+		}
+		if event.SchemaVersion != ports.DomainEventSchemaVersion || event.Source != "custodian-Komainu" ||
+			event.CorrelationID != "corr-7" || event.AggregateID != "SETTLE_FAIL" || event.ID == "" {
+			t.Errorf("Unexpected envelope: %+v", event)
+		}
+
+		// And: The payload decodes into the published data type
+		var data ports.SettlementEventData
+		if err := json.Unmarshal(event.Data.(json.RawMessage), &data); err != nil {
+			t.Fatalf("Failed to decode payload: %v", err)
+		}
+		if data.SettlementID != "SETTLE_FAIL" || data.Status != services.SettlementStatusFailed || data.Reason == "" {
+			t.Errorf("Unexpected settlement payload: %+v", data)
+		}
+	})
+
+	t.Run("consumer_groups_read_and_acknowledge_events", func(t *testing.T) {
+		// Given: A publisher whose consumer group was created before any event
+		standIn, client := startRedisStandIn(t)
+		cfg := testConfig("custodian:events:test")
+		publisher := eventbus.NewStreamPublisher(cfg, client, quietLogger())
+		if err := publisher.EnsureGroups(ctx, []string{"risk-monitor"}); err != nil {
+			t.Fatalf("EnsureGroups failed: %v", err)
+		}
+
+		// And: Creating the group again is not an error
+		if err := publisher.EnsureGroups(ctx, []string{"risk-monitor"}); err != nil {
+			t.Fatalf("EnsureGroups should be idempotent, got %v", err)
+		}
+
+		// When: Two events are published and the group reads them
+		publisher.Start()
+		publisher.PublishDomainEvent(ctx, ports.DomainEvent{Type: ports.DomainEventAccountCreated, AggregateID: "ACCT_1", Data: ports.AccountCreatedData{AccountID: "ACCT_1"}})
+		publisher.PublishDomainEvent(ctx, ports.DomainEvent{Type: ports.DomainEventAccountCreated, AggregateID: "ACCT_2", Data: ports.AccountCreatedData{AccountID: "ACCT_2"}})
+		publisher.Stop()
+
+		// The publisher closes its own client on Stop
+		consumer := standIn.newClient()
+		defer consumer.Close()
+		streams, err := consumer.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    "risk-monitor",
+			Consumer: "risk-1",
+			Streams:  []string{"custodian:events:test", ">"},
+			Count:    10,
+		}).Result()
+		if err != nil {
+			t.Fatalf("XReadGroup failed: %v", err)
+		}
+
+		// Then: Both events arrive with increasing entry IDs and can be acknowledged
+		messages := streams[0].Messages
+		if len(messages) != 2 || messages[0].ID >= messages[1].ID {
+			t.Fatalf("Expected two ordered messages, got %+v", messages)
+		}
+		if acked := consumer.XAck(ctx, "custodian:events:test", "risk-monitor", messages[0].ID, messages[1].ID).Val(); acked != 2 {
+			t.Errorf("Expected 2 acknowledgements, got %d", acked)
+		}
+		if pending := standIn.pending("custodian:events:test", "risk-monitor"); pending != 0 {
+			t.Errorf("Expected no pending entries, got %d", pending)
+		}
+	})
+
+	t.Run("broker_failures_do_not_block_publishers", func(t *testing.T) {
+		// Given: A broker rejecting every write
+		standIn, client := startRedisStandIn(t)
+		standIn.setFailXAdd(true)
+		publisher := eventbus.NewStreamPublisher(testConfig("custodian:events:test"), client, quietLogger())
+		publisher.Start()
+
+		// When: An event is published
+		done := make(chan struct{})
+		go func() {
+			publisher.PublishDomainEvent(ctx, ports.DomainEvent{Type: ports.DomainEventAccountCreated, AggregateID: "ACCT_1"})
+			close(done)
+		}()
+
+		// Then: The caller returns immediately and nothing is written
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("PublishDomainEvent blocked on a failing broker")
+		}
+		publisher.Stop()
+		if entries := standIn.entries("custodian:events:test"); len(entries) != 0 {
+			t.Errorf("Expected no entries, got %d", len(entries))
+		}
+	})
+}
+
+func testConfig(stream string) *config.Config {
+	return &config.Config{
+		ServiceName:             "custodian-simulator",
+		ServiceInstanceName:     "custodian-Komainu",
+		AccountEventBufferSize:  100,
+		EventStreamName:         stream,
+		EventStreamBufferSize:   64,
+		EventStreamWriteTimeout: time.Second,
+	}
+}
+
+func toValues(fields map[string]string) map[string]interface{} {
+	values := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		values[k] = v
+	}
+	return values
+}
+
+func quietLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
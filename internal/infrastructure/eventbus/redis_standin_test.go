@@ -0,0 +1,404 @@
+//go:build unit
+
+package eventbus_test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisStandIn is an in-process server speaking just enough RESP2 for the stream
+// commands used by the event bus: XADD, XRANGE, XLEN, XGROUP CREATE, XREADGROUP
+// and XACK. Entry IDs are "<n>-0" with n counting from 1 per stream.
+type redisStandIn struct {
+	addr     string
+	mu       sync.Mutex
+	streams  map[string]*standInStream
+	failXAdd bool
+}
+
+type standInStream struct {
+	lastID  int
+	entries []standInEntry
+	groups  map[string]*standInGroup
+}
+
+type standInEntry struct {
+	id     int
+	fields []string
+}
+
+type standInGroup struct {
+	lastDelivered int
+	pending       map[int]bool
+}
+
+// startRedisStandIn serves a stand-in on a loopback port and returns a client for it
+func startRedisStandIn(t *testing.T) (*redisStandIn, *redis.Client) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	standIn := &redisStandIn{addr: lis.Addr().String(), streams: make(map[string]*standInStream)}
+
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go standIn.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { lis.Close() })
+
+	return standIn, standIn.newClient()
+}
+
+// newClient returns another client connected to the stand-in
+func (r *redisStandIn) newClient() *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:            r.addr,
+		Protocol:        2,
+		DisableIdentity: true,
+	})
+}
+
+// setFailXAdd makes XADD return an error until cleared
+func (r *redisStandIn) setFailXAdd(fail bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failXAdd = fail
+}
+
+// entries returns the field maps of every entry in a stream, oldest first
+func (r *redisStandIn) entries(stream string) []map[string]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := r.streams[stream]
+	if s == nil {
+		return nil
+	}
+	list := make([]map[string]string, 0, len(s.entries))
+	for _, entry := range s.entries {
+		fields := make(map[string]string, len(entry.fields)/2)
+		for i := 0; i+1 < len(entry.fields); i += 2 {
+			fields[entry.fields[i]] = entry.fields[i+1]
+		}
+		list = append(list, fields)
+	}
+	return list
+}
+
+// delivered returns how many entries of a stream a group has been handed
+func (r *redisStandIn) delivered(stream, group string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s := r.streams[stream]; s != nil && s.groups[group] != nil {
+		return s.groups[group].lastDelivered
+	}
+	return 0
+}
+
+// pending returns the number of delivered but unacknowledged entries of a group
+func (r *redisStandIn) pending(stream, group string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s := r.streams[stream]; s != nil && s.groups[group] != nil {
+		return len(s.groups[group].pending)
+	}
+	return 0
+}
+
+func (r *redisStandIn) serve(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	writer := bufio.NewWriter(conn)
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		r.execute(writer, args)
+		if err := writer.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (r *redisStandIn) execute(w *bufio.Writer, args []string) {
+	if len(args) == 0 {
+		writeError(w, "ERR empty command")
+		return
+	}
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		w.WriteString("+PONG\r\n")
+	case "XADD":
+		r.xadd(w, args[1:])
+	case "XRANGE":
+		r.xrange(w, args[1:])
+	case "XLEN":
+		r.mu.Lock()
+		n := 0
+		if s := r.streams[args[1]]; s != nil {
+			n = len(s.entries)
+		}
+		r.mu.Unlock()
+		writeInteger(w, n)
+	case "XGROUP":
+		r.xgroup(w, args[1:])
+	case "XREADGROUP":
+		r.xreadgroup(w, args[1:])
+	case "XACK":
+		r.xack(w, args[1:])
+	default:
+		// HELLO, CLIENT and anything else: the client falls back to plain RESP2
+		writeError(w, fmt.Sprintf("ERR unknown command '%s'", args[0]))
+	}
+}
+
+func (r *redisStandIn) stream(name string, create bool) *standInStream {
+	s := r.streams[name]
+	if s == nil && create {
+		s = &standInStream{groups: make(map[string]*standInGroup)}
+		r.streams[name] = s
+	}
+	return s
+}
+
+// XADD key [MAXLEN [~|=] n] * field value ...
+func (r *redisStandIn) xadd(w *bufio.Writer, args []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.failXAdd {
+		writeError(w, "ERR stand-in XADD failure")
+		return
+	}
+
+	key, rest := args[0], args[1:]
+	maxLen := 0
+	if len(rest) > 0 && strings.EqualFold(rest[0], "MAXLEN") {
+		rest = rest[1:]
+		if rest[0] == "~" || rest[0] == "=" {
+			rest = rest[1:]
+		}
+		maxLen, _ = strconv.Atoi(rest[0])
+		rest = rest[1:]
+	}
+	if len(rest) < 3 || rest[0] != "*" || len(rest[1:])%2 != 0 {
+		writeError(w, "ERR stand-in only supports XADD key [MAXLEN n] * field value ...")
+		return
+	}
+
+	s := r.stream(key, true)
+	s.lastID++
+	s.entries = append(s.entries, standInEntry{id: s.lastID, fields: append([]string(nil), rest[1:]...)})
+	if maxLen > 0 && len(s.entries) > maxLen {
+		s.entries = s.entries[len(s.entries)-maxLen:]
+	}
+	writeBulk(w, formatEntryID(s.lastID))
+}
+
+// XRANGE key - +
+func (r *redisStandIn) xrange(w *bufio.Writer, args []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var entries []standInEntry
+	if s := r.stream(args[0], false); s != nil {
+		entries = s.entries
+	}
+	writeEntries(w, entries)
+}
+
+// XGROUP CREATE key group id [MKSTREAM]
+func (r *redisStandIn) xgroup(w *bufio.Writer, args []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(args) < 4 || !strings.EqualFold(args[0], "CREATE") {
+		writeError(w, "ERR stand-in only supports XGROUP CREATE")
+		return
+	}
+	mkStream := len(args) > 4 && strings.EqualFold(args[4], "MKSTREAM")
+	s := r.stream(args[1], mkStream)
+	if s == nil {
+		writeError(w, "ERR The XGROUP subcommand requires the key to exist")
+		return
+	}
+	if s.groups[args[2]] != nil {
+		writeError(w, "BUSYGROUP Consumer Group name already exists")
+		return
+	}
+
+	start := 0
+	if args[3] == "$" {
+		start = s.lastID
+	}
+	s.groups[args[2]] = &standInGroup{lastDelivered: start, pending: make(map[int]bool)}
+	w.WriteString("+OK\r\n")
+}
+
+// XREADGROUP GROUP group consumer [COUNT n] [BLOCK ms] STREAMS key >
+func (r *redisStandIn) xreadgroup(w *bufio.Writer, args []string) {
+	if len(args) < 3 || !strings.EqualFold(args[0], "GROUP") {
+		writeError(w, "ERR syntax error")
+		return
+	}
+	group, rest := args[1], args[3:]
+
+	count, block := 0, time.Duration(0)
+	for len(rest) > 0 && !strings.EqualFold(rest[0], "STREAMS") {
+		switch strings.ToUpper(rest[0]) {
+		case "COUNT":
+			count, _ = strconv.Atoi(rest[1])
+		case "BLOCK":
+			ms, _ := strconv.Atoi(rest[1])
+			block = time.Duration(ms) * time.Millisecond
+		}
+		rest = rest[2:]
+	}
+	if len(rest) != 3 || rest[2] != ">" {
+		writeError(w, "ERR stand-in only supports XREADGROUP ... STREAMS key >")
+		return
+	}
+	key := rest[1]
+
+	// Blocking reads poll briefly so tests stay fast
+	if block > 100*time.Millisecond {
+		block = 100 * time.Millisecond
+	}
+	deadline := time.Now().Add(block)
+	for {
+		r.mu.Lock()
+		s := r.stream(key, false)
+		if s == nil || s.groups[group] == nil {
+			r.mu.Unlock()
+			writeError(w, fmt.Sprintf("NOGROUP No such key '%s' or consumer group '%s'", key, group))
+			return
+		}
+		g := s.groups[group]
+
+		var delivered []standInEntry
+		for _, entry := range s.entries {
+			if entry.id > g.lastDelivered && (count == 0 || len(delivered) < count) {
+				delivered = append(delivered, entry)
+			}
+		}
+		for _, entry := range delivered {
+			g.lastDelivered = entry.id
+			g.pending[entry.id] = true
+		}
+		r.mu.Unlock()
+
+		if len(delivered) > 0 {
+			w.WriteString("*1\r\n*2\r\n")
+			writeBulk(w, key)
+			writeEntries(w, delivered)
+			return
+		}
+		if !time.Now().Before(deadline) {
+			w.WriteString("*-1\r\n")
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// XACK key group id ...
+func (r *redisStandIn) xack(w *bufio.Writer, args []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	acked := 0
+	if s := r.stream(args[0], false); s != nil && s.groups[args[1]] != nil {
+		g := s.groups[args[1]]
+		for _, raw := range args[2:] {
+			id, _ := strconv.Atoi(strings.TrimSuffix(raw, "-0"))
+			if g.pending[id] {
+				delete(g.pending, id)
+				acked++
+			}
+		}
+	}
+	writeInteger(w, acked)
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimRight(line, "\r\n")
+	if !strings.HasPrefix(line, "*") {
+		return strings.Fields(line), nil
+	}
+
+	n, err := strconv.Atoi(line[1:])
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimRight(header, "\r\n")[1:])
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			return nil, err
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+func writeEntries(w *bufio.Writer, entries []standInEntry) {
+	fmt.Fprintf(w, "*%d\r\n", len(entries))
+	for _, entry := range entries {
+		w.WriteString("*2\r\n")
+		writeBulk(w, formatEntryID(entry.id))
+		fmt.Fprintf(w, "*%d\r\n", len(entry.fields))
+		for _, field := range entry.fields {
+			writeBulk(w, field)
+		}
+	}
+}
+
+func writeBulk(w *bufio.Writer, s string) {
+	fmt.Fprintf(w, "$%d\r\n%s\r\n", len(s), s)
+}
+
+func writeInteger(w *bufio.Writer, n int) {
+	fmt.Fprintf(w, ":%d\r\n", n)
+}
+
+func writeError(w *bufio.Writer, msg string) {
+	fmt.Fprintf(w, "-%s\r\n", msg)
+}
+
+func formatEntryID(id int) string {
+	return fmt.Sprintf("%d-0", id)
+}
@@ -0,0 +1,179 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// TradeStreamConsumer reads exchange execution events from a Redis stream as a
+// member of a consumer group and hands them to a ports.TradeEventHandler. Entries
+// use the same envelope as published domain events; TradeExecuted and OrderFilled
+// are handled and other types are skipped. Every entry is acknowledged once
+// handled, including duplicates and entries that cannot be settled, so a restart
+// only redelivers entries that were never handled; duplicates among those are
+// discarded by the handler.
+type TradeStreamConsumer struct {
+	client       *redis.Client
+	stream       string
+	group        string
+	consumer     string
+	batchSize    int64
+	blockTimeout time.Duration
+	handler      ports.TradeEventHandler
+	logger       *logrus.Logger
+	metricsPort  ports.MetricsPort
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+func NewTradeStreamConsumer(cfg *config.Config, client *redis.Client, handler ports.TradeEventHandler, logger *logrus.Logger) *TradeStreamConsumer {
+	batchSize := int64(cfg.TradeStreamBatchSize)
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	blockTimeout := cfg.TradeStreamBlockTimeout
+	if blockTimeout <= 0 {
+		blockTimeout = 2 * time.Second
+	}
+
+	return &TradeStreamConsumer{
+		client:       client,
+		stream:       cfg.TradeStreamName,
+		group:        cfg.TradeStreamGroup,
+		consumer:     cfg.ServiceInstanceName,
+		batchSize:    batchSize,
+		blockTimeout: blockTimeout,
+		handler:      handler,
+		logger:       logger,
+		metricsPort:  cfg.GetMetricsPort(),
+	}
+}
+
+// Start joins the consumer group, creating it at the start of the stream if
+// needed, and consumes in the background until Stop
+func (c *TradeStreamConsumer) Start(ctx context.Context) error {
+	err := c.client.XGroupCreateMkStream(ctx, c.stream, c.group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create consumer group %s on %s: %w", c.group, c.stream, err)
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.run(runCtx)
+	}()
+
+	return nil
+}
+
+// Stop ends consumption and closes the client
+func (c *TradeStreamConsumer) Stop() {
+	c.once.Do(func() {
+		if c.cancel != nil {
+			c.cancel()
+		}
+		c.wg.Wait()
+
+		if err := c.client.Close(); err != nil {
+			c.logger.WithError(err).Error("Failed to close trade stream client")
+		}
+	})
+}
+
+func (c *TradeStreamConsumer) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		streams, err := c.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.group,
+			Consumer: c.consumer,
+			Streams:  []string{c.stream, ">"},
+			Count:    c.batchSize,
+			Block:    c.blockTimeout,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.logger.WithError(err).WithField("stream", c.stream).Warn("Failed to read trade stream")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(c.blockTimeout):
+			}
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, message := range stream.Messages {
+				c.handle(ctx, message)
+			}
+		}
+	}
+}
+
+func (c *TradeStreamConsumer) handle(ctx context.Context, message redis.XMessage) {
+	result := c.dispatch(ctx, message)
+
+	if c.metricsPort != nil {
+		c.metricsPort.IncCounter("trade_events_consumed_total", map[string]string{
+			"result": result,
+		})
+	}
+
+	if err := c.client.XAck(ctx, c.stream, c.group, message.ID).Err(); err != nil && ctx.Err() == nil {
+		c.logger.WithError(err).WithField("entry_id", message.ID).Warn("Failed to acknowledge trade event")
+	}
+}
+
+// dispatch decodes and handles one entry, returning the metric result label
+func (c *TradeStreamConsumer) dispatch(ctx context.Context, message redis.XMessage) string {
+	logger := c.logger.WithFields(logrus.Fields{
+		"stream":   c.stream,
+		"entry_id": message.ID,
+	})
+
+	event, err := DecodeDomainEvent(message.Values)
+	if err != nil {
+		logger.WithError(err).Warn("Skipping malformed trade event")
+		return "malformed"
+	}
+	if event.Type != ports.TradeEventExecuted && event.Type != ports.TradeEventFilled {
+		return "ignored"
+	}
+
+	var trade ports.TradeEvent
+	if err := json.Unmarshal(event.Data.(json.RawMessage), &trade); err != nil {
+		logger.WithError(err).Warn("Skipping malformed trade event")
+		return "malformed"
+	}
+
+	err = c.handler.HandleTradeEvent(ctx, trade)
+	switch {
+	case err == nil:
+		return "settled"
+	case errors.Is(err, ports.ErrDuplicateTrade):
+		logger.WithField("trade_id", trade.TradeID).Debug("Skipping duplicate trade")
+		return "duplicate"
+	default:
+		logger.WithError(err).WithField("trade_id", trade.TradeID).Warn("Failed to create settlement instructions for trade")
+		return "rejected"
+	}
+}
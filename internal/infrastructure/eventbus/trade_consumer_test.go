@@ -0,0 +1,107 @@
+//go:build unit
+
+package eventbus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/eventbus"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestTradeStreamConsumer verifies exchange settlements flow through to custodian accounts
+// Following BDD Given/When/Then pattern
+func TestTradeStreamConsumer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("exchange_trades_settle_into_custodian_accounts", func(t *testing.T) {
+		// Given: Funded buyer and seller accounts and a consumer on the exchange trade stream
+		standIn, client := startRedisStandIn(t)
+		cfg := testConfig("custodian:events:test")
+		cfg.TradeStreamName = "exchange:trades"
+		cfg.TradeStreamGroup = "custodian-simulator"
+		cfg.TradeStreamBlockTimeout = 20 * time.Millisecond
+
+		svc := services.NewCustodianService(cfg, quietLogger())
+		buyer, _ := svc.CreateAccount(ctx, "TRADING")
+		seller, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.Deposit(ctx, buyer.ID, "USD", 10000)
+		_, _ = svc.Deposit(ctx, seller.ID, "ETH", 5)
+
+		ingestor, err := services.NewTradeSettlementIngestor(svc, cfg, quietLogger())
+		if err != nil {
+			t.Fatalf("NewTradeSettlementIngestor failed: %v", err)
+		}
+		consumer := eventbus.NewTradeStreamConsumer(cfg, client, ingestor, quietLogger())
+		if err := consumer.Start(ctx); err != nil {
+			t.Fatalf("Start failed: %v", err)
+		}
+		defer consumer.Stop()
+
+		// When: The exchange publishes a fill twice, plus an event the custodian does not handle
+		producer := standIn.newClient()
+		defer producer.Close()
+		fill := ports.TradeEvent{
+			TradeID: "FILL_1", Symbol: "ETH/USD", Quantity: 2, Price: 1500,
+			BuyerAccountID: buyer.ID, SellerAccountID: seller.ID, ExecutedAt: time.Now(),
+		}
+		publishExchangeEvent(t, producer, ports.TradeEventFilled, fill)
+		publishExchangeEvent(t, producer, "OrderPlaced", map[string]string{"order_id": "O1"})
+		publishExchangeEvent(t, producer, ports.TradeEventFilled, fill)
+
+		// Then: The fill settles exactly once
+		waitFor(t, func() bool {
+			settlement, err := svc.GetSettlement(ctx, "SETTLE_FILL_1_PAY")
+			return err == nil && settlement.Status == services.SettlementStatusCompleted
+		})
+		waitFor(t, func() bool {
+			return standIn.delivered("exchange:trades", "custodian-simulator") == 3 && standIn.pending("exchange:trades", "custodian-simulator") == 0
+		})
+
+		balance, _ := svc.GetAccountBalance(ctx, buyer.ID, "ETH")
+		if balance != 2 {
+			t.Errorf("Expected buyer to receive 2 ETH once, got %v", balance)
+		}
+		balance, _ = svc.GetAccountBalance(ctx, seller.ID, "USD")
+		if balance != 3000 {
+			t.Errorf("Expected seller to receive 3000 USD once, got %v", balance)
+		}
+	})
+}
+
+// publishExchangeEvent appends an event to the exchange trade stream the way exchange-simulator does
+func publishExchangeEvent(t *testing.T, client *redis.Client, eventType string, data interface{}) {
+	t.Helper()
+
+	values, err := eventbus.EncodeDomainEvent(ports.DomainEvent{
+		ID:            "EXCH_" + eventType,
+		Type:          eventType,
+		SchemaVersion: 1,
+		Source:        "exchange-simulator",
+		OccurredAt:    time.Now(),
+		Data:          data,
+	})
+	if err != nil {
+		t.Fatalf("EncodeDomainEvent failed: %v", err)
+	}
+	if err := client.XAdd(context.Background(), &redis.XAddArgs{Stream: "exchange:trades", Values: values}).Err(); err != nil {
+		t.Fatalf("XAdd failed: %v", err)
+	}
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for condition")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -8,11 +8,17 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	auditv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/audit/v1"
+	custodianv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/custodian/v1"
+	exchangev1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/exchange/v1"
 	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/security"
 )
 
 type DefaultInterServiceClientManager struct {
@@ -21,32 +27,32 @@ type DefaultInterServiceClientManager struct {
 	configClient    ConfigurationClientInterface
 	logger          *logrus.Logger
 
-	// Connection management
-	connections      map[string]*grpc.ClientConn
-	connectionsMutex sync.RWMutex
-
 	// Connection pooling and circuit breaker
 	connectionPool map[string]*ConnectionPool
 	poolMutex      sync.RWMutex
+	stopReaper     chan struct{}
+	reaperOnce     sync.Once
+	breakers       map[string]*CircuitBreaker
+	breakersMutex  sync.Mutex
+	retryPolicy    RetryPolicy
+
+	// Instance selection across discovered peers
+	lbStrategy LoadBalancingStrategy
 
 	// Statistics
-	activeConnections int64
 	totalConnections  int64
 	failedConnections int64
+	closedConnections int64
 	statsMutex        sync.RWMutex
 }
 
-type ConnectionPool struct {
-	connections []*grpc.ClientConn
-	index       int
-	mutex       sync.Mutex
-	maxSize     int
-}
-
 type ConnectionStats struct {
-	ActiveConnections int64 `json:"active_connections"`
-	TotalConnections  int64 `json:"total_connections"`
-	FailedConnections int64 `json:"failed_connections"`
+	ActiveConnections int64                   `json:"active_connections"` // Open pooled connections
+	TotalConnections  int64                   `json:"total_connections"`  // Connections dialed since start
+	FailedConnections int64                   `json:"failed_connections"`
+	ClosedConnections int64                   `json:"closed_connections"` // Evicted, reaped or closed on cleanup
+	PoolSizes         map[string]int          `json:"pool_sizes"`
+	CircuitBreakers   map[string]CircuitState `json:"circuit_breakers"`
 }
 
 // Service client interfaces
@@ -62,6 +68,12 @@ type ExchangeSimulatorClientInterface interface {
 type AuditCorrelatorClientInterface interface {
 	ServiceClientInterface
 	GetAuditMetrics(ctx context.Context) (AuditMetrics, error)
+	RecordAuditEvent(ctx context.Context, event ports.AuditEvent) error
+}
+
+// SettlementNotificationClientInterface calls the settlement callback implemented by peers
+type SettlementNotificationClientInterface interface {
+	NotifySettlement(ctx context.Context, notificationID string, notification ports.SettlementNotification) error
 }
 
 // Response types
@@ -106,18 +118,41 @@ func (e *ServiceUnavailableError) Error() string {
 	return fmt.Sprintf("service '%s' is unavailable: %v", e.ServiceName, e.Cause)
 }
 
+func (e *ServiceUnavailableError) Unwrap() error {
+	return e.Cause
+}
+
 func NewInterServiceClientManager(cfg *config.Config) *DefaultInterServiceClientManager {
 	logger := logrus.New()
 	logger.SetLevel(getLogLevel(cfg.LogLevel))
 
+	lbStrategy, err := ParseLoadBalancingStrategy(cfg.LoadBalancingStrategy)
+	if err != nil {
+		logger.WithError(err).Warn("Falling back to round-robin load balancing")
+		lbStrategy = LoadBalancingRoundRobin
+	}
+
 	return &DefaultInterServiceClientManager{
 		config:         cfg,
 		logger:         logger,
-		connections:    make(map[string]*grpc.ClientConn),
 		connectionPool: make(map[string]*ConnectionPool),
+		stopReaper:     make(chan struct{}),
+		breakers:       make(map[string]*CircuitBreaker),
+		retryPolicy: RetryPolicy{
+			MaxAttempts:    cfg.RetryMaxAttempts,
+			InitialBackoff: cfg.RetryInitialBackoff,
+			MaxBackoff:     cfg.RetryMaxBackoff,
+			Multiplier:     2,
+		},
+		lbStrategy: lbStrategy,
 	}
 }
 
+// SetServiceDiscovery replaces the Redis-backed discovery created by Initialize (tests, custom registries)
+func (cm *DefaultInterServiceClientManager) SetServiceDiscovery(sd ServiceDiscoveryInterface) {
+	cm.serviceDiscovery = sd
+}
+
 func (cm *DefaultInterServiceClientManager) Initialize(ctx context.Context) error {
 	// Initialize service discovery
 	cm.serviceDiscovery = NewServiceDiscovery(cm.config)
@@ -136,25 +171,17 @@ func (cm *DefaultInterServiceClientManager) Initialize(ctx context.Context) erro
 }
 
 func (cm *DefaultInterServiceClientManager) Cleanup(ctx context.Context) error {
-	cm.connectionsMutex.Lock()
-	defer cm.connectionsMutex.Unlock()
-
-	// Close all connections
-	for serviceName, conn := range cm.connections {
-		if err := conn.Close(); err != nil {
-			cm.logger.WithError(err).WithField("service", serviceName).Warn("Failed to close connection")
-		}
+	// Stop idle reaping (and keep it from starting later), then close all pooled connections
+	cm.reaperOnce.Do(func() {})
+	select {
+	case <-cm.stopReaper:
+	default:
+		close(cm.stopReaper)
 	}
-	cm.connections = make(map[string]*grpc.ClientConn)
 
-	// Cleanup connection pools
 	cm.poolMutex.Lock()
 	for _, pool := range cm.connectionPool {
-		for _, conn := range pool.connections {
-			if err := conn.Close(); err != nil {
-				cm.logger.WithError(err).Warn("Failed to close pooled connection")
-			}
-		}
+		pool.Close()
 	}
 	cm.connectionPool = make(map[string]*ConnectionPool)
 	cm.poolMutex.Unlock()
@@ -182,10 +209,7 @@ func (cm *DefaultInterServiceClientManager) GetExchangeSimulatorClient(ctx conte
 		return nil, err
 	}
 
-	return &ExchangeSimulatorClient{
-		conn:   conn,
-		logger: cm.logger,
-	}, nil
+	return NewExchangeSimulatorClient(conn, cm.logger), nil
 }
 
 func (cm *DefaultInterServiceClientManager) GetAuditCorrelatorClient(ctx context.Context) (AuditCorrelatorClientInterface, error) {
@@ -194,10 +218,17 @@ func (cm *DefaultInterServiceClientManager) GetAuditCorrelatorClient(ctx context
 		return nil, err
 	}
 
-	return &AuditCorrelatorClient{
-		conn:   conn,
-		logger: cm.logger,
-	}, nil
+	return NewAuditCorrelatorClient(conn, cm.logger), nil
+}
+
+// GetSettlementNotificationClient returns a client for the settlement callback of any peer service
+func (cm *DefaultInterServiceClientManager) GetSettlementNotificationClient(ctx context.Context, serviceName string) (SettlementNotificationClientInterface, error) {
+	conn, err := cm.getServiceConnection(ctx, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSettlementNotificationClient(conn, cm.logger), nil
 }
 
 func (cm *DefaultInterServiceClientManager) GetClientByName(ctx context.Context, serviceName string) (ServiceClientInterface, error) {
@@ -237,28 +268,168 @@ func (cm *DefaultInterServiceClientManager) DiscoverServices(ctx context.Context
 }
 
 func (cm *DefaultInterServiceClientManager) GetConnectionStats() ConnectionStats {
+	breakers := make(map[string]CircuitState)
+	cm.breakersMutex.Lock()
+	for serviceName, breaker := range cm.breakers {
+		breakers[serviceName] = breaker.State()
+	}
+	cm.breakersMutex.Unlock()
+
+	poolSizes := make(map[string]int)
+	var active int64
+	cm.poolMutex.RLock()
+	for serviceName, pool := range cm.connectionPool {
+		size := pool.Size()
+		poolSizes[serviceName] = size
+		active += int64(size)
+	}
+	cm.poolMutex.RUnlock()
+
 	cm.statsMutex.RLock()
 	defer cm.statsMutex.RUnlock()
 
 	return ConnectionStats{
-		ActiveConnections: cm.activeConnections,
+		ActiveConnections: active,
 		TotalConnections:  cm.totalConnections,
 		FailedConnections: cm.failedConnections,
+		ClosedConnections: cm.closedConnections,
+		PoolSizes:         poolSizes,
+		CircuitBreakers:   breakers,
+	}
+}
+
+// circuitBreaker returns the breaker for serviceName, creating it on first use
+func (cm *DefaultInterServiceClientManager) circuitBreaker(serviceName string) *CircuitBreaker {
+	cm.breakersMutex.Lock()
+	defer cm.breakersMutex.Unlock()
+
+	if breaker, exists := cm.breakers[serviceName]; exists {
+		return breaker
+	}
+
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: cm.config.CircuitBreakerFailureThreshold,
+		OpenTimeout:      cm.config.CircuitBreakerOpenTimeout,
+		HalfOpenMaxCalls: cm.config.CircuitBreakerHalfOpenMaxCalls,
+	})
+	breaker.OnStateChange(func(from, to CircuitState) {
+		cm.logger.WithFields(logrus.Fields{
+			"service": serviceName,
+			"from":    from,
+			"to":      to,
+		}).Warn("Circuit breaker state changed")
+
+		if metricsPort := cm.config.GetMetricsPort(); metricsPort != nil {
+			labels := map[string]string{"service": serviceName}
+			metricsPort.SetGauge("circuit_breaker_state", circuitStateValue(to), labels)
+			metricsPort.IncCounter("circuit_breaker_transitions_total", map[string]string{
+				"service": serviceName,
+				"to":      string(to),
+			})
+		}
+	})
+	cm.breakers[serviceName] = breaker
+
+	return breaker
+}
+
+// circuitStateValue encodes a breaker state for the circuit_breaker_state gauge
+func circuitStateValue(state CircuitState) float64 {
+	switch state {
+	case CircuitOpen:
+		return 2
+	case CircuitHalfOpen:
+		return 1
+	default:
+		return 0
 	}
 }
 
 func (cm *DefaultInterServiceClientManager) getServiceConnection(ctx context.Context, serviceName string) (*grpc.ClientConn, error) {
-	cm.connectionsMutex.Lock()
-	defer cm.connectionsMutex.Unlock()
+	return cm.connectionPoolFor(serviceName).Get(ctx)
+}
+
+// connectionPoolFor returns the pool for serviceName, creating it on first use
+func (cm *DefaultInterServiceClientManager) connectionPoolFor(serviceName string) *ConnectionPool {
+	cm.poolMutex.RLock()
+	pool, exists := cm.connectionPool[serviceName]
+	cm.poolMutex.RUnlock()
+	if exists {
+		return pool
+	}
+
+	cm.poolMutex.Lock()
+	defer cm.poolMutex.Unlock()
+
+	if pool, exists := cm.connectionPool[serviceName]; exists {
+		return pool
+	}
+
+	pool = NewConnectionPool(cm.config.ConnectionPoolSize, cm.config.ConnectionIdleTimeout, func(ctx context.Context, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+		return cm.dialService(ctx, serviceName, opts...)
+	})
+	pool.OnClose(func(reason string) {
+		cm.incrementClosedConnections()
+		cm.logger.WithFields(logrus.Fields{
+			"service": serviceName,
+			"reason":  reason,
+		}).Debug("Closed pooled gRPC connection")
+	})
+	cm.connectionPool[serviceName] = pool
+
+	cm.reaperOnce.Do(func() {
+		go cm.reapIdleConnections()
+	})
+
+	return pool
+}
+
+// reapIdleConnections periodically closes pooled connections idle longer than the configured timeout
+func (cm *DefaultInterServiceClientManager) reapIdleConnections() {
+	interval := cm.config.ConnectionIdleTimeout / 2
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-cm.stopReaper:
+			return
+		case now := <-ticker.C:
+			cm.poolMutex.RLock()
+			for serviceName, pool := range cm.connectionPool {
+				if reaped := pool.ReapIdle(now); reaped > 0 {
+					cm.logger.WithFields(logrus.Fields{
+						"service": serviceName,
+						"reaped":  reaped,
+					}).Debug("Reaped idle gRPC connections")
+				}
+			}
+			cm.poolMutex.RUnlock()
+		}
+	}
+}
+
+// dialService opens one connection to serviceName through the discovery resolver
+func (cm *DefaultInterServiceClientManager) dialService(ctx context.Context, serviceName string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	// Fail fast while the peer is known to be down instead of rediscovering and redialing
+	breaker := cm.circuitBreaker(serviceName)
+	if breaker.State() == CircuitOpen {
+		return nil, &ServiceUnavailableError{
+			ServiceName: serviceName,
+			Cause:       ErrCircuitOpen,
+		}
+	}
 
-	// Check if we already have a connection
-	if conn, exists := cm.connections[serviceName]; exists {
-		if conn.GetState() == connectivity.Ready || conn.GetState() == connectivity.Idle {
-			return conn, nil
+	if cm.serviceDiscovery == nil {
+		cm.incrementFailedConnections()
+		return nil, &ServiceUnavailableError{
+			ServiceName: serviceName,
+			Cause:       fmt.Errorf("service discovery not initialized"),
 		}
-		// Connection is not healthy, remove it
-		conn.Close()
-		delete(cm.connections, serviceName)
 	}
 
 	// Discover service
@@ -271,20 +442,37 @@ func (cm *DefaultInterServiceClientManager) getServiceConnection(ctx context.Con
 		}
 	}
 
-	if len(services) == 0 {
+	healthy := FilterHealthyInstances(services, cm.config.ServiceInstanceMaxAge, time.Now())
+	if len(healthy) == 0 {
 		cm.incrementFailedConnections()
+		breaker.RecordFailure()
 		return nil, &ServiceUnavailableError{
 			ServiceName: serviceName,
-			Cause:       fmt.Errorf("no instances found"),
+			Cause:       fmt.Errorf("no healthy instances found (%d discovered)", len(services)),
 		}
 	}
 
-	// Use the first available service
-	service := services[0]
-	target := fmt.Sprintf("%s:%d", service.Host, service.GRPCPort)
+	// The discovery resolver keeps the instance list current; the balancer picks per call
+	target := fmt.Sprintf("%s:///%s", discoveryScheme, serviceName)
+	resolverBuilder := newDiscoveryResolverBuilder(cm.serviceDiscovery, cm.config.ServiceInstanceMaxAge, cm.config.DiscoveryRefreshInterval, cm.logger)
 
-	// Create new connection
-	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := cm.transportCredentials()
+	if err != nil {
+		cm.incrementFailedConnections()
+		return nil, &ServiceUnavailableError{
+			ServiceName: serviceName,
+			Cause:       err,
+		}
+	}
+
+	// Create new connection; every call on it goes through the service's breaker
+	opts = append(opts,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithResolvers(resolverBuilder),
+		grpc.WithDefaultServiceConfig(cm.lbStrategy.serviceConfig()),
+		grpc.WithChainUnaryInterceptor(ResilienceUnaryInterceptor(serviceName, breaker, cm.retryPolicy, cm.config.GetMetricsPort())),
+	)
+	conn, err := grpc.Dial(target, opts...)
 	if err != nil {
 		cm.incrementFailedConnections()
 		return nil, &ServiceUnavailableError{
@@ -293,23 +481,36 @@ func (cm *DefaultInterServiceClientManager) getServiceConnection(ctx context.Con
 		}
 	}
 
-	// Store connection
-	cm.connections[serviceName] = conn
-	cm.incrementActiveConnections()
 	cm.incrementTotalConnections()
 
 	cm.logger.WithFields(logrus.Fields{
-		"service": serviceName,
-		"target":  target,
+		"service":   serviceName,
+		"target":    target,
+		"instances": len(healthy),
+		"strategy":  cm.lbStrategy,
 	}).Info("Established gRPC connection")
 
 	return conn, nil
 }
 
-func (cm *DefaultInterServiceClientManager) incrementActiveConnections() {
+// transportCredentials returns mTLS credentials when TLS is enabled, otherwise plaintext
+// Certificates are loaded on every dial so rotated files are picked up on reconnect
+func (cm *DefaultInterServiceClientManager) transportCredentials() (credentials.TransportCredentials, error) {
+	if !cm.config.TLSEnabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	creds, err := security.ClientCredentials(cm.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client TLS credentials: %w", err)
+	}
+	return creds, nil
+}
+
+func (cm *DefaultInterServiceClientManager) incrementClosedConnections() {
 	cm.statsMutex.Lock()
 	defer cm.statsMutex.Unlock()
-	cm.activeConnections++
+	cm.closedConnections++
 }
 
 func (cm *DefaultInterServiceClientManager) incrementTotalConnections() {
@@ -330,6 +531,14 @@ type ExchangeSimulatorClient struct {
 	logger *logrus.Logger
 }
 
+// NewExchangeSimulatorClient wraps an existing connection to an exchange-simulator instance
+func NewExchangeSimulatorClient(conn *grpc.ClientConn, logger *logrus.Logger) *ExchangeSimulatorClient {
+	return &ExchangeSimulatorClient{
+		conn:   conn,
+		logger: logger,
+	}
+}
+
 func (c *ExchangeSimulatorClient) HealthCheck(ctx context.Context) (HealthStatus, error) {
 	client := grpc_health_v1.NewHealthClient(c.conn)
 	resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{
@@ -355,13 +564,21 @@ func (c *ExchangeSimulatorClient) HealthCheck(ctx context.Context) (HealthStatus
 }
 
 func (c *ExchangeSimulatorClient) GetTradingStatus(ctx context.Context) (TradingStatus, error) {
-	// This would be a real gRPC call in production
-	// For now, return mock data
-	return TradingStatus{
-		ActiveTrades:  10,
-		TotalVolume:   1000000,
-		LastTradeTime: time.Now(),
-	}, nil
+	resp, err := exchangev1.NewExchangeSimulatorServiceClient(c.conn).GetTradingStatus(ctx, &exchangev1.GetTradingStatusRequest{})
+	if err != nil {
+		c.logger.WithError(err).Debug("Exchange simulator GetTradingStatus failed")
+		return TradingStatus{}, err
+	}
+
+	status := TradingStatus{
+		ActiveTrades: int(resp.GetActiveTrades()),
+		TotalVolume:  resp.GetTotalVolume(),
+	}
+	if resp.GetLastTradeTime() != nil {
+		status.LastTradeTime = resp.GetLastTradeTime().AsTime()
+	}
+
+	return status, nil
 }
 
 type AuditCorrelatorClient struct {
@@ -369,6 +586,14 @@ type AuditCorrelatorClient struct {
 	logger *logrus.Logger
 }
 
+// NewAuditCorrelatorClient wraps an existing connection to an audit-correlator instance
+func NewAuditCorrelatorClient(conn *grpc.ClientConn, logger *logrus.Logger) *AuditCorrelatorClient {
+	return &AuditCorrelatorClient{
+		conn:   conn,
+		logger: logger,
+	}
+}
+
 func (c *AuditCorrelatorClient) HealthCheck(ctx context.Context) (HealthStatus, error) {
 	client := grpc_health_v1.NewHealthClient(c.conn)
 	resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{
@@ -394,13 +619,94 @@ func (c *AuditCorrelatorClient) HealthCheck(ctx context.Context) (HealthStatus,
 }
 
 func (c *AuditCorrelatorClient) GetAuditMetrics(ctx context.Context) (AuditMetrics, error) {
-	// This would be a real gRPC call in production
-	// For now, return mock data
-	return AuditMetrics{
-		TotalEvents:      5000,
-		CorrelatedEvents: 4800,
-		LastUpdated:      time.Now(),
-	}, nil
+	resp, err := auditv1.NewAuditCorrelatorServiceClient(c.conn).GetAuditMetrics(ctx, &auditv1.GetAuditMetricsRequest{})
+	if err != nil {
+		c.logger.WithError(err).Debug("Audit correlator GetAuditMetrics failed")
+		return AuditMetrics{}, err
+	}
+
+	metrics := AuditMetrics{
+		TotalEvents:      resp.GetTotalEvents(),
+		CorrelatedEvents: resp.GetCorrelatedEvents(),
+	}
+	if resp.GetLastUpdated() != nil {
+		metrics.LastUpdated = resp.GetLastUpdated().AsTime()
+	}
+
+	return metrics, nil
+}
+
+func (c *AuditCorrelatorClient) RecordAuditEvent(ctx context.Context, event ports.AuditEvent) error {
+	balances := make([]*auditv1.AuditBalance, 0, len(event.Balances))
+	for _, b := range event.Balances {
+		balances = append(balances, &auditv1.AuditBalance{
+			AccountId:       b.AccountID,
+			AssetId:         b.AssetID,
+			BalanceBefore:   b.BalanceBefore,
+			BalanceAfter:    b.BalanceAfter,
+			AvailableBefore: b.AvailableBefore,
+			AvailableAfter:  b.AvailableAfter,
+		})
+	}
+
+	_, err := auditv1.NewAuditCorrelatorServiceClient(c.conn).RecordAuditEvent(ctx, &auditv1.RecordAuditEventRequest{
+		Event: &auditv1.AuditEvent{
+			EventId:       event.ID,
+			Timestamp:     timestamppb.New(event.Timestamp),
+			Service:       event.Service,
+			Instance:      event.Instance,
+			Actor:         event.Actor,
+			ActorVerified: event.ActorVerified,
+			CorrelationId: event.CorrelationID,
+			TraceId:       event.TraceID,
+			Action:        event.Action,
+			Outcome:       event.Outcome,
+			ResourceType:  event.ResourceType,
+			ResourceId:    event.ResourceID,
+			Balances:      balances,
+			Details:       event.Details,
+			Error:         event.Error,
+		},
+	})
+	if err != nil {
+		c.logger.WithError(err).Debug("Audit correlator RecordAuditEvent failed")
+		return err
+	}
+
+	return nil
+}
+
+type SettlementNotificationClient struct {
+	conn   *grpc.ClientConn
+	logger *logrus.Logger
+}
+
+// NewSettlementNotificationClient wraps an existing connection to a peer implementing SettlementNotificationReceiver
+func NewSettlementNotificationClient(conn *grpc.ClientConn, logger *logrus.Logger) *SettlementNotificationClient {
+	return &SettlementNotificationClient{
+		conn:   conn,
+		logger: logger,
+	}
+}
+
+func (c *SettlementNotificationClient) NotifySettlement(ctx context.Context, notificationID string, notification ports.SettlementNotification) error {
+	_, err := custodianv1.NewSettlementNotificationReceiverClient(c.conn).NotifySettlement(ctx, &custodianv1.NotifySettlementRequest{
+		NotificationId: notificationID,
+		SettlementId:   notification.SettlementID,
+		Status:         notification.Status,
+		FromAccountId:  notification.FromAccountID,
+		ToAccountId:    notification.ToAccountID,
+		AssetId:        notification.AssetID,
+		Amount:         notification.Amount,
+		Reason:         notification.Reason,
+		OccurredAt:     timestamppb.New(notification.OccurredAt),
+	})
+	if err != nil {
+		c.logger.WithError(err).Debug("Peer NotifySettlement failed")
+		return err
+	}
+
+	return nil
 }
 
 type GenericServiceClient struct {
@@ -0,0 +1,196 @@
+//go:build unit
+
+package infrastructure_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/audit/v1"
+	exchangev1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/exchange/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+)
+
+// fakeExchange stands in for exchange-simulator
+type fakeExchange struct {
+	exchangev1.UnimplementedExchangeSimulatorServiceServer
+	resp *exchangev1.GetTradingStatusResponse
+	err  error
+}
+
+func (f *fakeExchange) GetTradingStatus(context.Context, *exchangev1.GetTradingStatusRequest) (*exchangev1.GetTradingStatusResponse, error) {
+	return f.resp, f.err
+}
+
+// fakeAudit stands in for audit-correlator
+type fakeAudit struct {
+	auditv1.UnimplementedAuditCorrelatorServiceServer
+	resp     *auditv1.GetAuditMetricsResponse
+	recorded chan *auditv1.AuditEvent
+}
+
+func (f *fakeAudit) GetAuditMetrics(context.Context, *auditv1.GetAuditMetricsRequest) (*auditv1.GetAuditMetricsResponse, error) {
+	return f.resp, nil
+}
+
+func (f *fakeAudit) RecordAuditEvent(_ context.Context, req *auditv1.RecordAuditEventRequest) (*auditv1.RecordAuditEventResponse, error) {
+	f.recorded <- req.GetEvent()
+	return &auditv1.RecordAuditEventResponse{}, nil
+}
+
+// TestPeerServiceClients verifies the exchange and audit clients call the peer APIs
+// Following BDD Given/When/Then pattern
+func TestPeerServiceClients(t *testing.T) {
+	lastTrade := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	t.Run("exchange_client_returns_peer_trading_status", func(t *testing.T) {
+		// Given: A fake exchange simulator with known activity
+		conn := startFakePeer(t, func(s *grpc.Server) {
+			exchangev1.RegisterExchangeSimulatorServiceServer(s, &fakeExchange{resp: &exchangev1.GetTradingStatusResponse{
+				ActiveTrades:  3,
+				TotalVolume:   4200,
+				LastTradeTime: timestamppb.New(lastTrade),
+			}})
+		})
+		client := infrastructure.NewExchangeSimulatorClient(conn, quietLogger())
+
+		// When: Trading status is requested
+		got, err := client.GetTradingStatus(context.Background())
+
+		// Then: The peer's values are returned, not canned data
+		if err != nil {
+			t.Fatalf("GetTradingStatus failed: %v", err)
+		}
+		if got.ActiveTrades != 3 || got.TotalVolume != 4200 || !got.LastTradeTime.Equal(lastTrade) {
+			t.Errorf("Unexpected trading status: %+v", got)
+		}
+	})
+
+	t.Run("exchange_client_propagates_peer_errors", func(t *testing.T) {
+		// Given: A fake exchange simulator that is not ready
+		conn := startFakePeer(t, func(s *grpc.Server) {
+			exchangev1.RegisterExchangeSimulatorServiceServer(s, &fakeExchange{err: status.Error(codes.Unavailable, "warming up")})
+		})
+		client := infrastructure.NewExchangeSimulatorClient(conn, quietLogger())
+
+		// When: Trading status is requested
+		_, err := client.GetTradingStatus(context.Background())
+
+		// Then: The gRPC status reaches the caller
+		if status.Code(err) != codes.Unavailable {
+			t.Errorf("Expected Unavailable, got %v", err)
+		}
+	})
+
+	t.Run("audit_client_returns_peer_metrics", func(t *testing.T) {
+		// Given: A fake audit correlator
+		conn := startFakePeer(t, func(s *grpc.Server) {
+			auditv1.RegisterAuditCorrelatorServiceServer(s, &fakeAudit{resp: &auditv1.GetAuditMetricsResponse{
+				TotalEvents:      120,
+				CorrelatedEvents: 90,
+				LastUpdated:      timestamppb.New(lastTrade),
+			}})
+		})
+		client := infrastructure.NewAuditCorrelatorClient(conn, quietLogger())
+
+		// When: Audit metrics are requested
+		got, err := client.GetAuditMetrics(context.Background())
+
+		// Then: The peer's counters are returned
+		if err != nil {
+			t.Fatalf("GetAuditMetrics failed: %v", err)
+		}
+		if got.TotalEvents != 120 || got.CorrelatedEvents != 90 || !got.LastUpdated.Equal(lastTrade) {
+			t.Errorf("Unexpected audit metrics: %+v", got)
+		}
+	})
+
+	t.Run("audit_client_records_events", func(t *testing.T) {
+		// Given: A fake audit correlator capturing recorded events
+		audit := &fakeAudit{recorded: make(chan *auditv1.AuditEvent, 1)}
+		conn := startFakePeer(t, func(s *grpc.Server) {
+			auditv1.RegisterAuditCorrelatorServiceServer(s, audit)
+		})
+		client := infrastructure.NewAuditCorrelatorClient(conn, quietLogger())
+
+		// When: A settlement audit event is recorded
+		err := client.RecordAuditEvent(context.Background(), ports.AuditEvent{
+			ID:            "AUDIT_1_1",
+			Timestamp:     lastTrade,
+			Actor:         "exchange-simulator",
+			ActorVerified: true,
+			CorrelationID: "corr-1",
+			TraceID:       "4bf92f3577b34da6a3ce929d0e0e4736",
+			Action:        "settlement.process",
+			Outcome:       ports.AuditOutcomeSuccess,
+			ResourceID:    "SETTLE_1",
+			Balances: []ports.AuditBalance{
+				{AccountID: "ACCT_A", AssetID: "BTC", BalanceBefore: 5, BalanceAfter: 3},
+			},
+			Details: map[string]string{"amount": "2"},
+		})
+
+		// Then: The correlator receives every field
+		if err != nil {
+			t.Fatalf("RecordAuditEvent failed: %v", err)
+		}
+		got := <-audit.recorded
+		if got.GetEventId() != "AUDIT_1_1" || got.GetActor() != "exchange-simulator" || !got.GetActorVerified() ||
+			got.GetCorrelationId() != "corr-1" || got.GetTraceId() != "4bf92f3577b34da6a3ce929d0e0e4736" ||
+			!got.GetTimestamp().AsTime().Equal(lastTrade) || got.GetDetails()["amount"] != "2" {
+			t.Errorf("Unexpected audit event: %+v", got)
+		}
+		if len(got.GetBalances()) != 1 || got.GetBalances()[0].GetBalanceBefore() != 5 || got.GetBalances()[0].GetBalanceAfter() != 3 {
+			t.Errorf("Unexpected balances: %+v", got.GetBalances())
+		}
+	})
+}
+
+func startFakePeer(t *testing.T, register func(*grpc.Server), opts ...grpc.DialOption) *grpc.ClientConn {
+	t.Helper()
+
+	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(startPeerServer(t, register), opts...)
+	if err != nil {
+		t.Fatalf("Failed to dial fake peer: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+// startPeerServer serves a fake peer on a loopback port and returns its address
+func startPeerServer(t *testing.T, register func(*grpc.Server)) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+
+	server := grpc.NewServer()
+	register(server)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func quietLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
@@ -0,0 +1,134 @@
+package infrastructure
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/balancer/roundrobin"
+)
+
+// LoadBalancingStrategy selects how calls are spread across a peer's instances
+type LoadBalancingStrategy string
+
+const (
+	// LoadBalancingRoundRobin cycles through ready instances in turn
+	LoadBalancingRoundRobin LoadBalancingStrategy = "round_robin"
+	// LoadBalancingLeastRequests sends each call to the instance with the fewest calls in flight
+	LoadBalancingLeastRequests LoadBalancingStrategy = "least_requests"
+)
+
+// leastRequestsBalancerName is the gRPC balancer registered for LoadBalancingLeastRequests
+const leastRequestsBalancerName = "custodian_least_requests"
+
+func init() {
+	balancer.Register(base.NewBalancerBuilder(leastRequestsBalancerName, newLeastRequestsPickerBuilder(), base.Config{HealthCheck: true}))
+}
+
+// ParseLoadBalancingStrategy validates a configured strategy name
+func ParseLoadBalancingStrategy(name string) (LoadBalancingStrategy, error) {
+	switch strategy := LoadBalancingStrategy(name); strategy {
+	case LoadBalancingRoundRobin, LoadBalancingLeastRequests:
+		return strategy, nil
+	case "":
+		return LoadBalancingRoundRobin, nil
+	default:
+		return "", fmt.Errorf("unknown load balancing strategy %q", name)
+	}
+}
+
+// serviceConfig returns the gRPC service config that activates the strategy's balancer
+func (s LoadBalancingStrategy) serviceConfig() string {
+	name := roundrobin.Name
+	if s == LoadBalancingLeastRequests {
+		name = leastRequestsBalancerName
+	}
+	return fmt.Sprintf(`{"loadBalancingConfig":[{%q:{}}]}`, name)
+}
+
+// FilterHealthyInstances keeps instances reporting "healthy" whose LastSeen is within maxAge of now
+// A non-positive maxAge disables the freshness check
+func FilterHealthyInstances(instances []ServiceInfo, maxAge time.Duration, now time.Time) []ServiceInfo {
+	healthy := make([]ServiceInfo, 0, len(instances))
+	for _, instance := range instances {
+		if instance.Status != "healthy" {
+			continue
+		}
+		if maxAge > 0 && now.Sub(instance.LastSeen) > maxAge {
+			continue
+		}
+		healthy = append(healthy, instance)
+	}
+	return healthy
+}
+
+// leastRequestsPickerBuilder keeps in-flight counters per SubConn across picker rebuilds,
+// so calls started before an instance joins or leaves are still accounted for
+type leastRequestsPickerBuilder struct {
+	mu       sync.Mutex
+	inFlight map[balancer.SubConn]*int64
+}
+
+func newLeastRequestsPickerBuilder() *leastRequestsPickerBuilder {
+	return &leastRequestsPickerBuilder{inFlight: make(map[balancer.SubConn]*int64)}
+}
+
+func (b *leastRequestsPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Idle counters of SubConns that are no longer ready carry no information
+	for subConn, counter := range b.inFlight {
+		if _, ready := info.ReadySCs[subConn]; !ready && atomic.LoadInt64(counter) == 0 {
+			delete(b.inFlight, subConn)
+		}
+	}
+
+	picker := &leastRequestsPicker{}
+	for subConn := range info.ReadySCs {
+		counter, exists := b.inFlight[subConn]
+		if !exists {
+			counter = new(int64)
+			b.inFlight[subConn] = counter
+		}
+		picker.subConns = append(picker.subConns, subConn)
+		picker.inFlight = append(picker.inFlight, counter)
+	}
+	return picker
+}
+
+// leastRequestsPicker scans all ready SubConns; ties rotate so idle instances share load evenly
+type leastRequestsPicker struct {
+	subConns []balancer.SubConn
+	inFlight []*int64
+	next     uint32
+}
+
+func (p *leastRequestsPicker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
+	n := len(p.subConns)
+	start := int(atomic.AddUint32(&p.next, 1)) % n
+
+	chosen := start
+	for i := 1; i < n; i++ {
+		candidate := (start + i) % n
+		if atomic.LoadInt64(p.inFlight[candidate]) < atomic.LoadInt64(p.inFlight[chosen]) {
+			chosen = candidate
+		}
+	}
+
+	counter := p.inFlight[chosen]
+	atomic.AddInt64(counter, 1)
+	return balancer.PickResult{
+		SubConn: p.subConns[chosen],
+		Done: func(balancer.DoneInfo) {
+			atomic.AddInt64(counter, -1)
+		},
+	}, nil
+}
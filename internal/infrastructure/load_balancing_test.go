@@ -0,0 +1,247 @@
+//go:build unit
+
+package infrastructure_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	exchangev1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/exchange/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+)
+
+// staticDiscovery serves a replaceable instance list in place of the Redis registry
+type staticDiscovery struct {
+	mu        sync.Mutex
+	instances []infrastructure.ServiceInfo
+}
+
+func (d *staticDiscovery) Connect(context.Context) error    { return nil }
+func (d *staticDiscovery) Disconnect(context.Context) error { return nil }
+
+func (d *staticDiscovery) DiscoverServices(_ context.Context, serviceName string) ([]infrastructure.ServiceInfo, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var matched []infrastructure.ServiceInfo
+	for _, instance := range d.instances {
+		if instance.Name == serviceName {
+			matched = append(matched, instance)
+		}
+	}
+	return matched, nil
+}
+
+func (d *staticDiscovery) set(instances ...infrastructure.ServiceInfo) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.instances = instances
+}
+
+// countingExchange counts calls and, while blocking is set, parks them until release is closed
+type countingExchange struct {
+	exchangev1.UnimplementedExchangeSimulatorServiceServer
+	calls    int32
+	blocking atomic.Bool
+	arrived  chan struct{}
+	release  chan struct{}
+}
+
+func newCountingExchange() *countingExchange {
+	return &countingExchange{arrived: make(chan struct{}, 16), release: make(chan struct{})}
+}
+
+func (e *countingExchange) GetTradingStatus(ctx context.Context, _ *exchangev1.GetTradingStatusRequest) (*exchangev1.GetTradingStatusResponse, error) {
+	atomic.AddInt32(&e.calls, 1)
+	if e.blocking.Load() {
+		e.arrived <- struct{}{}
+		select {
+		case <-e.release:
+		case <-ctx.Done():
+		}
+	}
+	return &exchangev1.GetTradingStatusResponse{}, nil
+}
+
+func (e *countingExchange) count() int32 {
+	return atomic.LoadInt32(&e.calls)
+}
+
+func TestFilterHealthyInstances(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	instances := []infrastructure.ServiceInfo{
+		{Host: "fresh", Status: "healthy", LastSeen: now.Add(-10 * time.Second)},
+		{Host: "stale", Status: "healthy", LastSeen: now.Add(-5 * time.Minute)},
+		{Host: "starting", Status: "starting", LastSeen: now},
+	}
+
+	t.Run("drops_unhealthy_and_stale_instances", func(t *testing.T) {
+		got := infrastructure.FilterHealthyInstances(instances, time.Minute, now)
+		if len(got) != 1 || got[0].Host != "fresh" {
+			t.Errorf("Expected only the fresh instance, got %+v", got)
+		}
+	})
+
+	t.Run("zero_max_age_disables_freshness_check", func(t *testing.T) {
+		got := infrastructure.FilterHealthyInstances(instances, 0, now)
+		if len(got) != 2 {
+			t.Errorf("Expected both healthy instances, got %+v", got)
+		}
+	})
+}
+
+// TestInterServiceLoadBalancing verifies calls are spread across discovered instances
+// Following BDD Given/When/Then pattern
+func TestInterServiceLoadBalancing(t *testing.T) {
+	t.Run("round_robin_uses_every_healthy_instance", func(t *testing.T) {
+		// Given: Two healthy instances, plus an unhealthy and a stale entry for a third server
+		first, second, skipped := newCountingExchange(), newCountingExchange(), newCountingExchange()
+		skippedAddr := startExchangePeer(t, skipped)
+		discovery := &staticDiscovery{}
+		discovery.set(
+			exchangeInstance(t, startExchangePeer(t, first), "healthy", time.Now()),
+			exchangeInstance(t, startExchangePeer(t, second), "healthy", time.Now()),
+			exchangeInstance(t, skippedAddr, "unhealthy", time.Now()),
+			exchangeInstance(t, skippedAddr, "healthy", time.Now().Add(-time.Hour)),
+		)
+		client := newBalancedExchangeClient(t, discovery, "round_robin")
+
+		// When: Calls are made until both healthy instances have served one
+		callUntil(t, client, func() bool { return first.count() > 0 && second.count() > 0 })
+
+		// Then: The filtered-out server never receives traffic
+		if skipped.count() != 0 {
+			t.Errorf("Expected no calls to unhealthy or stale instance, got %d", skipped.count())
+		}
+	})
+
+	t.Run("least_requests_avoids_busy_instance", func(t *testing.T) {
+		// Given: Two instances that have both served calls
+		busy, idle := newCountingExchange(), newCountingExchange()
+		discovery := &staticDiscovery{}
+		discovery.set(
+			exchangeInstance(t, startExchangePeer(t, busy), "healthy", time.Now()),
+			exchangeInstance(t, startExchangePeer(t, idle), "healthy", time.Now()),
+		)
+		client := newBalancedExchangeClient(t, discovery, "least_requests")
+		callUntil(t, client, func() bool { return busy.count() > 0 && idle.count() > 0 })
+
+		// When: One instance starts holding calls open and more calls arrive
+		busy.blocking.Store(true)
+		parked := 0
+		for i := 0; i < 10; i++ {
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_, _ = client.GetTradingStatus(context.Background())
+			}()
+
+			select {
+			case <-busy.arrived:
+				parked++
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("Call neither completed nor reached the busy instance")
+			}
+		}
+
+		// Then: Only one call is parked; the rest go to the instance with nothing in flight
+		if parked != 1 {
+			t.Errorf("Expected exactly 1 call on the busy instance, got %d", parked)
+		}
+	})
+
+	t.Run("follows_instance_churn", func(t *testing.T) {
+		// Given: A connection established while only the old instance is registered
+		old, replacement := newCountingExchange(), newCountingExchange()
+		discovery := &staticDiscovery{}
+		discovery.set(exchangeInstance(t, startExchangePeer(t, old), "healthy", time.Now()))
+		client := newBalancedExchangeClient(t, discovery, "round_robin")
+		callUntil(t, client, func() bool { return old.count() > 0 })
+
+		// When: The registry is updated to the replacement instance
+		discovery.set(exchangeInstance(t, startExchangePeer(t, replacement), "healthy", time.Now()))
+		callUntil(t, client, func() bool { return replacement.count() > 0 })
+
+		// Then: The old instance receives no further calls
+		before := old.count()
+		for i := 0; i < 10; i++ {
+			if _, err := client.GetTradingStatus(context.Background()); err != nil {
+				t.Fatalf("GetTradingStatus failed: %v", err)
+			}
+		}
+		if old.count() != before {
+			t.Errorf("Expected old instance to be drained, got %d more calls", old.count()-before)
+		}
+	})
+}
+
+func newBalancedExchangeClient(t *testing.T, discovery *staticDiscovery, strategy string) infrastructure.ExchangeSimulatorClientInterface {
+	t.Helper()
+
+	manager := infrastructure.NewInterServiceClientManager(&config.Config{
+		LogLevel:                 "error",
+		LoadBalancingStrategy:    strategy,
+		ServiceInstanceMaxAge:    time.Minute,
+		DiscoveryRefreshInterval: 20 * time.Millisecond,
+	})
+	manager.SetServiceDiscovery(discovery)
+	t.Cleanup(func() { _ = manager.Cleanup(context.Background()) })
+
+	client, err := manager.GetExchangeSimulatorClient(context.Background())
+	if err != nil {
+		t.Fatalf("GetExchangeSimulatorClient failed: %v", err)
+	}
+	return client
+}
+
+func startExchangePeer(t *testing.T, peer *countingExchange) string {
+	t.Helper()
+
+	addr := startPeerServer(t, func(s *grpc.Server) {
+		exchangev1.RegisterExchangeSimulatorServiceServer(s, peer)
+	})
+	// Runs before the server stops so parked handlers can return
+	t.Cleanup(func() { close(peer.release) })
+	return addr
+}
+
+func exchangeInstance(t *testing.T, addr, status string, lastSeen time.Time) infrastructure.ServiceInfo {
+	t.Helper()
+
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		t.Fatalf("Invalid peer address %q: %v", addr, err)
+	}
+	port, _ := strconv.Atoi(portStr)
+
+	return infrastructure.ServiceInfo{
+		Name:     "exchange-simulator",
+		Host:     host,
+		GRPCPort: port,
+		Status:   status,
+		LastSeen: lastSeen,
+	}
+}
+
+func callUntil(t *testing.T, client infrastructure.ExchangeSimulatorClientInterface, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("Condition not reached before deadline")
+		}
+		if _, err := client.GetTradingStatus(context.Background()); err != nil {
+			t.Fatalf("GetTradingStatus failed: %v", err)
+		}
+	}
+}
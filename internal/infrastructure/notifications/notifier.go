@@ -0,0 +1,242 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+)
+
+// SettlementNotifier implements ports.SettlementNotifierPort with an outbox: each event
+// is recorded once per target, then delivered in the background with jittered
+// exponential backoff until it succeeds, fails permanently or runs out of attempts.
+// Pending records in a durable outbox are resumed after a restart.
+type SettlementNotifier struct {
+	outbox          *Outbox
+	targets         map[string]Target
+	retryPolicy     infrastructure.RetryPolicy
+	maxAttempts     int
+	pollInterval    time.Duration
+	deliveryTimeout time.Duration
+	logger          *logrus.Logger
+	metricsPort     ports.MetricsPort
+
+	now      func() time.Time
+	sequence uint64
+	wake     chan struct{}
+	stop     chan struct{}
+	wg       sync.WaitGroup
+	once     sync.Once
+}
+
+func NewSettlementNotifier(cfg *config.Config, outbox *Outbox, targets []Target, logger *logrus.Logger) *SettlementNotifier {
+	n := &SettlementNotifier{
+		outbox:  outbox,
+		targets: make(map[string]Target, len(targets)),
+		retryPolicy: infrastructure.RetryPolicy{
+			InitialBackoff: cfg.NotificationRetryInitialBackoff,
+			MaxBackoff:     cfg.NotificationRetryMaxBackoff,
+			Multiplier:     2,
+		},
+		maxAttempts:     cfg.NotificationMaxAttempts,
+		pollInterval:    cfg.NotificationPollInterval,
+		deliveryTimeout: cfg.NotificationDeliveryTimeout,
+		logger:          logger,
+		metricsPort:     cfg.GetMetricsPort(),
+		now:             time.Now,
+		wake:            make(chan struct{}, 1),
+		stop:            make(chan struct{}),
+	}
+	if n.maxAttempts <= 0 {
+		n.maxAttempts = 1
+	}
+	if n.pollInterval <= 0 {
+		n.pollInterval = time.Second
+	}
+	if n.deliveryTimeout <= 0 {
+		n.deliveryTimeout = 5 * time.Second
+	}
+
+	for _, target := range targets {
+		n.targets[target.Name()] = target
+	}
+
+	return n
+}
+
+// SetClock replaces the time source (tests)
+func (n *SettlementNotifier) SetClock(now func() time.Time) {
+	n.now = now
+}
+
+// NotifySettlement records one pending notification per target and wakes the dispatcher
+func (n *SettlementNotifier) NotifySettlement(ctx context.Context, event ports.SettlementNotification) error {
+	now := n.now()
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = now
+	}
+
+	var errs []error
+	for name := range n.targets {
+		record := Notification{
+			ID:            n.generateNotificationID(),
+			Target:        name,
+			Event:         event,
+			Status:        DeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if err := n.outbox.Save(record); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	select {
+	case n.wake <- struct{}{}:
+	default:
+	}
+
+	return errors.Join(errs...)
+}
+
+// Notification returns the delivery status of one notification
+func (n *SettlementNotifier) Notification(id string) (Notification, bool) {
+	return n.outbox.Get(id)
+}
+
+// Notifications lists notifications oldest first, optionally filtered by status
+func (n *SettlementNotifier) Notifications(status DeliveryStatus) []Notification {
+	return n.outbox.List(status)
+}
+
+// Start runs the dispatcher until Stop is called
+func (n *SettlementNotifier) Start() {
+	n.wg.Add(1)
+	go n.run()
+
+	n.logger.WithField("targets", len(n.targets)).Info("Settlement notifier started")
+}
+
+// Stop halts the dispatcher and waits for in-flight deliveries; pending records stay in the outbox
+func (n *SettlementNotifier) Stop() {
+	n.once.Do(func() { close(n.stop) })
+	n.wg.Wait()
+}
+
+func (n *SettlementNotifier) run() {
+	defer n.wg.Done()
+
+	ticker := time.NewTicker(n.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		n.DispatchDue()
+
+		select {
+		case <-n.stop:
+			return
+		case <-ticker.C:
+		case <-n.wake:
+		}
+	}
+}
+
+// DispatchDue attempts every due notification once. Targets are served concurrently
+// and notifications to the same target in creation order.
+func (n *SettlementNotifier) DispatchDue() {
+	byTarget := make(map[string][]Notification)
+	for _, record := range n.outbox.Due(n.now()) {
+		byTarget[record.Target] = append(byTarget[record.Target], record)
+	}
+
+	var wg sync.WaitGroup
+	for _, records := range byTarget {
+		wg.Add(1)
+		go func(records []Notification) {
+			defer wg.Done()
+			for _, record := range records {
+				n.attempt(record)
+			}
+		}(records)
+	}
+	wg.Wait()
+}
+
+func (n *SettlementNotifier) attempt(record Notification) {
+	target, exists := n.targets[record.Target]
+
+	var err error
+	if !exists {
+		err = Permanent(fmt.Errorf("target %s is no longer configured", record.Target))
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), n.deliveryTimeout)
+		err = target.Deliver(ctx, record.ID, record.Event)
+		cancel()
+	}
+
+	now := n.now()
+	record.Attempts++
+	record.UpdatedAt = now
+
+	switch {
+	case err == nil:
+		record.Status = DeliveryDelivered
+		record.LastError = ""
+		record.DeliveredAt = &now
+	case IsPermanent(err) || record.Attempts >= n.maxAttempts:
+		record.Status = DeliveryFailed
+		record.LastError = err.Error()
+	default:
+		record.LastError = err.Error()
+		record.NextAttemptAt = now.Add(n.retryPolicy.Backoff(record.Attempts))
+	}
+
+	if saveErr := n.outbox.Save(record); saveErr != nil {
+		// The record stays due and is retried; the receiver deduplicates on notification ID
+		n.logger.WithError(saveErr).WithField("notification_id", record.ID).Error("Failed to record notification delivery")
+		return
+	}
+
+	n.recordOutcome(record, err)
+}
+
+func (n *SettlementNotifier) recordOutcome(record Notification, err error) {
+	fields := logrus.Fields{
+		"notification_id": record.ID,
+		"settlement_id":   record.Event.SettlementID,
+		"target":          record.Target,
+		"attempts":        record.Attempts,
+		"status":          record.Status,
+	}
+
+	switch record.Status {
+	case DeliveryDelivered:
+		n.logger.WithFields(fields).Debug("Settlement notification delivered")
+	case DeliveryFailed:
+		n.logger.WithFields(fields).WithError(err).Error("Settlement notification failed permanently")
+	default:
+		fields["next_attempt_at"] = record.NextAttemptAt
+		n.logger.WithFields(fields).WithError(err).Warn("Settlement notification delivery failed, will retry")
+	}
+
+	if n.metricsPort != nil {
+		n.metricsPort.IncCounter("settlement_notification_attempts_total", map[string]string{
+			"target": record.Target,
+			"status": string(record.Status),
+		})
+	}
+}
+
+func (n *SettlementNotifier) generateNotificationID() string {
+	// Several records are created in the same instant (one per target), so add a sequence
+	return fmt.Sprintf("NOTIF_%d_%d", time.Now().UnixNano(), atomic.AddUint64(&n.sequence, 1))
+}
@@ -0,0 +1,348 @@
+//go:build unit
+
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	custodianv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/custodian/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/notifications"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// scriptedTarget returns the queued errors in order, then succeeds
+type scriptedTarget struct {
+	name string
+
+	mu        sync.Mutex
+	errs      []error
+	delivered []string
+}
+
+func (s *scriptedTarget) Name() string { return s.name }
+
+func (s *scriptedTarget) Deliver(_ context.Context, notificationID string, _ ports.SettlementNotification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+		return err
+	}
+	s.delivered = append(s.delivered, notificationID)
+	return nil
+}
+
+// TestSettlementNotifier verifies outbox delivery, retries and per-notification status
+// Following BDD Given/When/Then pattern
+func TestSettlementNotifier(t *testing.T) {
+	event := ports.SettlementNotification{SettlementID: "SETTLE_1", Status: "completed", AssetID: "BTC", Amount: 1}
+
+	newNotifier := func(t *testing.T, targets ...notifications.Target) (*notifications.SettlementNotifier, *time.Time) {
+		outbox, err := notifications.OpenOutbox("")
+		if err != nil {
+			t.Fatalf("OpenOutbox failed: %v", err)
+		}
+		cfg := &config.Config{
+			NotificationMaxAttempts:         3,
+			NotificationRetryInitialBackoff: time.Second,
+			NotificationRetryMaxBackoff:     time.Minute,
+		}
+		notifier := notifications.NewSettlementNotifier(cfg, outbox, targets, quietLogger())
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		notifier.SetClock(func() time.Time { return now })
+		return notifier, &now
+	}
+
+	t.Run("retries_transient_failures_until_delivered", func(t *testing.T) {
+		// Given: A target that fails twice
+		target := &scriptedTarget{name: "peer:exchange-simulator", errs: []error{errors.New("unavailable"), errors.New("unavailable")}}
+		notifier, now := newNotifier(t, target)
+
+		// When: The notification is recorded and dispatched as attempts come due
+		if err := notifier.NotifySettlement(context.Background(), event); err != nil {
+			t.Fatalf("NotifySettlement failed: %v", err)
+		}
+		notifier.DispatchDue()
+		pending := notifier.Notifications(notifications.DeliveryPending)
+		if len(pending) != 1 || pending[0].Attempts != 1 || pending[0].LastError != "unavailable" {
+			t.Fatalf("Expected one pending notification after first attempt, got %+v", pending)
+		}
+
+		// And: Nothing is retried before its backoff elapses
+		notifier.DispatchDue()
+		if got, _ := notifier.Notification(pending[0].ID); got.Attempts != 1 {
+			t.Errorf("Expected no retry before backoff, got %d attempts", got.Attempts)
+		}
+
+		for i := 0; i < 2; i++ {
+			*now = now.Add(time.Minute)
+			notifier.DispatchDue()
+		}
+
+		// Then: The notification is delivered on the third attempt
+		got, _ := notifier.Notification(pending[0].ID)
+		if got.Status != notifications.DeliveryDelivered || got.Attempts != 3 || got.DeliveredAt == nil {
+			t.Errorf("Expected delivered after 3 attempts, got %+v", got)
+		}
+		if len(target.delivered) != 1 || target.delivered[0] != got.ID {
+			t.Errorf("Expected target to receive notification ID, got %v", target.delivered)
+		}
+	})
+
+	t.Run("permanent_errors_fail_immediately", func(t *testing.T) {
+		target := &scriptedTarget{name: "webhook:ops", errs: []error{notifications.Permanent(errors.New("rejected"))}}
+		notifier, _ := newNotifier(t, target)
+
+		_ = notifier.NotifySettlement(context.Background(), event)
+		notifier.DispatchDue()
+
+		failed := notifier.Notifications(notifications.DeliveryFailed)
+		if len(failed) != 1 || failed[0].Attempts != 1 {
+			t.Errorf("Expected one failed notification after one attempt, got %+v", failed)
+		}
+	})
+
+	t.Run("gives_up_after_max_attempts", func(t *testing.T) {
+		down := errors.New("down")
+		target := &scriptedTarget{name: "peer:audit-correlator", errs: []error{down, down, down, down}}
+		notifier, now := newNotifier(t, target)
+
+		_ = notifier.NotifySettlement(context.Background(), event)
+		for i := 0; i < 5; i++ {
+			notifier.DispatchDue()
+			*now = now.Add(time.Minute)
+		}
+
+		failed := notifier.Notifications(notifications.DeliveryFailed)
+		if len(failed) != 1 || failed[0].Attempts != 3 || failed[0].LastError != "down" {
+			t.Errorf("Expected failure after 3 attempts, got %+v", failed)
+		}
+	})
+
+	t.Run("records_one_notification_per_target", func(t *testing.T) {
+		notifier, _ := newNotifier(t, &scriptedTarget{name: "a"}, &scriptedTarget{name: "b"})
+
+		_ = notifier.NotifySettlement(context.Background(), event)
+		notifier.DispatchDue()
+
+		delivered := notifier.Notifications(notifications.DeliveryDelivered)
+		if len(delivered) != 2 || delivered[0].ID == delivered[1].ID {
+			t.Errorf("Expected two distinct delivered notifications, got %+v", delivered)
+		}
+	})
+
+	t.Run("custodian_service_notifies_settlement_outcomes", func(t *testing.T) {
+		// Given: A custodian service wired to the notifier
+		target := &scriptedTarget{name: "peer:exchange-simulator"}
+		notifier, _ := newNotifier(t, target)
+		cfg := &config.Config{ServiceName: "custodian-simulator"}
+		svc := services.NewCustodianService(cfg, quietLogger())
+		svc.SetSettlementNotifier(notifier)
+
+		from, _ := svc.CreateAccount(context.Background(), "trading")
+		to, _ := svc.CreateAccount(context.Background(), "trading")
+		_, _ = svc.Deposit(context.Background(), from.ID, "BTC", 1)
+
+		// When: One settlement succeeds and one fails
+		ok := services.Settlement{ID: "SETTLE_ok", FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 1}
+		short := services.Settlement{ID: "SETTLE_short", FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 5}
+		_, _ = svc.SubmitSettlementInstruction(context.Background(), ok)
+		_, _ = svc.SubmitSettlementInstruction(context.Background(), short)
+
+		// Then: Each is queued when submitted and again with its outcome, status and reason
+		pending := notifier.Notifications(notifications.DeliveryPending)
+		if len(pending) != 4 {
+			t.Fatalf("Expected 4 pending notifications, got %d", len(pending))
+		}
+		byID := map[string]ports.SettlementNotification{}
+		for _, n := range pending {
+			byID[n.Event.SettlementID] = n.Event
+		}
+		if byID["SETTLE_ok"].Status != services.SettlementStatusCompleted {
+			t.Errorf("Expected completed notification, got %+v", byID["SETTLE_ok"])
+		}
+		if byID["SETTLE_short"].Status != services.SettlementStatusFailed || byID["SETTLE_short"].Reason == "" {
+			t.Errorf("Expected failed notification with reason, got %+v", byID["SETTLE_short"])
+		}
+	})
+}
+
+func TestOutbox(t *testing.T) {
+	t.Run("survives_restart_and_torn_writes", func(t *testing.T) {
+		// Given: A durable outbox with one pending and one delivered record
+		path := filepath.Join(t.TempDir(), "outbox", "notifications.jsonl")
+		outbox, err := notifications.OpenOutbox(path)
+		if err != nil {
+			t.Fatalf("OpenOutbox failed: %v", err)
+		}
+		created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		_ = outbox.Save(notifications.Notification{ID: "NOTIF_1", Target: "a", Status: notifications.DeliveryPending, CreatedAt: created})
+		_ = outbox.Save(notifications.Notification{ID: "NOTIF_2", Target: "a", Status: notifications.DeliveryPending, CreatedAt: created})
+		_ = outbox.Save(notifications.Notification{ID: "NOTIF_2", Target: "a", Status: notifications.DeliveryDelivered, Attempts: 1, CreatedAt: created})
+		_ = outbox.Close()
+
+		// And: A crash left half a line at the end of the file
+		file, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+		_, _ = file.WriteString(`{"id":"NOTIF_1","status":"deliv`)
+		_ = file.Close()
+
+		// When: The outbox is reopened
+		reopened, err := notifications.OpenOutbox(path)
+		if err != nil {
+			t.Fatalf("Reopen failed: %v", err)
+		}
+		defer reopened.Close()
+
+		// Then: The latest complete state of each record is restored
+		if due := reopened.Due(created); len(due) != 1 || due[0].ID != "NOTIF_1" {
+			t.Errorf("Expected NOTIF_1 to still be due, got %+v", due)
+		}
+		if got, _ := reopened.Get("NOTIF_2"); got.Status != notifications.DeliveryDelivered {
+			t.Errorf("Expected NOTIF_2 delivered, got %+v", got)
+		}
+	})
+}
+
+func TestWebhookTarget(t *testing.T) {
+	var received struct {
+		NotificationID string `json:"notification_id"`
+		SettlementID   string `json:"settlement_id"`
+	}
+	var header string
+	statusCode := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Notification-ID")
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	targets, err := notifications.ParseWebhooks("ops="+server.URL, nil)
+	if err != nil || len(targets) != 1 || targets[0].Name() != "webhook:ops" {
+		t.Fatalf("ParseWebhooks returned %v, %v", targets, err)
+	}
+	target := targets[0]
+	event := ports.SettlementNotification{SettlementID: "SETTLE_1"}
+
+	t.Run("posts_json_with_notification_id", func(t *testing.T) {
+		if err := target.Deliver(context.Background(), "NOTIF_1", event); err != nil {
+			t.Fatalf("Deliver failed: %v", err)
+		}
+		if received.NotificationID != "NOTIF_1" || received.SettlementID != "SETTLE_1" || header != "NOTIF_1" {
+			t.Errorf("Unexpected webhook request: %+v header=%q", received, header)
+		}
+	})
+
+	t.Run("classifies_response_statuses", func(t *testing.T) {
+		statusCode = http.StatusServiceUnavailable
+		if err := target.Deliver(context.Background(), "NOTIF_2", event); err == nil || notifications.IsPermanent(err) {
+			t.Errorf("Expected retryable error for 503, got %v", err)
+		}
+
+		statusCode = http.StatusBadRequest
+		if err := target.Deliver(context.Background(), "NOTIF_3", event); !notifications.IsPermanent(err) {
+			t.Errorf("Expected permanent error for 400, got %v", err)
+		}
+	})
+
+	t.Run("rejects_malformed_specs", func(t *testing.T) {
+		if _, err := notifications.ParseWebhooks("ops", nil); err == nil {
+			t.Error("Expected error for entry without URL")
+		}
+		if _, err := notifications.ParseWebhooks("ops=ftp://example", nil); err == nil {
+			t.Error("Expected error for non-HTTP URL")
+		}
+	})
+}
+
+// fakeReceiver implements the settlement callback on a peer
+type fakeReceiver struct {
+	custodianv1.UnimplementedSettlementNotificationReceiverServer
+	requests chan *custodianv1.NotifySettlementRequest
+}
+
+func (f *fakeReceiver) NotifySettlement(_ context.Context, req *custodianv1.NotifySettlementRequest) (*custodianv1.NotifySettlementResponse, error) {
+	f.requests <- req
+	return &custodianv1.NotifySettlementResponse{}, nil
+}
+
+// connClients hands out notification clients over a fixed connection
+type connClients struct {
+	conn *grpc.ClientConn
+}
+
+func (c connClients) GetSettlementNotificationClient(context.Context, string) (infrastructure.SettlementNotificationClientInterface, error) {
+	return infrastructure.NewSettlementNotificationClient(c.conn, quietLogger()), nil
+}
+
+func TestPeerTarget(t *testing.T) {
+	dial := func(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("Failed to listen: %v", err)
+		}
+		server := grpc.NewServer()
+		register(server)
+		go func() { _ = server.Serve(lis) }()
+		t.Cleanup(server.Stop)
+
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			t.Fatalf("Failed to dial: %v", err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		return conn
+	}
+
+	t.Run("calls_peer_receiver", func(t *testing.T) {
+		receiver := &fakeReceiver{requests: make(chan *custodianv1.NotifySettlementRequest, 1)}
+		conn := dial(t, func(s *grpc.Server) { custodianv1.RegisterSettlementNotificationReceiverServer(s, receiver) })
+		target := notifications.NewPeerTarget("exchange-simulator", connClients{conn})
+
+		err := target.Deliver(context.Background(), "NOTIF_1", ports.SettlementNotification{SettlementID: "SETTLE_1", Status: "completed", Amount: 2})
+		if err != nil {
+			t.Fatalf("Deliver failed: %v", err)
+		}
+
+		req := <-receiver.requests
+		if req.GetNotificationId() != "NOTIF_1" || req.GetSettlementId() != "SETTLE_1" || req.GetAmount() != 2 {
+			t.Errorf("Unexpected request: %v", req)
+		}
+	})
+
+	t.Run("peer_without_receiver_is_permanent_failure", func(t *testing.T) {
+		conn := dial(t, func(*grpc.Server) {})
+		target := notifications.NewPeerTarget("risk-monitor", connClients{conn})
+
+		err := target.Deliver(context.Background(), "NOTIF_2", ports.SettlementNotification{})
+		if !notifications.IsPermanent(err) {
+			t.Errorf("Expected permanent error for Unimplemented, got %v", err)
+		}
+	})
+}
+
+func quietLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
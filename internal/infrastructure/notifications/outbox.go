@@ -0,0 +1,229 @@
+package notifications
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// DeliveryStatus is the delivery state of one notification to one target
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// Notification is an outbox record: one settlement event addressed to one target
+type Notification struct {
+	ID            string                       `json:"id"`
+	Target        string                       `json:"target"`
+	Event         ports.SettlementNotification `json:"event"`
+	Status        DeliveryStatus               `json:"status"`
+	Attempts      int                          `json:"attempts"`
+	LastError     string                       `json:"last_error,omitempty"`
+	NextAttemptAt time.Time                    `json:"next_attempt_at"`
+	CreatedAt     time.Time                    `json:"created_at"`
+	UpdatedAt     time.Time                    `json:"updated_at"`
+	DeliveredAt   *time.Time                   `json:"delivered_at,omitempty"`
+}
+
+// Outbox stores notification records. With a path it is durable: every change is
+// appended to a JSON-lines file and fsynced before Save returns, and the file is
+// replayed (last record per ID wins) and compacted when the outbox is opened.
+// Without a path records live in memory only.
+type Outbox struct {
+	mu      sync.RWMutex
+	path    string
+	file    *os.File
+	records map[string]*Notification
+}
+
+// OpenOutbox loads the outbox at path, creating it if needed; an empty path gives an in-memory outbox
+func OpenOutbox(path string) (*Outbox, error) {
+	o := &Outbox{
+		path:    path,
+		records: make(map[string]*Notification),
+	}
+	if path == "" {
+		return o, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create outbox directory: %w", err)
+	}
+	if err := o.replay(); err != nil {
+		return nil, err
+	}
+	if err := o.compact(); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open outbox: %w", err)
+	}
+	o.file = file
+
+	return o, nil
+}
+
+// Save records the current state of a notification
+func (o *Outbox) Save(n Notification) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.file != nil {
+		line, err := json.Marshal(n)
+		if err != nil {
+			return fmt.Errorf("failed to marshal notification %s: %w", n.ID, err)
+		}
+		if _, err := o.file.Write(append(line, '\n')); err != nil {
+			return fmt.Errorf("failed to append notification %s: %w", n.ID, err)
+		}
+		if err := o.file.Sync(); err != nil {
+			return fmt.Errorf("failed to sync outbox: %w", err)
+		}
+	}
+
+	o.records[n.ID] = &n
+	return nil
+}
+
+// Get returns a notification by ID
+func (o *Outbox) Get(id string) (Notification, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	n, exists := o.records[id]
+	if !exists {
+		return Notification{}, false
+	}
+	return *n, true
+}
+
+// List returns notifications oldest first, filtered by status unless status is empty
+func (o *Outbox) List(status DeliveryStatus) []Notification {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	list := make([]Notification, 0, len(o.records))
+	for _, n := range o.records {
+		if status == "" || n.Status == status {
+			list = append(list, *n)
+		}
+	}
+	sortNotifications(list)
+	return list
+}
+
+// Due returns pending notifications whose next attempt is at or before now, oldest first
+func (o *Outbox) Due(now time.Time) []Notification {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	var due []Notification
+	for _, n := range o.records {
+		if n.Status == DeliveryPending && !n.NextAttemptAt.After(now) {
+			due = append(due, *n)
+		}
+	}
+	sortNotifications(due)
+	return due
+}
+
+// Close closes the outbox file
+func (o *Outbox) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.file == nil {
+		return nil
+	}
+	err := o.file.Close()
+	o.file = nil
+	return err
+}
+
+func (o *Outbox) replay() error {
+	file, err := os.Open(o.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read outbox: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var n Notification
+		// A torn final line from a crash mid-write is skipped; the previous state of that record stands
+		if err := json.Unmarshal(scanner.Bytes(), &n); err != nil || n.ID == "" {
+			continue
+		}
+		o.records[n.ID] = &n
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read outbox: %w", err)
+	}
+	return nil
+}
+
+// compact rewrites the file with one line per record
+func (o *Outbox) compact() error {
+	tmpPath := o.path + ".tmp"
+	tmp, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to compact outbox: %w", err)
+	}
+
+	writer := bufio.NewWriter(tmp)
+	list := make([]Notification, 0, len(o.records))
+	for _, n := range o.records {
+		list = append(list, *n)
+	}
+	sortNotifications(list)
+	for _, n := range list {
+		line, err := json.Marshal(n)
+		if err != nil {
+			tmp.Close()
+			return fmt.Errorf("failed to compact outbox: %w", err)
+		}
+		writer.Write(append(line, '\n'))
+	}
+
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to compact outbox: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to compact outbox: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to compact outbox: %w", err)
+	}
+	if err := os.Rename(tmpPath, o.path); err != nil {
+		return fmt.Errorf("failed to compact outbox: %w", err)
+	}
+	return nil
+}
+
+func sortNotifications(list []Notification) {
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+}
@@ -0,0 +1,177 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+)
+
+// Target is a recipient of settlement notifications
+type Target interface {
+	// Name identifies the target in outbox records; it must be stable across restarts
+	Name() string
+	// Deliver sends one notification; errors wrapped with Permanent are not retried
+	Deliver(ctx context.Context, notificationID string, event ports.SettlementNotification) error
+}
+
+// PermanentError marks a delivery failure that retrying cannot fix
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent wraps err so the notification is marked failed without further attempts
+func Permanent(err error) error {
+	return &PermanentError{Err: err}
+}
+
+// IsPermanent reports whether err was wrapped with Permanent
+func IsPermanent(err error) bool {
+	var permanent *PermanentError
+	return errors.As(err, &permanent)
+}
+
+// webhookPayload is the JSON body posted to webhook targets
+type webhookPayload struct {
+	NotificationID string `json:"notification_id"`
+	ports.SettlementNotification
+}
+
+// WebhookTarget posts notifications as JSON to an HTTP endpoint
+type WebhookTarget struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+func NewWebhookTarget(name, url string, client *http.Client) *WebhookTarget {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &WebhookTarget{
+		name:   name,
+		url:    url,
+		client: client,
+	}
+}
+
+func (w *WebhookTarget) Name() string {
+	return w.name
+}
+
+// Deliver posts the notification; 2xx is success, 408, 429 and 5xx are retried and
+// other statuses are permanent failures. The X-Notification-ID header lets the
+// receiver deduplicate redeliveries.
+func (w *WebhookTarget) Deliver(ctx context.Context, notificationID string, event ports.SettlementNotification) error {
+	body, err := json.Marshal(webhookPayload{NotificationID: notificationID, SettlementNotification: event})
+	if err != nil {
+		return Permanent(fmt.Errorf("failed to marshal webhook payload: %w", err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return Permanent(fmt.Errorf("failed to build webhook request: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Notification-ID", notificationID)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook %s request failed: %w", w.name, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusRequestTimeout, resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= 500:
+		return fmt.Errorf("webhook %s returned %d", w.name, resp.StatusCode)
+	default:
+		return Permanent(fmt.Errorf("webhook %s rejected notification with %d", w.name, resp.StatusCode))
+	}
+}
+
+// ParseWebhooks parses "name=url;name=url" into webhook targets
+func ParseWebhooks(spec string, client *http.Client) ([]Target, error) {
+	var targets []Target
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, url, ok := strings.Cut(entry, "=")
+		name, url = strings.TrimSpace(name), strings.TrimSpace(url)
+		if !ok || name == "" || url == "" {
+			return nil, fmt.Errorf("invalid webhook entry %q, expected name=url", entry)
+		}
+		if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+			return nil, fmt.Errorf("webhook %s has unsupported URL %q", name, url)
+		}
+
+		targets = append(targets, NewWebhookTarget("webhook:"+name, url, client))
+	}
+	return targets, nil
+}
+
+// PeerNotificationClients resolves gRPC clients for peer services
+// Implemented by infrastructure.DefaultInterServiceClientManager
+type PeerNotificationClients interface {
+	GetSettlementNotificationClient(ctx context.Context, serviceName string) (infrastructure.SettlementNotificationClientInterface, error)
+}
+
+// PeerTarget calls SettlementNotificationReceiver.NotifySettlement on a peer service
+type PeerTarget struct {
+	serviceName string
+	clients     PeerNotificationClients
+}
+
+func NewPeerTarget(serviceName string, clients PeerNotificationClients) *PeerTarget {
+	return &PeerTarget{
+		serviceName: serviceName,
+		clients:     clients,
+	}
+}
+
+func (p *PeerTarget) Name() string {
+	return "peer:" + p.serviceName
+}
+
+// Deliver calls the peer; caller-side errors and a missing receiver implementation are
+// permanent, everything else (including an unavailable peer) is retried
+func (p *PeerTarget) Deliver(ctx context.Context, notificationID string, event ports.SettlementNotification) error {
+	client, err := p.clients.GetSettlementNotificationClient(ctx, p.serviceName)
+	if err != nil {
+		return err
+	}
+
+	err = client.NotifySettlement(ctx, notificationID, event)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.InvalidArgument, codes.Unimplemented, codes.PermissionDenied, codes.Unauthenticated:
+		return Permanent(err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,39 @@
+package observability
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// CorrelationIDKey is the metadata/header key used to propagate correlation IDs
+// between services (gRPC metadata keys are lowercase by convention)
+const CorrelationIDKey = "x-correlation-id"
+
+type correlationIDContextKey struct{}
+
+// WithCorrelationID returns a copy of ctx carrying the given correlation ID
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDContextKey{}, correlationID)
+}
+
+// CorrelationIDFromContext returns the correlation ID stored in ctx, or "" if none
+func CorrelationIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	correlationID, _ := ctx.Value(correlationIDContextKey{}).(string)
+	return correlationID
+}
+
+// NewCorrelationID generates a random correlation ID
+// Falls back to a timestamp-based ID if the system random source is unavailable
+func NewCorrelationID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Sprintf("corr-%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf)
+}
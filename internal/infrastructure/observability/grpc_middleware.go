@@ -0,0 +1,78 @@
+package observability
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// REDMetricsUnaryInterceptor creates a gRPC unary interceptor for RED pattern metrics
+// It is the gRPC counterpart of REDMetricsMiddleware and records:
+// - grpc_requests_total: Total number of RPCs (counter)
+// - grpc_request_duration_seconds: RPC duration (histogram)
+// - grpc_request_errors_total: Total number of RPCs with a non-OK status (counter)
+//
+// Labels: method (full gRPC method name), code (gRPC status code name)
+func REDMetricsUnaryInterceptor(metricsPort ports.MetricsPort) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		recordGRPCMetrics(metricsPort, info.FullMethod, err, time.Since(start))
+		return resp, err
+	}
+}
+
+// REDMetricsStreamInterceptor creates a gRPC stream interceptor for RED pattern metrics
+// Duration covers the whole lifetime of the stream
+func REDMetricsStreamInterceptor(metricsPort ports.MetricsPort) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		recordGRPCMetrics(metricsPort, info.FullMethod, err, time.Since(start))
+		return err
+	}
+}
+
+func recordGRPCMetrics(metricsPort ports.MetricsPort, fullMethod string, err error, duration time.Duration) {
+	if metricsPort == nil {
+		return
+	}
+
+	code := status.Code(err)
+
+	// Extract labels (low cardinality: method names are fixed by the service definitions)
+	labels := map[string]string{
+		"method": fullMethod,
+		"code":   code.String(),
+	}
+
+	// RED Metric 1: Rate - Total requests
+	metricsPort.IncCounter("grpc_requests_total", labels)
+
+	// RED Metric 2: Duration - Request duration histogram
+	metricsPort.ObserveHistogram("grpc_request_duration_seconds", duration.Seconds(), labels)
+
+	// RED Metric 3: Errors - Error counter (any non-OK status)
+	if code != codes.OK {
+		metricsPort.IncCounter("grpc_request_errors_total", labels)
+	}
+}
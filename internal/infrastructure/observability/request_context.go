@@ -0,0 +1,76 @@
+package observability
+
+import (
+	"context"
+	"strings"
+)
+
+// TraceParentKey is the W3C Trace Context header/metadata key
+const TraceParentKey = "traceparent"
+
+// ClientIDKey is the header/metadata key a caller may use to name itself when it
+// has no client certificate; the name is recorded as an unverified actor
+const ClientIDKey = "x-client-id"
+
+// Actor identifies who made a request
+type Actor struct {
+	ID       string // Certificate common name, self-reported client ID, or "anonymous"
+	Verified bool   // True only when ID comes from a verified client certificate
+}
+
+type traceIDContextKey struct{}
+
+type actorContextKey struct{}
+
+// WithTraceID returns a copy of ctx carrying the given trace ID
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDContextKey{}, traceID)
+}
+
+// TraceIDFromContext returns the trace ID stored in ctx, or "" if none
+func TraceIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	traceID, _ := ctx.Value(traceIDContextKey{}).(string)
+	return traceID
+}
+
+// TraceIDFromTraceParent extracts the trace ID from a W3C traceparent value
+// ("00-<32 hex trace id>-<16 hex span id>-<2 hex flags>")
+func TraceIDFromTraceParent(traceparent string) (string, bool) {
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return "", false
+	}
+
+	traceID := strings.ToLower(parts[1])
+	if !isHex(traceID) || traceID == strings.Repeat("0", 32) {
+		return "", false
+	}
+	return traceID, true
+}
+
+// WithActor returns a copy of ctx carrying the caller identity
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the caller identity stored in ctx, or an anonymous actor
+func ActorFromContext(ctx context.Context) Actor {
+	if ctx != nil {
+		if actor, ok := ctx.Value(actorContextKey{}).(Actor); ok {
+			return actor
+		}
+	}
+	return Actor{ID: "anonymous"}
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
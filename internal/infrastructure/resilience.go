@@ -0,0 +1,136 @@
+package infrastructure
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	auditv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/audit/v1"
+	exchangev1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/exchange/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// idempotentMethods lists peer RPCs that are safe to retry
+// Anything not listed is attempted once so side effects are never duplicated
+var idempotentMethods = map[string]bool{
+	grpc_health_v1.Health_Check_FullMethodName:                          true,
+	exchangev1.ExchangeSimulatorService_GetTradingStatus_FullMethodName: true,
+	auditv1.AuditCorrelatorService_GetAuditMetrics_FullMethodName:       true,
+	auditv1.AuditCorrelatorService_RecordAuditEvent_FullMethodName:      true, // deduplicated on event_id
+}
+
+// RetryPolicy configures jittered exponential backoff between attempts
+type RetryPolicy struct {
+	MaxAttempts    int           // Total attempts including the first
+	InitialBackoff time.Duration // Upper bound of the first backoff
+	MaxBackoff     time.Duration // Cap on any single backoff
+	Multiplier     float64       // Growth factor of the backoff bound per attempt
+}
+
+// Backoff returns the delay before retry number attempt (1-based), using full
+// jitter: a uniform random duration between zero and the exponential bound
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	bound := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && bound > float64(p.MaxBackoff) {
+		bound = float64(p.MaxBackoff)
+	}
+	if bound <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(bound) + 1))
+}
+
+// isRetryableCode reports transient failures worth retrying on an idempotent call
+func isRetryableCode(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// isBreakerFailure reports failures that indicate the peer is unhealthy
+// Caller errors such as NotFound or InvalidArgument must not trip the breaker
+func isBreakerFailure(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// ResilienceUnaryInterceptor guards every call to serviceName with breaker and retries
+// idempotent methods according to policy. Rejected and exhausted calls are returned as
+// *ServiceUnavailableError wrapping the last error, so status.Code still works on them.
+// metricsPort may be nil.
+func ResilienceUnaryInterceptor(serviceName string, breaker *CircuitBreaker, policy RetryPolicy, metricsPort ports.MetricsPort) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		attempts := 1
+		if idempotentMethods[method] && policy.MaxAttempts > 1 {
+			attempts = policy.MaxAttempts
+		}
+
+		var err error
+		for attempt := 1; attempt <= attempts; attempt++ {
+			if attempt > 1 {
+				if metricsPort != nil {
+					metricsPort.IncCounter("grpc_client_retries_total", map[string]string{
+						"service": serviceName,
+						"method":  method,
+					})
+				}
+
+				timer := time.NewTimer(policy.Backoff(attempt - 1))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return &ServiceUnavailableError{ServiceName: serviceName, Cause: err}
+				case <-timer.C:
+				}
+			}
+
+			if allowErr := breaker.Allow(); allowErr != nil {
+				return &ServiceUnavailableError{ServiceName: serviceName, Cause: allowErr}
+			}
+
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			code := status.Code(err)
+
+			if isBreakerFailure(code) {
+				breaker.RecordFailure()
+			} else {
+				breaker.RecordSuccess()
+			}
+
+			if err == nil || !isRetryableCode(code) {
+				break
+			}
+		}
+
+		if err != nil && isBreakerFailure(status.Code(err)) {
+			return &ServiceUnavailableError{ServiceName: serviceName, Cause: err}
+		}
+		return err
+	}
+}
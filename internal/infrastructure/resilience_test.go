@@ -0,0 +1,185 @@
+//go:build unit
+
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	exchangev1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/exchange/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure"
+)
+
+// TestCircuitBreaker verifies the closed/open/half-open state machine
+// Following BDD Given/When/Then pattern
+func TestCircuitBreaker(t *testing.T) {
+	newBreaker := func() (*infrastructure.CircuitBreaker, *time.Time) {
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		breaker := infrastructure.NewCircuitBreaker(infrastructure.CircuitBreakerConfig{
+			FailureThreshold: 3,
+			OpenTimeout:      10 * time.Second,
+			HalfOpenMaxCalls: 1,
+		})
+		breaker.SetClock(func() time.Time { return now })
+		return breaker, &now
+	}
+
+	t.Run("opens_after_consecutive_failures", func(t *testing.T) {
+		breaker, _ := newBreaker()
+
+		// When: Failures reach the threshold, with a success resetting the count
+		breaker.RecordFailure()
+		breaker.RecordSuccess()
+		for i := 0; i < 3; i++ {
+			breaker.RecordFailure()
+		}
+
+		// Then: The breaker is open and rejects calls
+		if breaker.State() != infrastructure.CircuitOpen {
+			t.Errorf("Expected open, got %s", breaker.State())
+		}
+		if err := breaker.Allow(); !errors.Is(err, infrastructure.ErrCircuitOpen) {
+			t.Errorf("Expected ErrCircuitOpen, got %v", err)
+		}
+	})
+
+	t.Run("half_open_probe_closes_on_success", func(t *testing.T) {
+		breaker, now := newBreaker()
+		for i := 0; i < 3; i++ {
+			breaker.RecordFailure()
+		}
+
+		// When: The open timeout elapses
+		*now = now.Add(10 * time.Second)
+
+		// Then: A single probe is allowed and a second concurrent one is not
+		if err := breaker.Allow(); err != nil {
+			t.Fatalf("Expected probe to be allowed, got %v", err)
+		}
+		if err := breaker.Allow(); !errors.Is(err, infrastructure.ErrCircuitOpen) {
+			t.Errorf("Expected second probe to be rejected, got %v", err)
+		}
+
+		// And: A successful probe closes the circuit
+		breaker.RecordSuccess()
+		if breaker.State() != infrastructure.CircuitClosed {
+			t.Errorf("Expected closed, got %s", breaker.State())
+		}
+	})
+
+	t.Run("half_open_probe_reopens_on_failure", func(t *testing.T) {
+		breaker, now := newBreaker()
+		for i := 0; i < 3; i++ {
+			breaker.RecordFailure()
+		}
+		*now = now.Add(10 * time.Second)
+		_ = breaker.Allow()
+
+		// When: The probe fails
+		breaker.RecordFailure()
+
+		// Then: The circuit opens again for a full timeout
+		*now = now.Add(5 * time.Second)
+		if breaker.State() != infrastructure.CircuitOpen {
+			t.Errorf("Expected open, got %s", breaker.State())
+		}
+	})
+}
+
+// flakyExchange fails its first `failures` calls with code, then answers
+type flakyExchange struct {
+	exchangev1.UnimplementedExchangeSimulatorServiceServer
+	calls    int32
+	failures int32
+	code     codes.Code
+}
+
+func (f *flakyExchange) GetTradingStatus(context.Context, *exchangev1.GetTradingStatusRequest) (*exchangev1.GetTradingStatusResponse, error) {
+	if atomic.AddInt32(&f.calls, 1) <= f.failures {
+		return nil, status.Error(f.code, "transient failure")
+	}
+	return &exchangev1.GetTradingStatusResponse{ActiveTrades: 1}, nil
+}
+
+func TestResilienceUnaryInterceptor(t *testing.T) {
+	policy := infrastructure.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+	}
+
+	dial := func(t *testing.T, peer *flakyExchange, breaker *infrastructure.CircuitBreaker) *infrastructure.ExchangeSimulatorClient {
+		conn := startFakePeer(t, func(s *grpc.Server) {
+			exchangev1.RegisterExchangeSimulatorServiceServer(s, peer)
+		}, grpc.WithUnaryInterceptor(infrastructure.ResilienceUnaryInterceptor("exchange-simulator", breaker, policy, nil)))
+		return infrastructure.NewExchangeSimulatorClient(conn, quietLogger())
+	}
+
+	t.Run("retries_idempotent_calls_until_success", func(t *testing.T) {
+		// Given: A peer that fails twice before answering
+		peer := &flakyExchange{failures: 2, code: codes.Unavailable}
+		client := dial(t, peer, infrastructure.NewCircuitBreaker(infrastructure.CircuitBreakerConfig{FailureThreshold: 5}))
+
+		// When: The idempotent status call is made
+		_, err := client.GetTradingStatus(context.Background())
+
+		// Then: It succeeds on the third attempt
+		if err != nil {
+			t.Fatalf("Expected success after retries, got %v", err)
+		}
+		if got := atomic.LoadInt32(&peer.calls); got != 3 {
+			t.Errorf("Expected 3 attempts, got %d", got)
+		}
+	})
+
+	t.Run("does_not_retry_caller_errors", func(t *testing.T) {
+		// Given: A peer rejecting the request as invalid
+		peer := &flakyExchange{failures: 10, code: codes.InvalidArgument}
+		breaker := infrastructure.NewCircuitBreaker(infrastructure.CircuitBreakerConfig{FailureThreshold: 1})
+		client := dial(t, peer, breaker)
+
+		// When: The call is made
+		_, err := client.GetTradingStatus(context.Background())
+
+		// Then: It is attempted once, returned as-is and does not trip the breaker
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+		if got := atomic.LoadInt32(&peer.calls); got != 1 {
+			t.Errorf("Expected 1 attempt, got %d", got)
+		}
+		if breaker.State() != infrastructure.CircuitClosed {
+			t.Errorf("Expected breaker to stay closed, got %s", breaker.State())
+		}
+	})
+
+	t.Run("open_breaker_fails_fast_with_service_unavailable", func(t *testing.T) {
+		// Given: A peer that is down and a breaker that opens after three failures
+		peer := &flakyExchange{failures: 100, code: codes.Unavailable}
+		breaker := infrastructure.NewCircuitBreaker(infrastructure.CircuitBreakerConfig{FailureThreshold: 3, OpenTimeout: time.Minute})
+		client := dial(t, peer, breaker)
+
+		// When: One call exhausts its retries and another follows
+		_, err := client.GetTradingStatus(context.Background())
+		if !infrastructure.IsServiceUnavailableError(err) || status.Code(err) != codes.Unavailable {
+			t.Errorf("Expected ServiceUnavailableError wrapping Unavailable, got %T %v", err, err)
+		}
+		_, err = client.GetTradingStatus(context.Background())
+
+		// Then: The second call is rejected without reaching the peer
+		if !errors.Is(err, infrastructure.ErrCircuitOpen) {
+			t.Errorf("Expected ErrCircuitOpen, got %v", err)
+		}
+		if got := atomic.LoadInt32(&peer.calls); got != 3 {
+			t.Errorf("Expected peer to see 3 calls, got %d", got)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package security
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Permission is a coarse capability granted to a client identity
+type Permission string
+
+const (
+	// PermissionRead allows balance, account and event queries
+	PermissionRead Permission = "read"
+	// PermissionWrite allows state changes such as deposits and settlements
+	PermissionWrite Permission = "write"
+	// PermissionPublic marks endpoints (health, metrics) that need no grant
+	PermissionPublic Permission = "public"
+)
+
+var (
+	// ErrUnauthenticated is returned when a call carries no verified client identity
+	ErrUnauthenticated = errors.New("client identity not verified")
+	// ErrPermissionDenied is returned when the identity lacks the required permission
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// Policy maps client identities (certificate common names) to their permissions
+type Policy struct {
+	grants map[string]map[Permission]bool
+}
+
+// ParsePolicy parses a policy of the form "client=perm|perm;client=perm"
+// e.g. "exchange-simulator=read|write;risk-monitor=read"
+func ParsePolicy(spec string) (*Policy, error) {
+	policy := &Policy{grants: make(map[string]map[Permission]bool)}
+
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		identity, perms, found := strings.Cut(entry, "=")
+		identity = strings.TrimSpace(identity)
+		if !found || identity == "" {
+			return nil, fmt.Errorf("invalid policy entry %q: expected client=permissions", entry)
+		}
+
+		granted := make(map[Permission]bool)
+		for _, perm := range strings.Split(perms, "|") {
+			switch p := Permission(strings.TrimSpace(perm)); p {
+			case PermissionRead, PermissionWrite:
+				granted[p] = true
+			default:
+				return nil, fmt.Errorf("invalid permission %q for client %s", perm, identity)
+			}
+		}
+		policy.grants[identity] = granted
+	}
+
+	return policy, nil
+}
+
+// Authorize checks that identity holds perm; public permissions are always granted
+func (p *Policy) Authorize(identity string, perm Permission) error {
+	if perm == PermissionPublic {
+		return nil
+	}
+	if identity == "" {
+		return ErrUnauthenticated
+	}
+	if !p.grants[identity][perm] {
+		return fmt.Errorf("%w: %s requires %s", ErrPermissionDenied, identity, perm)
+	}
+	return nil
+}
+
+// Clients returns the identities named in the policy, sorted
+func (p *Policy) Clients() []string {
+	clients := make([]string, 0, len(p.grants))
+	for identity := range p.grants {
+		clients = append(clients, identity)
+	}
+	sort.Strings(clients)
+	return clients
+}
@@ -0,0 +1,57 @@
+//go:build unit
+
+package security_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/security"
+)
+
+// TestPolicy verifies parsing and evaluation of per-client authorization policies
+// Following BDD Given/When/Then pattern
+func TestPolicy(t *testing.T) {
+	t.Run("grants_configured_permissions_only", func(t *testing.T) {
+		// Given: A policy where the exchange may write and risk may only read
+		policy, err := security.ParsePolicy("exchange-simulator=read|write; risk-monitor=read")
+		if err != nil {
+			t.Fatalf("ParsePolicy failed: %v", err)
+		}
+
+		// Then: Each client holds exactly its grants
+		if err := policy.Authorize("exchange-simulator", security.PermissionWrite); err != nil {
+			t.Errorf("Expected exchange-simulator write to be allowed, got %v", err)
+		}
+		if err := policy.Authorize("risk-monitor", security.PermissionRead); err != nil {
+			t.Errorf("Expected risk-monitor read to be allowed, got %v", err)
+		}
+		if err := policy.Authorize("risk-monitor", security.PermissionWrite); !errors.Is(err, security.ErrPermissionDenied) {
+			t.Errorf("Expected risk-monitor write to be denied, got %v", err)
+		}
+		if err := policy.Authorize("unknown-service", security.PermissionRead); !errors.Is(err, security.ErrPermissionDenied) {
+			t.Errorf("Expected unknown client to be denied, got %v", err)
+		}
+	})
+
+	t.Run("requires_an_identity_except_for_public_endpoints", func(t *testing.T) {
+		// Given: Any policy
+		policy, _ := security.ParsePolicy("risk-monitor=read")
+
+		// Then: Anonymous callers are unauthenticated but may reach public endpoints
+		if err := policy.Authorize("", security.PermissionRead); !errors.Is(err, security.ErrUnauthenticated) {
+			t.Errorf("Expected ErrUnauthenticated, got %v", err)
+		}
+		if err := policy.Authorize("", security.PermissionPublic); err != nil {
+			t.Errorf("Expected public permission to be granted, got %v", err)
+		}
+	})
+
+	t.Run("rejects_malformed_entries", func(t *testing.T) {
+		for _, spec := range []string{"risk-monitor", "=read", "risk-monitor=admin"} {
+			if _, err := security.ParsePolicy(spec); err == nil {
+				t.Errorf("Expected error parsing %q", spec)
+			}
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package security
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// AuthorizationMiddleware creates Gin middleware requiring perm on the routes it guards
+// The caller is identified by its verified client certificate. Routes served by the
+// HTTP gateway are authorized per gRPC method there instead.
+func AuthorizationMiddleware(policy *Policy, logger *logrus.Logger, perm Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		identity, _ := IdentityFromTLS(c.Request.TLS)
+		if err := policy.Authorize(identity, perm); err != nil {
+			logger.WithFields(logrus.Fields{
+				"client":     identity,
+				"method":     c.Request.Method,
+				"path":       c.Request.URL.Path,
+				"permission": perm,
+			}).WithError(err).Warn("HTTP request denied")
+
+			code := http.StatusForbidden
+			if errors.Is(err, ErrUnauthenticated) {
+				code = http.StatusUnauthorized
+			}
+			c.AbortWithStatusJSON(code, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Set("client_identity", identity)
+		c.Next()
+	}
+}
@@ -0,0 +1,107 @@
+package security
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+)
+
+// NewServerTLSConfig builds a TLS config that presents the service certificate and
+// requires every client to present a certificate signed by the configured CA
+func NewServerTLSConfig(cfg *config.Config) (*tls.Config, error) {
+	cert, pool, err := loadKeyPairAndCA(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewClientTLSConfig builds a TLS config that presents the service certificate to peers
+// and only trusts servers whose certificate is signed by the configured CA
+func NewClientTLSConfig(cfg *config.Config) (*tls.Config, error) {
+	cert, pool, err := loadKeyPairAndCA(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ServerCredentials wraps NewServerTLSConfig as gRPC transport credentials
+func ServerCredentials(cfg *config.Config) (credentials.TransportCredentials, error) {
+	tlsConfig, err := NewServerTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// ClientCredentials wraps NewClientTLSConfig as gRPC transport credentials
+func ClientCredentials(cfg *config.Config) (credentials.TransportCredentials, error) {
+	tlsConfig, err := NewClientTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// IdentityFromTLS returns the common name of the verified client certificate
+func IdentityFromTLS(state *tls.ConnectionState) (string, bool) {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	name := state.VerifiedChains[0][0].Subject.CommonName
+	return name, name != ""
+}
+
+// IdentityFromPeer returns the verified client identity of a gRPC call
+func IdentityFromPeer(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return "", false
+	}
+
+	return IdentityFromTLS(&tlsInfo.State)
+}
+
+func loadKeyPairAndCA(cfg *config.Config) (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load certificate %s: %w", cfg.TLSCertFile, err)
+	}
+
+	caPEM, err := os.ReadFile(cfg.TLSCAFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to read CA file %s: %w", cfg.TLSCAFile, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return tls.Certificate{}, nil, fmt.Errorf("no certificates found in CA file %s", cfg.TLSCAFile)
+	}
+
+	return cert, pool, nil
+}
@@ -0,0 +1,127 @@
+//go:build unit
+
+package security_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/security"
+)
+
+// TestMutualTLS verifies the generated test PKI, TLS configs and the Gin authorization middleware together
+func TestMutualTLS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Given: A test PKI with certificates for the custodian and two clients
+	dir := t.TempDir()
+	if err := security.WriteTestPKI(dir, []string{"custodian-simulator", "exchange-simulator", "risk-monitor"}); err != nil {
+		t.Fatalf("WriteTestPKI failed: %v", err)
+	}
+
+	serverTLS, err := security.NewServerTLSConfig(tlsConfigFor(dir, "custodian-simulator"))
+	if err != nil {
+		t.Fatalf("NewServerTLSConfig failed: %v", err)
+	}
+
+	policy, _ := security.ParsePolicy("exchange-simulator=read|write;risk-monitor=read")
+	logger := logrus.New()
+	logger.SetLevel(logrus.PanicLevel)
+
+	// And: An HTTPS server whose routes are guarded by the authorization middleware
+	router := gin.New()
+	router.GET("/health", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/balances", security.AuthorizationMiddleware(policy, logger, security.PermissionRead), func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/settlements", security.AuthorizationMiddleware(policy, logger, security.PermissionWrite), func(c *gin.Context) { c.Status(http.StatusCreated) })
+
+	server := httptest.NewUnstartedServer(router)
+	server.TLS = serverTLS
+	server.StartTLS()
+	defer server.Close()
+
+	t.Run("writer_may_submit_settlements", func(t *testing.T) {
+		client := httpClientFor(t, dir, "exchange-simulator")
+
+		resp, err := client.Post(server.URL+"/settlements", "application/json", nil)
+		if err != nil {
+			t.Fatalf("POST failed: %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusCreated {
+			t.Errorf("Expected 201, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("reader_may_read_but_not_write", func(t *testing.T) {
+		client := httpClientFor(t, dir, "risk-monitor")
+
+		resp, err := client.Get(server.URL + "/balances")
+		if err != nil {
+			t.Fatalf("GET failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Expected 200 for read, got %d", resp.StatusCode)
+		}
+
+		resp, err = client.Post(server.URL+"/settlements", "application/json", nil)
+		if err != nil {
+			t.Fatalf("POST failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("Expected 403 for write, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("rejects_clients_without_a_trusted_certificate", func(t *testing.T) {
+		// Given: A client whose certificate comes from a different CA
+		otherDir := t.TempDir()
+		if err := security.WriteTestPKI(otherDir, []string{"exchange-simulator"}); err != nil {
+			t.Fatalf("WriteTestPKI failed: %v", err)
+		}
+		clientTLS, err := security.NewClientTLSConfig(tlsConfigFor(otherDir, "exchange-simulator"))
+		if err != nil {
+			t.Fatalf("NewClientTLSConfig failed: %v", err)
+		}
+		// Trust the real server so only the client certificate is at fault
+		clientTLS.RootCAs = serverTLS.ClientCAs
+
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLS}}
+
+		// When: It connects
+		_, err = client.Get(server.URL + "/health")
+
+		// Then: The handshake fails
+		if err == nil {
+			t.Error("Expected TLS handshake failure for untrusted client certificate")
+		}
+	})
+}
+
+func tlsConfigFor(dir, name string) *config.Config {
+	return &config.Config{
+		TLSEnabled:  true,
+		TLSCertFile: filepath.Join(dir, name+".crt"),
+		TLSKeyFile:  filepath.Join(dir, name+".key"),
+		TLSCAFile:   filepath.Join(dir, "ca.crt"),
+	}
+}
+
+func httpClientFor(t *testing.T, dir, name string) *http.Client {
+	t.Helper()
+
+	clientTLS, err := security.NewClientTLSConfig(tlsConfigFor(dir, name))
+	if err != nil {
+		t.Fatalf("NewClientTLSConfig failed: %v", err)
+	}
+
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLS}}
+}
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	custodianv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/custodian/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/security"
+)
+
+// methodPermissions lists the permission each custodian RPC requires
+// Methods not listed here require write so new RPCs are closed by default
+var methodPermissions = map[string]security.Permission{
+	custodianv1.CustodianService_GetBalance_FullMethodName:                         security.PermissionRead,
+	custodianv1.CustodianService_GetTransferHeadroom_FullMethodName:                security.PermissionRead,
+	custodianv1.CustodianService_GetChainStatus_FullMethodName:                     security.PermissionRead,
+	custodianv1.CustodianService_GetChainTransaction_FullMethodName:                security.PermissionRead,
+	custodianv1.CustodianService_GetFiatPayment_FullMethodName:                     security.PermissionRead,
+	custodianv1.CustodianService_GetWalletTiers_FullMethodName:                     security.PermissionRead,
+	custodianv1.CustodianService_SubscribeAccountEvents_FullMethodName:             security.PermissionRead,
+	custodianv1.CustodianService_GetStandingSettlementInstruction_FullMethodName:   security.PermissionRead,
+	custodianv1.CustodianService_ListStandingSettlementInstructions_FullMethodName: security.PermissionRead,
+	custodianv1.CustodianService_GetFailsReport_FullMethodName:                     security.PermissionRead,
+	custodianv1.CustodianService_GetSettlement_FullMethodName:                      security.PermissionRead,
+	custodianv1.CustodianService_GetMatchingInstruction_FullMethodName:             security.PermissionRead,
+	custodianv1.CustodianService_GetMismatchReport_FullMethodName:                  security.PermissionRead,
+	custodianv1.CustodianService_GetWithdrawal_FullMethodName:                      security.PermissionRead,
+	custodianv1.CustodianService_ListWithdrawalAddresses_FullMethodName:            security.PermissionRead,
+	custodianv1.CustodianService_ListApprovals_FullMethodName:                      security.PermissionRead,
+	custodianv1.CustodianService_GetApproval_FullMethodName:                        security.PermissionRead,
+	custodianv1.CustodianService_ListComplianceReviews_FullMethodName:              security.PermissionRead,
+	custodianv1.CustodianService_GetComplianceReview_FullMethodName:                security.PermissionRead,
+	custodianv1.CustodianService_CreateAccount_FullMethodName:                      security.PermissionWrite,
+	custodianv1.CustodianService_Deposit_FullMethodName:                            security.PermissionWrite,
+	custodianv1.CustodianService_SubmitSettlement_FullMethodName:                   security.PermissionWrite,
+	custodianv1.CustodianService_PutStandingSettlementInstruction_FullMethodName:   security.PermissionWrite,
+}
+
+// AuthorizationUnaryInterceptor rejects unary calls whose verified client
+// certificate identity does not hold the permission the method requires
+func AuthorizationUnaryInterceptor(policy *security.Policy, logger *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := authorize(ctx, policy, logger, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthorizationStreamInterceptor is the streaming counterpart of AuthorizationUnaryInterceptor
+func AuthorizationStreamInterceptor(policy *security.Policy, logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := authorize(ss.Context(), policy, logger, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, policy *security.Policy, logger *logrus.Logger, fullMethod string) error {
+	identity, _ := security.IdentityFromPeer(ctx)
+	perm := permissionFor(fullMethod)
+
+	err := policy.Authorize(identity, perm)
+	if err == nil {
+		return nil
+	}
+
+	logger.WithFields(logrus.Fields{
+		"client":         identity,
+		"grpc_method":    fullMethod,
+		"permission":     perm,
+		"correlation_id": observability.CorrelationIDFromContext(ctx),
+	}).WithError(err).Warn("gRPC request denied")
+
+	if errors.Is(err, security.ErrUnauthenticated) {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return status.Error(codes.PermissionDenied, err.Error())
+}
+
+func permissionFor(fullMethod string) security.Permission {
+	// Health checks must keep working for probes and load balancers
+	if strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/") {
+		return security.PermissionPublic
+	}
+	// Reflection only describes the API, it never changes state
+	if strings.HasPrefix(fullMethod, "/grpc.reflection.") {
+		return security.PermissionRead
+	}
+	if perm, ok := methodPermissions[fullMethod]; ok {
+		return perm
+	}
+	return security.PermissionWrite
+}
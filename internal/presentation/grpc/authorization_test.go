@@ -0,0 +1,202 @@
+//go:build unit
+
+package grpc_test
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	custodianv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/custodian/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/security"
+	grpcserver "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/presentation/grpc"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+func TestAuthorizationInterceptors(t *testing.T) {
+	// Given: A custodian gRPC server with mTLS and a per-client policy
+	dir := t.TempDir()
+	if err := security.WriteTestPKI(dir, []string{"custodian-simulator", "exchange-simulator", "risk-monitor"}); err != nil {
+		t.Fatalf("WriteTestPKI failed: %v", err)
+	}
+
+	cfg := tlsConfigFor(dir, "custodian-simulator")
+	creds, err := security.ServerCredentials(cfg)
+	if err != nil {
+		t.Fatalf("ServerCredentials failed: %v", err)
+	}
+	policy, _ := security.ParsePolicy("exchange-simulator=read|write;risk-monitor=read")
+
+	logger := quietLogger()
+	server := grpcserver.NewCustodianGRPCServerWithDependencies(cfg, services.NewCustodianService(cfg, logger), logger, nil,
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(grpcserver.AuthorizationUnaryInterceptor(policy, logger)),
+		grpc.ChainStreamInterceptor(grpcserver.AuthorizationStreamInterceptor(policy, logger)),
+	)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.GracefulStop()
+
+	dial := func(t *testing.T, name string) *grpc.ClientConn {
+		t.Helper()
+		clientCreds, err := security.ClientCredentials(tlsConfigFor(dir, name))
+		if err != nil {
+			t.Fatalf("ClientCredentials failed: %v", err)
+		}
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(clientCreds))
+		if err != nil {
+			t.Fatalf("Dial failed: %v", err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		return conn
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	t.Run("writer_may_create_accounts", func(t *testing.T) {
+		client := custodianv1.NewCustodianServiceClient(dial(t, "exchange-simulator"))
+
+		if _, err := client.CreateAccount(ctx, &custodianv1.CreateAccountRequest{AccountType: "trading"}); err != nil {
+			t.Errorf("Expected CreateAccount to succeed, got %v", err)
+		}
+	})
+
+	t.Run("reader_is_denied_writes_but_may_read", func(t *testing.T) {
+		client := custodianv1.NewCustodianServiceClient(dial(t, "risk-monitor"))
+
+		// When: The read-only client attempts a write
+		_, err := client.CreateAccount(ctx, &custodianv1.CreateAccountRequest{AccountType: "trading"})
+
+		// Then: It is rejected with PermissionDenied
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied, got %v", err)
+		}
+
+		// And: Reads pass authorization (the account simply does not exist)
+		_, err = client.GetBalance(ctx, &custodianv1.GetBalanceRequest{AccountId: "ACCT_missing", AssetId: "BTC"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound from an authorized read, got %v", err)
+		}
+	})
+
+	t.Run("health_checks_are_public_to_any_trusted_client", func(t *testing.T) {
+		// Given: A client that has a trusted certificate but no grants in the policy
+		client := grpc_health_v1.NewHealthClient(dial(t, "custodian-simulator"))
+
+		if _, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{}); err != nil {
+			t.Errorf("Expected health check to pass authorization, got %v", err)
+		}
+	})
+}
+
+// TestHTTPGatewayAuthorization verifies HTTP calls are authorized per gRPC method like gRPC calls
+// Following BDD Given/When/Then pattern
+func TestHTTPGatewayAuthorization(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Given: A gateway with a per-client policy, served over mTLS that lets anonymous clients connect
+	dir := t.TempDir()
+	if err := security.WriteTestPKI(dir, []string{"custodian-simulator", "exchange-simulator", "risk-monitor"}); err != nil {
+		t.Fatalf("WriteTestPKI failed: %v", err)
+	}
+	cfg := tlsConfigFor(dir, "custodian-simulator")
+	policy, _ := security.ParsePolicy("exchange-simulator=read|write;risk-monitor=read")
+
+	logger := quietLogger()
+	server := grpcserver.NewCustodianGRPCServerWithDependencies(cfg, services.NewCustodianService(cfg, logger), logger, nil)
+	gateway, err := grpcserver.NewHTTPGateway(server, logger, policy)
+	if err != nil {
+		t.Fatalf("NewHTTPGateway failed: %v", err)
+	}
+	router := gin.New()
+	gateway.Register(router)
+
+	httpServer := httptest.NewUnstartedServer(router)
+	httpServer.TLS, err = security.NewServerTLSConfig(cfg)
+	if err != nil {
+		t.Fatalf("NewServerTLSConfig failed: %v", err)
+	}
+	httpServer.TLS.ClientAuth = tls.VerifyClientCertIfGiven
+	httpServer.StartTLS()
+	defer httpServer.Close()
+	defer gateway.Close()
+
+	call := func(t *testing.T, name, method, path, body string) int {
+		t.Helper()
+		tlsConfig, err := security.NewClientTLSConfig(tlsConfigFor(dir, "custodian-simulator"))
+		if err != nil {
+			t.Fatalf("NewClientTLSConfig failed: %v", err)
+		}
+		if name == "" {
+			// Anonymous: trust the server but present no certificate
+			tlsConfig.Certificates = nil
+		} else if tlsConfig, err = security.NewClientTLSConfig(tlsConfigFor(dir, name)); err != nil {
+			t.Fatalf("NewClientTLSConfig failed: %v", err)
+		}
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+		req, _ := http.NewRequest(method, httpServer.URL+path, strings.NewReader(body))
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s failed: %v", method, path, err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	t.Run("writer_may_create_accounts", func(t *testing.T) {
+		if code := call(t, "exchange-simulator", http.MethodPost, "/api/v1/accounts", `{"accountType":"trading"}`); code != http.StatusOK {
+			t.Errorf("Expected 200, got %d", code)
+		}
+	})
+
+	t.Run("reader_is_denied_writes_but_may_read", func(t *testing.T) {
+		// When: The read-only client attempts a write
+		code := call(t, "risk-monitor", http.MethodPost, "/api/v1/accounts", `{"accountType":"trading"}`)
+
+		// Then: It is forbidden by the method's permission
+		if code != http.StatusForbidden {
+			t.Errorf("Expected 403, got %d", code)
+		}
+
+		// And: Reads pass authorization (the account simply does not exist)
+		if code := call(t, "risk-monitor", http.MethodGet, "/api/v1/accounts/ACCT_missing/balances/BTC", ""); code != http.StatusNotFound {
+			t.Errorf("Expected 404 from an authorized read, got %d", code)
+		}
+	})
+
+	t.Run("anonymous_clients_are_unauthenticated", func(t *testing.T) {
+		if code := call(t, "", http.MethodGet, "/api/v1/accounts/ACCT_missing/balances/BTC", ""); code != http.StatusUnauthorized {
+			t.Errorf("Expected 401, got %d", code)
+		}
+	})
+}
+
+func tlsConfigFor(dir, name string) *config.Config {
+	return &config.Config{
+		ServiceName: "custodian-simulator",
+		TLSEnabled:  true,
+		TLSCertFile: filepath.Join(dir, name+".crt"),
+		TLSKeyFile:  filepath.Join(dir, name+".key"),
+		TLSCAFile:   filepath.Join(dir, "ca.crt"),
+	}
+}
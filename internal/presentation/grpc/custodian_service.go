@@ -0,0 +1,1011 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	custodianv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/custodian/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// custodianServiceServer adapts services.CustodianService to the custodian.v1 gRPC API
+type custodianServiceServer struct {
+	custodianv1.UnimplementedCustodianServiceServer
+
+	custodianSvc *services.CustodianService
+	logger       *logrus.Logger
+
+	// verifiedReviewers requires compliance reviewers to present a client
+	// certificate, as they always can when TLS is enabled
+	verifiedReviewers bool
+
+	// shutdown is closed when the server stops so long-lived streams end promptly
+	shutdown <-chan struct{}
+}
+
+func newCustodianServiceServer(custodianSvc *services.CustodianService, logger *logrus.Logger, verifiedReviewers bool, shutdown <-chan struct{}) *custodianServiceServer {
+	return &custodianServiceServer{
+		custodianSvc:      custodianSvc,
+		logger:            logger,
+		verifiedReviewers: verifiedReviewers,
+		shutdown:          shutdown,
+	}
+}
+
+func (s *custodianServiceServer) CreateAccount(ctx context.Context, req *custodianv1.CreateAccountRequest) (*custodianv1.CreateAccountResponse, error) {
+	if req.GetAccountType() == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_type is required")
+	}
+
+	account, err := s.custodianSvc.CreateAccount(ctx, req.GetAccountType())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.CreateAccountResponse{Account: toProtoAccount(account)}, nil
+}
+
+func (s *custodianServiceServer) Deposit(ctx context.Context, req *custodianv1.DepositRequest) (*custodianv1.DepositResponse, error) {
+	balance, err := s.custodianSvc.Deposit(ctx, req.GetAccountId(), req.GetAssetId(), req.GetAmount())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.DepositResponse{Balance: balance}, nil
+}
+
+func (s *custodianServiceServer) Withdraw(ctx context.Context, req *custodianv1.WithdrawRequest) (*custodianv1.WithdrawResponse, error) {
+	withdrawal, err := s.custodianSvc.Withdraw(ctx, services.Withdrawal{
+		AccountID:   req.GetAccountId(),
+		AssetID:     req.GetAssetId(),
+		Amount:      req.GetAmount(),
+		Network:     req.GetNetwork(),
+		Address:     req.GetAddress(),
+		RequestedBy: callerIdentity(ctx, req.GetRequestedBy()),
+		TravelRule:  fromProtoTravelRule(req.GetTravelRule()),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.WithdrawResponse{Withdrawal: toProtoWithdrawal(*withdrawal)}, nil
+}
+
+func (s *custodianServiceServer) AddWithdrawalAddress(ctx context.Context, req *custodianv1.AddWithdrawalAddressRequest) (*custodianv1.WithdrawalAddressResponse, error) {
+	address, err := s.custodianSvc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{
+		AccountID: req.GetAccountId(),
+		AssetID:   req.GetAssetId(),
+		Network:   req.GetNetwork(),
+		Address:   req.GetAddress(),
+		Label:     req.GetLabel(),
+		AddedBy:   callerIdentity(ctx, req.GetAddedBy()),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.WithdrawalAddressResponse{Address: toProtoWithdrawalAddress(*address)}, nil
+}
+
+func (s *custodianServiceServer) ListWithdrawalAddresses(ctx context.Context, req *custodianv1.ListWithdrawalAddressesRequest) (*custodianv1.ListWithdrawalAddressesResponse, error) {
+	addresses, err := s.custodianSvc.ListWithdrawalAddresses(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &custodianv1.ListWithdrawalAddressesResponse{}
+	for _, address := range addresses {
+		resp.Addresses = append(resp.Addresses, toProtoWithdrawalAddress(address))
+	}
+	return resp, nil
+}
+
+func (s *custodianServiceServer) RemoveWithdrawalAddress(ctx context.Context, req *custodianv1.RemoveWithdrawalAddressRequest) (*custodianv1.WithdrawalAddressResponse, error) {
+	address, err := s.custodianSvc.RemoveWithdrawalAddress(ctx, req.GetAccountId(), req.GetAddressId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.WithdrawalAddressResponse{Address: toProtoWithdrawalAddress(*address)}, nil
+}
+
+func (s *custodianServiceServer) GetWithdrawal(ctx context.Context, req *custodianv1.GetWithdrawalRequest) (*custodianv1.GetWithdrawalResponse, error) {
+	withdrawal, err := s.custodianSvc.GetWithdrawal(ctx, req.GetWithdrawalId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.GetWithdrawalResponse{Withdrawal: toProtoWithdrawal(*withdrawal)}, nil
+}
+
+func (s *custodianServiceServer) SubmitChainDeposit(ctx context.Context, req *custodianv1.SubmitChainDepositRequest) (*custodianv1.ChainTransactionResponse, error) {
+	tx, err := s.custodianSvc.SubmitChainDeposit(ctx, req.GetAccountId(), req.GetAssetId(), req.GetNetwork(), req.GetAmount(), req.GetFromAddress())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.ChainTransactionResponse{Transaction: toProtoChainTransaction(*tx)}, nil
+}
+
+func (s *custodianServiceServer) GetChainStatus(ctx context.Context, req *custodianv1.GetChainStatusRequest) (*custodianv1.GetChainStatusResponse, error) {
+	chain, err := s.custodianSvc.ChainStatus(ctx, req.GetNetwork())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &custodianv1.GetChainStatusResponse{
+		Network:              chain.Network,
+		Height:               chain.Height,
+		TipHash:              chain.TipHash,
+		NextBlockAt:          timestamppb.New(chain.NextBlockAt),
+		MempoolSize:          int32(chain.MempoolSize),
+		BlockIntervalSeconds: int64(chain.BlockInterval / time.Second),
+		Confirmations:        int32(chain.Confirmations),
+		Fee:                  chain.Fee,
+	}
+	if !chain.TipMinedAt.IsZero() {
+		resp.TipMinedAt = timestamppb.New(chain.TipMinedAt)
+	}
+	return resp, nil
+}
+
+func (s *custodianServiceServer) GetChainTransaction(ctx context.Context, req *custodianv1.GetChainTransactionRequest) (*custodianv1.ChainTransactionResponse, error) {
+	tx, err := s.custodianSvc.GetChainTransaction(ctx, req.GetNetwork(), req.GetHash())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.ChainTransactionResponse{Transaction: toProtoChainTransaction(*tx)}, nil
+}
+
+func (s *custodianServiceServer) SubmitFiatDeposit(ctx context.Context, req *custodianv1.SubmitFiatDepositRequest) (*custodianv1.FiatPaymentResponse, error) {
+	payment, err := s.custodianSvc.SubmitFiatDeposit(ctx, services.FiatPayment{
+		AccountID:   req.GetAccountId(),
+		AssetID:     req.GetAssetId(),
+		Amount:      req.GetAmount(),
+		Rail:        req.GetRail(),
+		BankAccount: req.GetBankAccount(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.FiatPaymentResponse{Payment: toProtoFiatPayment(*payment)}, nil
+}
+
+func (s *custodianServiceServer) GetFiatPayment(ctx context.Context, req *custodianv1.GetFiatPaymentRequest) (*custodianv1.FiatPaymentResponse, error) {
+	payment, err := s.custodianSvc.GetFiatPayment(ctx, req.GetPaymentId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.FiatPaymentResponse{Payment: toProtoFiatPayment(*payment)}, nil
+}
+
+func (s *custodianServiceServer) GetWalletTiers(ctx context.Context, req *custodianv1.GetWalletTiersRequest) (*custodianv1.GetWalletTiersResponse, error) {
+	tiers, err := s.custodianSvc.WalletTierStatus(ctx, req.GetAssetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &custodianv1.GetWalletTiersResponse{
+		AssetId:             tiers.AssetID,
+		Hot:                 tiers.Balances[services.WalletTierHot],
+		Warm:                tiers.Balances[services.WalletTierWarm],
+		Cold:                tiers.Balances[services.WalletTierCold],
+		TargetHot:           tiers.Targets.Hot,
+		TargetWarm:          tiers.Targets.Warm,
+		TargetCold:          tiers.Targets.Cold,
+		InTransit:           tiers.InTransit,
+		AwaitingWithdrawals: int32(tiers.AwaitingWithdrawals),
+	}
+	for _, operation := range tiers.Operations {
+		resp.Operations = append(resp.Operations, &custodianv1.RebalanceOperation{
+			Id:          operation.ID,
+			AssetId:     operation.AssetID,
+			FromTier:    operation.FromTier,
+			ToTier:      operation.ToTier,
+			Amount:      operation.Amount,
+			Reason:      operation.Reason,
+			Status:      operation.Status,
+			StartedAt:   timestamppb.New(operation.StartedAt),
+			CompletesAt: timestamppb.New(operation.CompletesAt),
+		})
+	}
+	return resp, nil
+}
+
+func (s *custodianServiceServer) GetBalance(ctx context.Context, req *custodianv1.GetBalanceRequest) (*custodianv1.GetBalanceResponse, error) {
+	balance, err := s.custodianSvc.GetAccountBalance(ctx, req.GetAccountId(), req.GetAssetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	available, err := s.custodianSvc.GetAvailableBalance(ctx, req.GetAccountId(), req.GetAssetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.GetBalanceResponse{
+		AccountId: req.GetAccountId(),
+		AssetId:   req.GetAssetId(),
+		Balance:   balance,
+		Available: available,
+	}, nil
+}
+
+func (s *custodianServiceServer) GetTransferHeadroom(ctx context.Context, req *custodianv1.GetTransferHeadroomRequest) (*custodianv1.GetTransferHeadroomResponse, error) {
+	headroom, err := s.custodianSvc.TransferHeadroom(ctx, req.GetAccountId(), req.GetAssetId(), time.Now())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.GetTransferHeadroomResponse{
+		AccountId:            headroom.AccountID,
+		AssetId:              headroom.AssetID,
+		PerTransaction:       headroom.PerTransaction,
+		Daily:                toProtoLimitUsage(headroom.Daily),
+		Rolling:              toProtoLimitUsage(headroom.Rolling),
+		RollingWindowSeconds: int64(headroom.RollingWindow / time.Second),
+		Remaining:            headroom.Remaining,
+	}, nil
+}
+
+func (s *custodianServiceServer) SubmitSettlement(ctx context.Context, req *custodianv1.SubmitSettlementRequest) (*custodianv1.SubmitSettlementResponse, error) {
+	if req.GetFromCounterparty() != "" || req.GetToCounterparty() != "" {
+		return s.submitCounterpartySettlement(ctx, req)
+	}
+
+	// Without a settlement date the asset's calendar and cut-off decide when it
+	// settles. Only settlements refused outright are errors; failed settlements are
+	// kept, and retried until their fail deadline, so they are reported like any other.
+	settlement, err := s.custodianSvc.SubmitSettlementInstruction(ctx, services.Settlement{
+		FromAccount:  req.GetFromAccount(),
+		ToAccount:    req.GetToAccount(),
+		AssetID:      req.GetAssetId(),
+		Amount:       req.GetAmount(),
+		AllowPartial: req.GetAllowPartial(),
+		InitiatedBy:  callerIdentity(ctx, req.GetRequestedBy()),
+		TravelRule:   fromProtoTravelRule(req.GetTravelRule()),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toProtoSubmitSettlementResponse(*settlement), nil
+}
+
+func (s *custodianServiceServer) submitCounterpartySettlement(ctx context.Context, req *custodianv1.SubmitSettlementRequest) (*custodianv1.SubmitSettlementResponse, error) {
+	if req.GetFromCounterparty() == "" || req.GetToCounterparty() == "" {
+		return nil, status.Error(codes.InvalidArgument, "from_counterparty and to_counterparty must be set together")
+	}
+	if req.GetFromAccount() != "" || req.GetToAccount() != "" {
+		return nil, status.Error(codes.InvalidArgument, "accounts come from standing settlement instructions when counterparties are set")
+	}
+
+	settlement, err := s.custodianSvc.SubmitCounterpartySettlement(ctx, req.GetFromCounterparty(), req.GetToCounterparty(), req.GetAssetId(), req.GetAmount(),
+		callerIdentity(ctx, req.GetRequestedBy()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toProtoSubmitSettlementResponse(*settlement), nil
+}
+
+func (s *custodianServiceServer) GetSettlement(ctx context.Context, req *custodianv1.GetSettlementRequest) (*custodianv1.GetSettlementResponse, error) {
+	settlement, err := s.custodianSvc.GetSettlement(ctx, req.GetSettlementId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.GetSettlementResponse{Settlement: toProtoSettlement(*settlement)}, nil
+}
+
+func (s *custodianServiceServer) AmendSettlement(ctx context.Context, req *custodianv1.AmendSettlementRequest) (*custodianv1.SettlementChangeResponse, error) {
+	if req.Amount != nil && req.GetAmount() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	change := services.SettlementChange{
+		RequestedBy: callerIdentity(ctx, req.GetRequestedBy()),
+		Reason:      req.GetReason(),
+		Amount:      req.GetAmount(),
+	}
+	if req.GetSettlementDate() != nil {
+		change.SettlementDate = req.GetSettlementDate().AsTime()
+	}
+
+	settlement, err := s.custodianSvc.AmendSettlement(ctx, req.GetSettlementId(), change)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.SettlementChangeResponse{Settlement: toProtoSettlement(*settlement)}, nil
+}
+
+func (s *custodianServiceServer) CancelSettlement(ctx context.Context, req *custodianv1.CancelSettlementRequest) (*custodianv1.SettlementChangeResponse, error) {
+	settlement, err := s.custodianSvc.CancelSettlement(ctx, req.GetSettlementId(), callerIdentity(ctx, req.GetRequestedBy()), req.GetReason())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.SettlementChangeResponse{Settlement: toProtoSettlement(*settlement)}, nil
+}
+
+func (s *custodianServiceServer) ApproveSettlementChange(ctx context.Context, req *custodianv1.ApproveSettlementChangeRequest) (*custodianv1.SettlementChangeResponse, error) {
+	settlement, err := s.custodianSvc.ApproveSettlementChange(ctx, req.GetSettlementId(), callerIdentity(ctx, req.GetApprovedBy()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.SettlementChangeResponse{Settlement: toProtoSettlement(*settlement)}, nil
+}
+
+func (s *custodianServiceServer) RejectSettlementChange(ctx context.Context, req *custodianv1.RejectSettlementChangeRequest) (*custodianv1.SettlementChangeResponse, error) {
+	settlement, err := s.custodianSvc.RejectSettlementChange(ctx, req.GetSettlementId(), callerIdentity(ctx, req.GetRejectedBy()), req.GetReason())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.SettlementChangeResponse{Settlement: toProtoSettlement(*settlement)}, nil
+}
+
+func (s *custodianServiceServer) ListApprovals(ctx context.Context, req *custodianv1.ListApprovalsRequest) (*custodianv1.ListApprovalsResponse, error) {
+	resp := &custodianv1.ListApprovalsResponse{}
+	for _, request := range s.custodianSvc.ListApprovalRequests(ctx, req.GetStatus()) {
+		resp.Approvals = append(resp.Approvals, toProtoApproval(request))
+	}
+	return resp, nil
+}
+
+func (s *custodianServiceServer) GetApproval(ctx context.Context, req *custodianv1.GetApprovalRequest) (*custodianv1.ApprovalResponse, error) {
+	request, err := s.custodianSvc.GetApprovalRequest(ctx, req.GetApprovalId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.ApprovalResponse{Approval: toProtoApproval(*request)}, nil
+}
+
+func (s *custodianServiceServer) ApproveOperation(ctx context.Context, req *custodianv1.ApproveOperationRequest) (*custodianv1.ApprovalResponse, error) {
+	approver, err := verifiedIdentity(ctx, "approving operations")
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.custodianSvc.ApproveOperation(ctx, req.GetApprovalId(), approver)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.ApprovalResponse{Approval: toProtoApproval(*request)}, nil
+}
+
+func (s *custodianServiceServer) RejectOperation(ctx context.Context, req *custodianv1.RejectOperationRequest) (*custodianv1.ApprovalResponse, error) {
+	approver, err := verifiedIdentity(ctx, "rejecting operations")
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.custodianSvc.RejectOperation(ctx, req.GetApprovalId(), approver, req.GetReason())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.ApprovalResponse{Approval: toProtoApproval(*request)}, nil
+}
+
+func (s *custodianServiceServer) ListComplianceReviews(ctx context.Context, req *custodianv1.ListComplianceReviewsRequest) (*custodianv1.ListComplianceReviewsResponse, error) {
+	resp := &custodianv1.ListComplianceReviewsResponse{}
+	for _, review := range s.custodianSvc.ListComplianceReviews(ctx, req.GetStatus()) {
+		resp.Reviews = append(resp.Reviews, toProtoComplianceReview(review))
+	}
+	return resp, nil
+}
+
+func (s *custodianServiceServer) GetComplianceReview(ctx context.Context, req *custodianv1.GetComplianceReviewRequest) (*custodianv1.ComplianceReviewResponse, error) {
+	review, err := s.custodianSvc.GetComplianceReview(ctx, req.GetReviewId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.ComplianceReviewResponse{Review: toProtoComplianceReview(*review)}, nil
+}
+
+func (s *custodianServiceServer) ReleaseComplianceReview(ctx context.Context, req *custodianv1.ResolveComplianceReviewRequest) (*custodianv1.ComplianceReviewResponse, error) {
+	reviewer, err := s.reviewerIdentity(ctx, req.GetReviewer())
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.custodianSvc.ReleaseComplianceReview(ctx, req.GetReviewId(), reviewer, req.GetNotes())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.ComplianceReviewResponse{Review: toProtoComplianceReview(*review)}, nil
+}
+
+func (s *custodianServiceServer) RejectComplianceReview(ctx context.Context, req *custodianv1.ResolveComplianceReviewRequest) (*custodianv1.ComplianceReviewResponse, error) {
+	reviewer, err := s.reviewerIdentity(ctx, req.GetReviewer())
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.custodianSvc.RejectComplianceReview(ctx, req.GetReviewId(), reviewer, req.GetNotes())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.ComplianceReviewResponse{Review: toProtoComplianceReview(*review)}, nil
+}
+
+func (s *custodianServiceServer) SubmitMatchingInstruction(ctx context.Context, req *custodianv1.SubmitMatchingInstructionRequest) (*custodianv1.SubmitMatchingInstructionResponse, error) {
+	// Each leg confirms the trade for its counterparty, so it must come from a verified identity
+	submitter, err := verifiedIdentity(ctx, "submitting matching instructions")
+	if err != nil {
+		return nil, err
+	}
+
+	instruction := services.MatchingInstruction{
+		ID:             req.GetInstructionId(),
+		Side:           req.GetSide(),
+		TradeReference: req.GetTradeReference(),
+		FromAccount:    req.GetFromAccount(),
+		ToAccount:      req.GetToAccount(),
+		AssetID:        req.GetAssetId(),
+		Amount:         req.GetAmount(),
+		SubmittedBy:    submitter,
+	}
+	if req.GetSettlementDate() != nil {
+		instruction.SettlementDate = req.GetSettlementDate().AsTime()
+	}
+
+	submitted, err := s.custodianSvc.SubmitMatchingInstruction(ctx, instruction)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.SubmitMatchingInstructionResponse{Instruction: toProtoMatchingInstruction(*submitted)}, nil
+}
+
+func (s *custodianServiceServer) GetMatchingInstruction(ctx context.Context, req *custodianv1.GetMatchingInstructionRequest) (*custodianv1.GetMatchingInstructionResponse, error) {
+	instruction, err := s.custodianSvc.GetMatchingInstruction(ctx, req.GetInstructionId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.GetMatchingInstructionResponse{Instruction: toProtoMatchingInstruction(*instruction)}, nil
+}
+
+func (s *custodianServiceServer) GetMismatchReport(ctx context.Context, req *custodianv1.GetMismatchReportRequest) (*custodianv1.GetMismatchReportResponse, error) {
+	report := s.custodianSvc.MismatchReport(ctx, time.Now())
+
+	resp := &custodianv1.GetMismatchReportResponse{
+		GeneratedAt: timestamppb.New(report.GeneratedAt),
+		CountByAge:  make(map[string]int32, len(report.CountByAge)),
+	}
+	for bucket, count := range report.CountByAge {
+		resp.CountByAge[bucket] = int32(count)
+	}
+	for _, entry := range report.Unmatched {
+		resp.Unmatched = append(resp.Unmatched, &custodianv1.UnmatchedInstruction{
+			Instruction: toProtoMatchingInstruction(entry.MatchingInstruction),
+			AgeSeconds:  int64(entry.Age / time.Second),
+			AgeBucket:   entry.AgeBucket,
+		})
+	}
+	return resp, nil
+}
+
+func (s *custodianServiceServer) GetFailsReport(ctx context.Context, req *custodianv1.GetFailsReportRequest) (*custodianv1.GetFailsReportResponse, error) {
+	date := time.Now()
+	if req.GetDate() != "" {
+		parsed, err := time.Parse(time.DateOnly, req.GetDate())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "date must be YYYY-MM-DD")
+		}
+		date = parsed
+	}
+
+	report := s.custodianSvc.FailsReport(ctx, date)
+	resp := &custodianv1.GetFailsReportResponse{
+		Date:             report.Date.Format(time.DateOnly),
+		CountByReason:    make(map[string]int32, len(report.CountByReason)),
+		UnsettledByAsset: report.UnsettledByAsset,
+	}
+	for reason, count := range report.CountByReason {
+		resp.CountByReason[reason] = int32(count)
+	}
+	for _, settlement := range report.Fails {
+		resp.Fails = append(resp.Fails, toProtoSettlementFail(settlement))
+	}
+	return resp, nil
+}
+
+func (s *custodianServiceServer) PutStandingSettlementInstruction(ctx context.Context, req *custodianv1.PutStandingSettlementInstructionRequest) (*custodianv1.PutStandingSettlementInstructionResponse, error) {
+	ssi, err := s.custodianSvc.PutStandingSettlementInstruction(ctx, services.StandingSettlementInstruction{
+		Counterparty: req.GetCounterparty(),
+		AssetID:      req.GetAssetId(),
+		AccountID:    req.GetAccountId(),
+		Network:      req.GetNetwork(),
+		CycleDays:    int(req.GetSettlementCycleDays()),
+		CutOff:       req.GetCutOff(),
+	}, int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &custodianv1.PutStandingSettlementInstructionResponse{Ssi: toProtoSSI(*ssi)}, nil
+}
+
+func (s *custodianServiceServer) GetStandingSettlementInstruction(ctx context.Context, req *custodianv1.GetStandingSettlementInstructionRequest) (*custodianv1.GetStandingSettlementInstructionResponse, error) {
+	ssi, err := s.custodianSvc.GetStandingSettlementInstruction(ctx, req.GetCounterparty(), req.GetAssetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &custodianv1.GetStandingSettlementInstructionResponse{Ssi: toProtoSSI(*ssi)}
+	if req.GetIncludeHistory() {
+		history, err := s.custodianSvc.StandingSettlementInstructionHistory(ctx, req.GetCounterparty(), req.GetAssetId())
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		for _, version := range history {
+			resp.History = append(resp.History, toProtoSSI(version))
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *custodianServiceServer) ListStandingSettlementInstructions(ctx context.Context, req *custodianv1.ListStandingSettlementInstructionsRequest) (*custodianv1.ListStandingSettlementInstructionsResponse, error) {
+	resp := &custodianv1.ListStandingSettlementInstructionsResponse{}
+	for _, ssi := range s.custodianSvc.ListStandingSettlementInstructions(ctx, req.GetCounterparty()) {
+		resp.Ssis = append(resp.Ssis, toProtoSSI(ssi))
+	}
+	return resp, nil
+}
+
+func (s *custodianServiceServer) SubscribeAccountEvents(req *custodianv1.SubscribeAccountEventsRequest, stream custodianv1.CustodianService_SubscribeAccountEventsServer) error {
+	sub, err := s.custodianSvc.SubscribeAccountEvents(req.GetAccountIds(), req.ResumeAfterSequence)
+	if err != nil {
+		return toStatusError(err)
+	}
+	defer sub.Close()
+
+	// Headers tell the client the subscription is registered and no live events will be missed
+	if err := stream.SendHeader(nil); err != nil {
+		return err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"accounts":     req.GetAccountIds(),
+		"resume_after": req.ResumeAfterSequence,
+		"replayed":     len(sub.Replay),
+	}).Info("Account event subscriber connected")
+
+	for _, event := range sub.Replay {
+		if err := stream.Send(toProtoAccountEvent(event)); err != nil {
+			return err
+		}
+	}
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.shutdown:
+			// Subscribers reconnect with their last sequence to resume elsewhere
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case event, ok := <-sub.Events:
+			if !ok {
+				if err := sub.Err(); err != nil {
+					return toStatusError(err)
+				}
+				return nil
+			}
+			if err := stream.Send(toProtoAccountEvent(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// toStatusError maps service errors onto gRPC status codes
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, services.ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, services.ErrInsufficientBalance), errors.Is(err, services.ErrAccountInactive),
+		errors.Is(err, services.ErrSettlementInProgress), errors.Is(err, services.ErrAddressNotWhitelisted),
+		errors.Is(err, services.ErrComplianceRejected):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, services.ErrEventsUnavailable):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, services.ErrSubscriberTooSlow), errors.Is(err, services.ErrLimitExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// callerIdentity returns the verified client certificate identity when there is
+// one, and otherwise the identity the request claims, falling back to the caller's
+// self-reported client ID so that every operation records a maker
+func callerIdentity(ctx context.Context, claimed string) string {
+	if actor := observability.ActorFromContext(ctx); actor.Verified || claimed == "" {
+		return actor.ID
+	}
+	return claimed
+}
+
+// verifiedIdentity returns the verified client certificate identity, refusing
+// callers without one: a claimed identity cannot keep makers and approvers apart
+func verifiedIdentity(ctx context.Context, action string) (string, error) {
+	if actor := observability.ActorFromContext(ctx); actor.Verified {
+		return actor.ID, nil
+	}
+	return "", status.Errorf(codes.Unauthenticated, "%s requires a verified client certificate", action)
+}
+
+// reviewerIdentity returns who resolves a compliance review: the verified client
+// certificate identity when reviewers must be verified, and otherwise the caller
+func (s *custodianServiceServer) reviewerIdentity(ctx context.Context, claimed string) (string, error) {
+	if s.verifiedReviewers {
+		return verifiedIdentity(ctx, "resolving compliance reviews")
+	}
+	return callerIdentity(ctx, claimed), nil
+}
+
+func toProtoAccount(account *services.Account) *custodianv1.Account {
+	return &custodianv1.Account{
+		Id:        account.ID,
+		Type:      account.Type,
+		Status:    account.Status,
+		CreatedAt: timestamppb.New(account.CreatedAt),
+		UpdatedAt: timestamppb.New(account.UpdatedAt),
+	}
+}
+
+func toProtoSubmitSettlementResponse(settlement services.Settlement) *custodianv1.SubmitSettlementResponse {
+	resp := &custodianv1.SubmitSettlementResponse{
+		SettlementId:   settlement.ID,
+		Status:         settlement.Status,
+		SettlementDate: timestamppb.New(settlement.SettlementDate),
+		ApprovalId:     settlement.ApprovalID,
+		ReviewId:       settlement.ReviewID,
+		ReasonCode:     settlement.ReasonCode,
+		Reason:         settlement.Reason,
+	}
+	if settlement.FailDeadline != nil {
+		resp.FailDeadline = timestamppb.New(*settlement.FailDeadline)
+	}
+	return resp
+}
+
+func toProtoSettlement(settlement services.Settlement) *custodianv1.Settlement {
+	msg := &custodianv1.Settlement{
+		Id:               settlement.ID,
+		FromAccount:      settlement.FromAccount,
+		ToAccount:        settlement.ToAccount,
+		AssetId:          settlement.AssetID,
+		Amount:           settlement.Amount,
+		SettledAmount:    settlement.SettledAmount,
+		Status:           settlement.Status,
+		ReasonCode:       settlement.ReasonCode,
+		Reason:           settlement.Reason,
+		SettlementDate:   timestamppb.New(settlement.SettlementDate),
+		CreatedAt:        timestamppb.New(settlement.CreatedAt),
+		FromCounterparty: settlement.FromCounterparty,
+		ToCounterparty:   settlement.ToCounterparty,
+		TradeId:          settlement.TradeID,
+		InitiatedBy:      settlement.InitiatedBy,
+		ApprovalId:       settlement.ApprovalID,
+		ReviewId:         settlement.ReviewID,
+	}
+	for _, amendment := range settlement.Amendments {
+		entry := &custodianv1.SettlementAmendment{
+			Sequence:               int32(amendment.Sequence),
+			Action:                 amendment.Action,
+			Status:                 amendment.Status,
+			RequestedBy:            amendment.RequestedBy,
+			ResolvedBy:             amendment.ResolvedBy,
+			Reason:                 amendment.Reason,
+			PreviousAmount:         amendment.PreviousAmount,
+			Amount:                 amendment.Amount,
+			PreviousSettlementDate: timestamppb.New(amendment.PreviousSettlementDate),
+			SettlementDate:         timestamppb.New(amendment.SettlementDate),
+			RequestedAt:            timestamppb.New(amendment.RequestedAt),
+		}
+		if amendment.ResolvedAt != nil {
+			entry.ResolvedAt = timestamppb.New(*amendment.ResolvedAt)
+		}
+		msg.Amendments = append(msg.Amendments, entry)
+	}
+	return msg
+}
+
+func toProtoLimitUsage(usage *services.LimitUsage) *custodianv1.LimitUsage {
+	if usage == nil {
+		return nil
+	}
+	return &custodianv1.LimitUsage{Limit: usage.Limit, Used: usage.Used, Remaining: usage.Remaining}
+}
+
+func toProtoWithdrawal(withdrawal services.Withdrawal) *custodianv1.Withdrawal {
+	msg := &custodianv1.Withdrawal{
+		Id:            withdrawal.ID,
+		AccountId:     withdrawal.AccountID,
+		AssetId:       withdrawal.AssetID,
+		Amount:        withdrawal.Amount,
+		Network:       withdrawal.Network,
+		Address:       withdrawal.Address,
+		RequestedBy:   withdrawal.RequestedBy,
+		Status:        withdrawal.Status,
+		Reason:        withdrawal.Reason,
+		ApprovalId:    withdrawal.ApprovalID,
+		ReviewId:      withdrawal.ReviewID,
+		TxHash:        withdrawal.TxHash,
+		Fee:           withdrawal.Fee,
+		Confirmations: int32(withdrawal.Confirmations),
+		PaymentId:     withdrawal.PaymentID,
+		Reference:     withdrawal.Reference,
+		CreatedAt:     timestamppb.New(withdrawal.CreatedAt),
+	}
+	if withdrawal.CompletedAt != nil {
+		msg.CompletedAt = timestamppb.New(*withdrawal.CompletedAt)
+	}
+	return msg
+}
+
+func toProtoChainTransaction(tx services.ChainTransaction) *custodianv1.ChainTransaction {
+	msg := &custodianv1.ChainTransaction{
+		Hash:          tx.Hash,
+		Network:       tx.Network,
+		Direction:     tx.Direction,
+		ResourceId:    tx.ResourceID,
+		AccountId:     tx.AccountID,
+		AssetId:       tx.AssetID,
+		Amount:        tx.Amount,
+		Fee:           tx.Fee,
+		Address:       tx.Address,
+		Status:        tx.Status,
+		BlockHeight:   tx.BlockHeight,
+		BlockHash:     tx.BlockHash,
+		Confirmations: int32(tx.Confirmations),
+		Reorgs:        int32(tx.Reorgs),
+		BroadcastAt:   timestamppb.New(tx.BroadcastAt),
+	}
+	if tx.ConfirmedAt != nil {
+		msg.ConfirmedAt = timestamppb.New(*tx.ConfirmedAt)
+	}
+	return msg
+}
+
+func toProtoFiatPayment(payment services.FiatPayment) *custodianv1.FiatPayment {
+	msg := &custodianv1.FiatPayment{
+		Id:          payment.ID,
+		Reference:   payment.Reference,
+		Rail:        payment.Rail,
+		Direction:   payment.Direction,
+		ResourceId:  payment.ResourceID,
+		AccountId:   payment.AccountID,
+		AssetId:     payment.AssetID,
+		Amount:      payment.Amount,
+		BankAccount: payment.BankAccount,
+		Status:      payment.Status,
+		ReturnCode:  payment.ReturnCode,
+		SubmittedAt: timestamppb.New(payment.SubmittedAt),
+		BatchAt:     timestamppb.New(payment.BatchAt),
+		SettlesAt:   timestamppb.New(payment.SettlesAt),
+	}
+	if payment.CompletedAt != nil {
+		msg.CompletedAt = timestamppb.New(*payment.CompletedAt)
+	}
+	return msg
+}
+
+func toProtoWithdrawalAddress(address services.WithdrawalAddress) *custodianv1.WithdrawalAddress {
+	msg := &custodianv1.WithdrawalAddress{
+		Id:         address.ID,
+		AccountId:  address.AccountID,
+		AssetId:    address.AssetID,
+		Network:    address.Network,
+		Address:    address.Address,
+		Label:      address.Label,
+		AddedBy:    address.AddedBy,
+		Status:     address.Status,
+		AddedAt:    timestamppb.New(address.AddedAt),
+		ActiveFrom: timestamppb.New(address.ActiveFrom),
+	}
+	if address.RemovedAt != nil {
+		msg.RemovedAt = timestamppb.New(*address.RemovedAt)
+	}
+	return msg
+}
+
+func toProtoComplianceReview(review services.ComplianceReview) *custodianv1.ComplianceReview {
+	msg := &custodianv1.ComplianceReview{
+		Id:         review.ID,
+		Operation:  review.Operation,
+		ResourceId: review.ResourceID,
+		AccountId:  review.AccountID,
+		AssetId:    review.AssetID,
+		Amount:     review.Amount,
+		Reasons:    review.Reasons,
+		Status:     review.Status,
+		Reviewer:   review.Reviewer,
+		Notes:      review.Notes,
+		CreatedAt:  timestamppb.New(review.CreatedAt),
+	}
+	if review.ResolvedAt != nil {
+		msg.ResolvedAt = timestamppb.New(*review.ResolvedAt)
+	}
+	return msg
+}
+
+func fromProtoTravelRule(data *custodianv1.TravelRuleData) *ports.TravelRuleData {
+	if data == nil {
+		return nil
+	}
+	return &ports.TravelRuleData{
+		OriginatorName:     data.GetOriginatorName(),
+		OriginatorAccount:  data.GetOriginatorAccount(),
+		BeneficiaryName:    data.GetBeneficiaryName(),
+		BeneficiaryAccount: data.GetBeneficiaryAccount(),
+	}
+}
+
+func toProtoApproval(request services.ApprovalRequest) *custodianv1.Approval {
+	msg := &custodianv1.Approval{
+		Id:          request.ID,
+		Operation:   request.Operation,
+		ResourceId:  request.ResourceID,
+		AccountId:   request.AccountID,
+		AssetId:     request.AssetID,
+		Amount:      request.Amount,
+		RequestedBy: request.RequestedBy,
+		Quorum:      int32(request.Quorum),
+		Approvers:   request.Approvers,
+		Status:      request.Status,
+		CreatedAt:   timestamppb.New(request.CreatedAt),
+	}
+	for _, decision := range request.Decisions {
+		msg.Decisions = append(msg.Decisions, &custodianv1.ApprovalDecision{
+			Approver: decision.Approver,
+			Approved: decision.Approved,
+			Reason:   decision.Reason,
+			At:       timestamppb.New(decision.At),
+		})
+	}
+	if request.ExpiresAt != nil {
+		msg.ExpiresAt = timestamppb.New(*request.ExpiresAt)
+	}
+	if request.ResolvedAt != nil {
+		msg.ResolvedAt = timestamppb.New(*request.ResolvedAt)
+	}
+	return msg
+}
+
+func toProtoMatchingInstruction(instruction services.MatchingInstruction) *custodianv1.MatchingInstruction {
+	msg := &custodianv1.MatchingInstruction{
+		Id:              instruction.ID,
+		Side:            instruction.Side,
+		TradeReference:  instruction.TradeReference,
+		FromAccount:     instruction.FromAccount,
+		ToAccount:       instruction.ToAccount,
+		AssetId:         instruction.AssetID,
+		Amount:          instruction.Amount,
+		SettlementDate:  timestamppb.New(instruction.SettlementDate),
+		SubmittedBy:     instruction.SubmittedBy,
+		Status:          instruction.Status,
+		MatchedWith:     instruction.MatchedWith,
+		SettlementId:    instruction.SettlementID,
+		MismatchReasons: instruction.MismatchReasons,
+		SubmittedAt:     timestamppb.New(instruction.SubmittedAt),
+	}
+	if instruction.MatchedAt != nil {
+		msg.MatchedAt = timestamppb.New(*instruction.MatchedAt)
+	}
+	return msg
+}
+
+func toProtoSettlementFail(settlement services.Settlement) *custodianv1.SettlementFail {
+	msg := &custodianv1.SettlementFail{
+		SettlementId:   settlement.ID,
+		FromAccount:    settlement.FromAccount,
+		ToAccount:      settlement.ToAccount,
+		AssetId:        settlement.AssetID,
+		Amount:         settlement.Amount,
+		SettledAmount:  settlement.SettledAmount,
+		Status:         settlement.Status,
+		ReasonCode:     settlement.ReasonCode,
+		Reason:         settlement.Reason,
+		Attempts:       int32(settlement.Attempts),
+		SettlementDate: timestamppb.New(settlement.SettlementDate),
+	}
+	if settlement.FailedAt != nil {
+		msg.FailedAt = timestamppb.New(*settlement.FailedAt)
+	}
+	if settlement.FailDeadline != nil {
+		msg.FailDeadline = timestamppb.New(*settlement.FailDeadline)
+	}
+	return msg
+}
+
+func toProtoSSI(ssi services.StandingSettlementInstruction) *custodianv1.StandingSettlementInstruction {
+	msg := &custodianv1.StandingSettlementInstruction{
+		Counterparty:        ssi.Counterparty,
+		AssetId:             ssi.AssetID,
+		AccountId:           ssi.AccountID,
+		Network:             ssi.Network,
+		SettlementCycleDays: int32(ssi.CycleDays),
+		CutOff:              ssi.CutOff,
+		Version:             int32(ssi.Version),
+		EffectiveFrom:       timestamppb.New(ssi.EffectiveFrom),
+	}
+	if ssi.SupersededAt != nil {
+		msg.SupersededAt = timestamppb.New(*ssi.SupersededAt)
+	}
+	return msg
+}
+
+func toProtoAccountEvent(event services.AccountEvent) *custodianv1.AccountEvent {
+	msg := &custodianv1.AccountEvent{
+		Sequence:  event.Sequence,
+		AccountId: event.AccountID,
+		Timestamp: timestamppb.New(event.Timestamp),
+	}
+
+	switch event.Type {
+	case services.AccountEventBalanceChanged:
+		msg.Type = custodianv1.AccountEventType_ACCOUNT_EVENT_TYPE_BALANCE_CHANGED
+		msg.Detail = &custodianv1.AccountEvent_BalanceChange{BalanceChange: &custodianv1.BalanceChange{
+			AssetId:      event.AssetID,
+			Delta:        event.Delta,
+			Balance:      event.Balance,
+			SettlementId: event.SettlementID,
+		}}
+	case services.AccountEventSettlementStatusChanged:
+		msg.Type = custodianv1.AccountEventType_ACCOUNT_EVENT_TYPE_SETTLEMENT_STATUS_CHANGED
+		msg.Detail = &custodianv1.AccountEvent_SettlementTransition{SettlementTransition: &custodianv1.SettlementTransition{
+			SettlementId: event.SettlementID,
+			Status:       event.SettlementStatus,
+			Reason:       event.Reason,
+		}}
+	case services.AccountEventHoldPlaced, services.AccountEventHoldReleased:
+		msg.Type = custodianv1.AccountEventType_ACCOUNT_EVENT_TYPE_HOLD_PLACED
+		if event.Type == services.AccountEventHoldReleased {
+			msg.Type = custodianv1.AccountEventType_ACCOUNT_EVENT_TYPE_HOLD_RELEASED
+		}
+		msg.Detail = &custodianv1.AccountEvent_HoldChange{HoldChange: &custodianv1.HoldChange{
+			HoldId:  event.HoldID,
+			AssetId: event.AssetID,
+			Amount:  event.HoldAmount,
+		}}
+	case services.AccountEventAccountStatusChanged:
+		msg.Type = custodianv1.AccountEventType_ACCOUNT_EVENT_TYPE_ACCOUNT_STATUS_CHANGED
+		msg.Detail = &custodianv1.AccountEvent_AccountStatusChange{AccountStatusChange: &custodianv1.AccountStatusChange{
+			PreviousStatus: event.PreviousStatus,
+			Status:         event.AccountStatus,
+		}}
+	}
+
+	return msg
+}
@@ -0,0 +1,318 @@
+//go:build unit
+
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	custodianv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/custodian/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	grpcserver "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/presentation/grpc"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+func TestCustodianService_SubscribeAccountEvents(t *testing.T) {
+	t.Run("streams_live_events_and_resumes_after_reconnect", func(t *testing.T) {
+		// Given: A running custodian gRPC server and client
+		client, stop := startCustodianServer(t)
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		resp, err := client.CreateAccount(ctx, &custodianv1.CreateAccountRequest{AccountType: "trading"})
+		if err != nil {
+			t.Fatalf("CreateAccount failed: %v", err)
+		}
+		accountID := resp.GetAccount().GetId()
+
+		// And: A live subscription for the account
+		streamCtx, streamCancel := context.WithCancel(ctx)
+		stream, err := client.SubscribeAccountEvents(streamCtx, &custodianv1.SubscribeAccountEventsRequest{
+			AccountIds: []string{accountID},
+		})
+		if err != nil {
+			t.Fatalf("SubscribeAccountEvents failed: %v", err)
+		}
+		if _, err := stream.Header(); err != nil {
+			t.Fatalf("Subscription was not established: %v", err)
+		}
+
+		// When: The account is funded
+		if _, err := client.Deposit(ctx, &custodianv1.DepositRequest{AccountId: accountID, AssetId: "BTC", Amount: 2}); err != nil {
+			t.Fatalf("Deposit failed: %v", err)
+		}
+
+		// Then: The balance change is pushed to the subscriber
+		event, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		if event.GetType() != custodianv1.AccountEventType_ACCOUNT_EVENT_TYPE_BALANCE_CHANGED {
+			t.Errorf("Expected balance changed event, got %v", event.GetType())
+		}
+		if event.GetBalanceChange().GetBalance() != 2 {
+			t.Errorf("Expected balance 2, got %v", event.GetBalanceChange().GetBalance())
+		}
+		lastSeen := event.GetSequence()
+
+		// When: The subscriber disconnects and more deposits happen
+		streamCancel()
+		for i := 0; i < 2; i++ {
+			if _, err := client.Deposit(ctx, &custodianv1.DepositRequest{AccountId: accountID, AssetId: "BTC", Amount: 1}); err != nil {
+				t.Fatalf("Deposit failed: %v", err)
+			}
+		}
+
+		// And: It reconnects resuming from its last sequence
+		resumed, err := client.SubscribeAccountEvents(ctx, &custodianv1.SubscribeAccountEventsRequest{
+			AccountIds:          []string{accountID},
+			ResumeAfterSequence: &lastSeen,
+		})
+		if err != nil {
+			t.Fatalf("SubscribeAccountEvents (resume) failed: %v", err)
+		}
+
+		// Then: The missed events are replayed in order
+		for _, wantBalance := range []float64{3, 4} {
+			event, err := resumed.Recv()
+			if err != nil {
+				t.Fatalf("Recv failed: %v", err)
+			}
+			if event.GetSequence() <= lastSeen {
+				t.Errorf("Expected sequence after %d, got %d", lastSeen, event.GetSequence())
+			}
+			if event.GetBalanceChange().GetBalance() != wantBalance {
+				t.Errorf("Expected balance %v, got %v", wantBalance, event.GetBalanceChange().GetBalance())
+			}
+			lastSeen = event.GetSequence()
+		}
+	})
+
+	t.Run("requires_at_least_one_account", func(t *testing.T) {
+		// Given: A running custodian gRPC server and client
+		client, stop := startCustodianServer(t)
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// When: Subscribing without accounts
+		stream, err := client.SubscribeAccountEvents(ctx, &custodianv1.SubscribeAccountEventsRequest{})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+
+		// Then: The request is rejected as invalid
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestCustodianService_SubmitSettlement(t *testing.T) {
+	t.Run("reports_failed_settlements_kept_for_retry", func(t *testing.T) {
+		// Given: A server retrying failed settlements for an hour and an account short of funds
+		client, stop := startCustodianServerWithConfig(t, &config.Config{ServiceName: "custodian-simulator", SettlementFailDeadline: time.Hour})
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		from, _ := client.CreateAccount(ctx, &custodianv1.CreateAccountRequest{AccountType: "TRADING"})
+		to, _ := client.CreateAccount(ctx, &custodianv1.CreateAccountRequest{AccountType: "TRADING"})
+
+		// When: A settlement is submitted
+		resp, err := client.SubmitSettlement(ctx, &custodianv1.SubmitSettlementRequest{
+			FromAccount: from.GetAccount().GetId(), ToAccount: to.GetAccount().GetId(), AssetId: "BTC", Amount: 1,
+		})
+
+		// Then: It is reported as failed with its deadline rather than as an error
+		if err != nil {
+			t.Fatalf("Expected a response, got %v", err)
+		}
+		if resp.GetSettlementId() == "" || resp.GetStatus() != services.SettlementStatusFailed || resp.GetFailDeadline() == nil {
+			t.Errorf("Expected a failed settlement with a fail deadline, got %+v", resp)
+		}
+	})
+
+	t.Run("settlements_submitted_on_a_holiday_wait_for_the_next_business_day", func(t *testing.T) {
+		now := time.Now().UTC()
+		if now.Hour() == 23 && now.Minute() == 59 {
+			t.Skip("too close to midnight for today's holiday to hold while submitting")
+		}
+
+		// Given: A server whose USD calendar closes today
+		cfg := &config.Config{ServiceName: "custodian-simulator", SettlementHolidays: "USD=" + now.Format(time.DateOnly)}
+		calendars, err := services.NewBusinessCalendars(cfg)
+		if err != nil {
+			t.Fatalf("NewBusinessCalendars failed: %v", err)
+		}
+		svc := services.NewCustodianService(cfg, quietLogger())
+		svc.SetBusinessCalendars(calendars)
+		client, stop := startCustodianServerWithService(t, cfg, svc)
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		from, _ := client.CreateAccount(ctx, &custodianv1.CreateAccountRequest{AccountType: "TRADING"})
+		to, _ := client.CreateAccount(ctx, &custodianv1.CreateAccountRequest{AccountType: "TRADING"})
+		if _, err := client.Deposit(ctx, &custodianv1.DepositRequest{AccountId: from.GetAccount().GetId(), AssetId: "USD", Amount: 100}); err != nil {
+			t.Fatalf("Deposit failed: %v", err)
+		}
+
+		// When: A USD settlement is submitted
+		resp, err := client.SubmitSettlement(ctx, &custodianv1.SubmitSettlementRequest{
+			FromAccount: from.GetAccount().GetId(), ToAccount: to.GetAccount().GetId(), AssetId: "USD", Amount: 10,
+		})
+		if err != nil {
+			t.Fatalf("SubmitSettlement failed: %v", err)
+		}
+
+		// Then: It is pending until the next day and nothing has moved yet
+		nextDay := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+		if resp.GetStatus() != services.SettlementStatusPending || !resp.GetSettlementDate().AsTime().Equal(nextDay) {
+			t.Errorf("Expected pending until %v, got %s until %v", nextDay, resp.GetStatus(), resp.GetSettlementDate().AsTime())
+		}
+		balance, _ := client.GetBalance(ctx, &custodianv1.GetBalanceRequest{AccountId: to.GetAccount().GetId(), AssetId: "USD"})
+		if balance.GetBalance() != 0 {
+			t.Errorf("Expected nothing settled yet, got %v", balance.GetBalance())
+		}
+	})
+
+	t.Run("settlements_without_a_maker_record_the_caller", func(t *testing.T) {
+		// Given: A running server and a client naming itself only in its metadata
+		cfg := &config.Config{ServiceName: "custodian-simulator"}
+		svc := services.NewCustodianService(cfg, quietLogger())
+		client, stop := startCustodianServerWithService(t, cfg, svc)
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-client-id", "desk-a")
+		from, _ := client.CreateAccount(ctx, &custodianv1.CreateAccountRequest{AccountType: "TRADING"})
+		to, _ := client.CreateAccount(ctx, &custodianv1.CreateAccountRequest{AccountType: "TRADING"})
+
+		// When: A settlement is submitted without requested_by
+		resp, err := client.SubmitSettlement(ctx, &custodianv1.SubmitSettlementRequest{
+			FromAccount: from.GetAccount().GetId(), ToAccount: to.GetAccount().GetId(), AssetId: "BTC", Amount: 1,
+		})
+		if err != nil {
+			t.Fatalf("SubmitSettlement failed: %v", err)
+		}
+
+		// Then: The caller is recorded as its maker, so approvers can be kept apart from it
+		settlement, err := svc.GetSettlement(context.Background(), resp.GetSettlementId())
+		if err != nil {
+			t.Fatalf("GetSettlement failed: %v", err)
+		}
+		if settlement.InitiatedBy != "desk-a" {
+			t.Errorf("Expected desk-a as the maker, got %q", settlement.InitiatedBy)
+		}
+	})
+
+	t.Run("refused_settlements_are_errors", func(t *testing.T) {
+		// Given: A running custodian gRPC server and client
+		client, stop := startCustodianServer(t)
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// When: A settlement without an amount is submitted
+		_, err := client.SubmitSettlement(ctx, &custodianv1.SubmitSettlementRequest{FromAccount: "ACC_1", ToAccount: "ACC_2", AssetId: "BTC"})
+
+		// Then: It is an error
+		if err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
+
+func TestCustodianService_ApprovalsNeedVerifiedCallers(t *testing.T) {
+	t.Run("refuses_decisions_from_unverified_callers", func(t *testing.T) {
+		// Given: A running custodian gRPC server and a client without a certificate
+		client, stop := startCustodianServer(t)
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// When: The client approves, rejects or submits a matching instruction, naming itself
+		_, approveErr := client.ApproveOperation(ctx, &custodianv1.ApproveOperationRequest{ApprovalId: "APPR_1", Approver: "bob"})
+		_, rejectErr := client.RejectOperation(ctx, &custodianv1.RejectOperationRequest{ApprovalId: "APPR_1", Approver: "bob"})
+		_, matchErr := client.SubmitMatchingInstruction(ctx, &custodianv1.SubmitMatchingInstructionRequest{SubmittedBy: "DELIVERER"})
+
+		// Then: Each is refused as unauthenticated
+		for _, err := range []error{approveErr, rejectErr, matchErr} {
+			if status.Code(err) != codes.Unauthenticated {
+				t.Errorf("Expected Unauthenticated, got %v", err)
+			}
+		}
+	})
+}
+
+func TestCustodianService_ComplianceReviewsNeedVerifiedCallersUnderTLS(t *testing.T) {
+	t.Run("refuses_reviewers_without_a_certificate", func(t *testing.T) {
+		// Given: A server configured for TLS and a client without a certificate
+		client, stop := startCustodianServerWithConfig(t, &config.Config{ServiceName: "custodian-simulator", TLSEnabled: true})
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// When: The client releases or rejects a review, naming itself
+		_, releaseErr := client.ReleaseComplianceReview(ctx, &custodianv1.ResolveComplianceReviewRequest{ReviewId: "REV_1", Reviewer: "officer-1"})
+		_, rejectErr := client.RejectComplianceReview(ctx, &custodianv1.ResolveComplianceReviewRequest{ReviewId: "REV_1", Reviewer: "officer-1"})
+
+		// Then: Each is refused as unauthenticated
+		for _, err := range []error{releaseErr, rejectErr} {
+			if status.Code(err) != codes.Unauthenticated {
+				t.Errorf("Expected Unauthenticated, got %v", err)
+			}
+		}
+	})
+}
+
+func startCustodianServer(t *testing.T) (custodianv1.CustodianServiceClient, func()) {
+	t.Helper()
+	return startCustodianServerWithConfig(t, &config.Config{ServiceName: "custodian-simulator"})
+}
+
+func startCustodianServerWithConfig(t *testing.T, cfg *config.Config) (custodianv1.CustodianServiceClient, func()) {
+	t.Helper()
+	return startCustodianServerWithService(t, cfg, services.NewCustodianService(cfg, quietLogger()))
+}
+
+func startCustodianServerWithService(t *testing.T, cfg *config.Config, svc *services.CustodianService) (custodianv1.CustodianServiceClient, func()) {
+	t.Helper()
+
+	logger := quietLogger()
+	server := grpcserver.NewCustodianGRPCServerWithDependencies(cfg, svc, logger, nil)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+
+	return custodianv1.NewCustodianServiceClient(conn), func() {
+		conn.Close()
+		server.GracefulStop()
+	}
+}
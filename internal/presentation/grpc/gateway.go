@@ -0,0 +1,542 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	custodianv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/custodian/v1"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/security"
+)
+
+// HTTPGateway serves gRPC methods as JSON over HTTP, grpc-gateway style
+// Routes, verbs and field bindings come from the google.api.http annotations in
+// the proto definitions, and requests are dispatched to the same service
+// implementation the gRPC server uses, so the two APIs cannot drift apart.
+type HTTPGateway struct {
+	routes []gatewayRoute
+	logger *logrus.Logger
+
+	// The gRPC server's interceptor chains, so HTTP calls are correlated, logged,
+	// measured and recovered like gRPC calls
+	unaryInterceptor  grpc.UnaryServerInterceptor
+	streamInterceptor grpc.StreamServerInterceptor
+
+	// Closed by Close so long-lived streaming responses end before HTTP shutdown completes
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+type gatewayRoute struct {
+	httpMethod string
+	path       string // gin syntax, e.g. /api/v1/accounts/:account_id
+	fullMethod string
+	body       string
+	input      protoreflect.MessageType
+	pathParams []string
+
+	impl   interface{}
+	unary  *grpc.MethodDesc
+	stream *grpc.StreamDesc
+}
+
+var marshalOptions = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// NewHTTPGateway builds routes for every annotated method registered on server
+// With a policy, each call is authorized for its gRPC method after the server's
+// own chain, exactly as the authorization interceptors do on the gRPC server.
+func NewHTTPGateway(server *CustodianGRPCServer, logger *logrus.Logger, policy *security.Policy) (*HTTPGateway, error) {
+	gateway := &HTTPGateway{
+		logger:            logger,
+		unaryInterceptor:  server.unaryInterceptor,
+		streamInterceptor: server.streamInterceptor,
+		done:              make(chan struct{}),
+	}
+	if policy != nil {
+		gateway.unaryInterceptor = chainUnaryInterceptors(gateway.unaryInterceptor, AuthorizationUnaryInterceptor(policy, logger))
+		gateway.streamInterceptor = chainStreamInterceptors(gateway.streamInterceptor, AuthorizationStreamInterceptor(policy, logger))
+	}
+
+	if err := gateway.addService(&custodianv1.CustodianService_ServiceDesc, server.custodianAPI); err != nil {
+		return nil, err
+	}
+
+	return gateway, nil
+}
+
+// Register adds the gateway routes to router
+func (g *HTTPGateway) Register(router gin.IRoutes) {
+	for i := range g.routes {
+		route := &g.routes[i]
+		router.Handle(route.httpMethod, route.path, func(c *gin.Context) {
+			g.serve(c, route)
+		})
+	}
+}
+
+// Routes returns "VERB /path -> /pkg.Service/Method" for each registered route
+func (g *HTTPGateway) Routes() []string {
+	routes := make([]string, 0, len(g.routes))
+	for _, route := range g.routes {
+		routes = append(routes, fmt.Sprintf("%s %s -> %s", route.httpMethod, route.path, route.fullMethod))
+	}
+	return routes
+}
+
+// Close ends all streaming responses; register it with http.Server.RegisterOnShutdown
+func (g *HTTPGateway) Close() {
+	g.closeOnce.Do(func() {
+		close(g.done)
+	})
+}
+
+func (g *HTTPGateway) addService(desc *grpc.ServiceDesc, impl interface{}) error {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(desc.ServiceName))
+	if err != nil {
+		return fmt.Errorf("service %s not found in proto registry: %w", desc.ServiceName, err)
+	}
+	service, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return fmt.Errorf("%s is not a service", desc.ServiceName)
+	}
+
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+
+		rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+
+		route, err := newGatewayRoute(desc, impl, method, rule)
+		if err != nil {
+			return err
+		}
+		g.routes = append(g.routes, route)
+	}
+
+	return nil
+}
+
+func newGatewayRoute(desc *grpc.ServiceDesc, impl interface{}, method protoreflect.MethodDescriptor, rule *annotations.HttpRule) (gatewayRoute, error) {
+	fullMethod := fmt.Sprintf("/%s/%s", desc.ServiceName, method.Name())
+
+	route := gatewayRoute{
+		fullMethod: fullMethod,
+		body:       rule.GetBody(),
+		impl:       impl,
+	}
+
+	var template string
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		route.httpMethod, template = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		route.httpMethod, template = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		route.httpMethod, template = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Patch:
+		route.httpMethod, template = http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Delete:
+		route.httpMethod, template = http.MethodDelete, pattern.Delete
+	default:
+		return gatewayRoute{}, fmt.Errorf("%s: unsupported http rule pattern", fullMethod)
+	}
+
+	if route.body != "" && route.body != "*" {
+		return gatewayRoute{}, fmt.Errorf("%s: only body \"*\" is supported, got %q", fullMethod, route.body)
+	}
+
+	input, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return gatewayRoute{}, fmt.Errorf("%s: input type not registered: %w", fullMethod, err)
+	}
+	route.input = input
+
+	// Translate {field} segments into gin :field parameters
+	segments := strings.Split(template, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name := strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+			if strings.ContainsAny(name, ".=*") {
+				return gatewayRoute{}, fmt.Errorf("%s: unsupported path template %q", fullMethod, template)
+			}
+			if method.Input().Fields().ByName(protoreflect.Name(name)) == nil {
+				return gatewayRoute{}, fmt.Errorf("%s: path field %q not in %s", fullMethod, name, method.Input().FullName())
+			}
+			route.pathParams = append(route.pathParams, name)
+			segments[i] = ":" + name
+		}
+	}
+	route.path = strings.Join(segments, "/")
+
+	name := string(method.Name())
+	if method.IsStreamingServer() {
+		if method.IsStreamingClient() {
+			return gatewayRoute{}, fmt.Errorf("%s: client streaming cannot be transcoded", fullMethod)
+		}
+		for i := range desc.Streams {
+			if desc.Streams[i].StreamName == name {
+				route.stream = &desc.Streams[i]
+			}
+		}
+	} else {
+		for i := range desc.Methods {
+			if desc.Methods[i].MethodName == name {
+				route.unary = &desc.Methods[i]
+			}
+		}
+	}
+	if route.unary == nil && route.stream == nil {
+		return gatewayRoute{}, fmt.Errorf("%s: no handler in service descriptor", fullMethod)
+	}
+
+	return route, nil
+}
+
+func (g *HTTPGateway) serve(c *gin.Context, route *gatewayRoute) {
+	req, err := route.decodeRequest(c)
+	if err != nil {
+		writeGatewayError(c, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+
+	ctx := gatewayContext(c, route.fullMethod)
+
+	if route.unary != nil {
+		dec := func(v interface{}) error {
+			proto.Merge(v.(proto.Message), req)
+			return nil
+		}
+
+		resp, err := route.unary.Handler(route.impl, ctx, dec, g.unaryInterceptor)
+		if err != nil {
+			writeGatewayError(c, err)
+			return
+		}
+
+		data, err := marshalOptions.Marshal(resp.(proto.Message))
+		if err != nil {
+			writeGatewayError(c, status.Error(codes.Internal, err.Error()))
+			return
+		}
+		c.Data(http.StatusOK, "application/json", data)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-g.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	stream := &gatewayServerStream{ctx: ctx, c: c, req: req}
+	info := &grpc.StreamServerInfo{FullMethod: route.fullMethod, IsServerStream: true}
+	if err := g.streamInterceptor(route.impl, stream, info, route.stream.Handler); err != nil {
+		if stream.started {
+			// Headers are gone; report the failure in-band like grpc-gateway does
+			stream.writeLine(gin.H{"error": gatewayErrorBody(err)})
+			return
+		}
+		writeGatewayError(c, err)
+	}
+}
+
+// decodeRequest builds the request message from path parameters, query string and body
+func (r *gatewayRoute) decodeRequest(c *gin.Context) (proto.Message, error) {
+	msg := r.input.New()
+
+	if r.body == "*" {
+		data, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read body: %w", err)
+		}
+		if len(data) > 0 {
+			if err := protojson.Unmarshal(data, msg.Interface()); err != nil {
+				return nil, fmt.Errorf("invalid JSON body: %w", err)
+			}
+		}
+	} else {
+		for key, values := range c.Request.URL.Query() {
+			if err := setField(msg, key, values); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, name := range r.pathParams {
+		if err := setField(msg, name, []string{c.Param(name)}); err != nil {
+			return nil, err
+		}
+	}
+
+	return msg.Interface(), nil
+}
+
+// setField assigns string values to a top-level scalar or repeated scalar field
+// The field may be named by its proto name or its JSON name
+func setField(msg protoreflect.Message, name string, values []string) error {
+	fields := msg.Descriptor().Fields()
+	field := fields.ByName(protoreflect.Name(name))
+	if field == nil {
+		field = fields.ByJSONName(name)
+	}
+	if field == nil {
+		return fmt.Errorf("unknown field %q", name)
+	}
+	if field.Kind() == protoreflect.MessageKind || field.IsMap() {
+		return fmt.Errorf("field %q cannot be set from a URL", name)
+	}
+
+	if field.IsList() {
+		list := msg.Mutable(field).List()
+		for _, raw := range values {
+			for _, item := range strings.Split(raw, ",") {
+				value, err := parseScalar(field, item)
+				if err != nil {
+					return err
+				}
+				list.Append(value)
+			}
+		}
+		return nil
+	}
+
+	value, err := parseScalar(field, values[len(values)-1])
+	if err != nil {
+		return err
+	}
+	msg.Set(field, value)
+	return nil
+}
+
+func parseScalar(field protoreflect.FieldDescriptor, raw string) (protoreflect.Value, error) {
+	invalid := func(err error) (protoreflect.Value, error) {
+		return protoreflect.Value{}, fmt.Errorf("invalid value %q for field %s: %w", raw, field.Name(), err)
+	}
+
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(raw), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfBool(v), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfInt32(int32(v)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfInt64(v), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfUint32(uint32(v)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfUint64(v), nil
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(raw, 32)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfFloat32(float32(v)), nil
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfFloat64(v), nil
+	case protoreflect.EnumKind:
+		if value := field.Enum().Values().ByName(protoreflect.Name(raw)); value != nil {
+			return protoreflect.ValueOfEnum(value.Number()), nil
+		}
+		v, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("field %s has unsupported type %s", field.Name(), field.Kind())
+	}
+}
+
+// gatewayContext gives the interceptor chain what a real gRPC call would see: the
+// HTTP headers as incoming metadata, the client certificate as the peer's TLS
+// state, and the method. The correlation ID is settled here so the HTTP response
+// can echo it.
+func gatewayContext(c *gin.Context, fullMethod string) context.Context {
+	md := metadata.MD{}
+	for key, values := range c.Request.Header {
+		md.Append(strings.ToLower(key), values...)
+	}
+
+	correlationID := c.GetHeader(observability.CorrelationIDKey)
+	if correlationID == "" {
+		correlationID = observability.NewCorrelationID()
+	}
+	md.Set(observability.CorrelationIDKey, correlationID)
+	c.Header(observability.CorrelationIDKey, correlationID)
+
+	p := &peer.Peer{}
+	if addr, err := net.ResolveTCPAddr("tcp", c.Request.RemoteAddr); err == nil {
+		p.Addr = addr
+	}
+	if c.Request.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *c.Request.TLS}
+	}
+
+	ctx := metadata.NewIncomingContext(c.Request.Context(), md)
+	ctx = peer.NewContext(ctx, p)
+	return grpc.NewContextWithServerTransportStream(ctx, &gatewayTransportStream{method: fullMethod})
+}
+
+func writeGatewayError(c *gin.Context, err error) {
+	c.AbortWithStatusJSON(httpStatusFromCode(status.Code(err)), gatewayErrorBody(err))
+}
+
+func gatewayErrorBody(err error) gin.H {
+	st := status.Convert(err)
+	return gin.H{
+		"code":    int(st.Code()),
+		"message": st.Message(),
+	}
+}
+
+// httpStatusFromCode follows the mapping used by grpc-gateway
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// gatewayServerStream adapts a server-streaming handler to newline-delimited JSON
+// Each message is written as {"result": ...} and flushed immediately
+type gatewayServerStream struct {
+	ctx     context.Context
+	c       *gin.Context
+	req     proto.Message
+	read    bool
+	started bool
+}
+
+func (s *gatewayServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s *gatewayServerStream) SendHeader(metadata.MD) error { s.start(); return nil }
+func (s *gatewayServerStream) SetTrailer(metadata.MD)       {}
+func (s *gatewayServerStream) Context() context.Context     { return s.ctx }
+
+func (s *gatewayServerStream) SendMsg(m interface{}) error {
+	data, err := marshalOptions.Marshal(m.(proto.Message))
+	if err != nil {
+		return err
+	}
+	return s.writeLine(map[string]json.RawMessage{"result": data})
+}
+
+func (s *gatewayServerStream) RecvMsg(m interface{}) error {
+	if s.read {
+		return io.EOF
+	}
+	s.read = true
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func (s *gatewayServerStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	s.c.Header("Content-Type", "application/x-ndjson")
+	s.c.Status(http.StatusOK)
+	s.c.Writer.WriteHeaderNow()
+	s.c.Writer.Flush()
+}
+
+func (s *gatewayServerStream) writeLine(v interface{}) error {
+	s.start()
+
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := s.c.Writer.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	s.c.Writer.Flush()
+	return nil
+}
+
+// gatewayTransportStream lets handlers call grpc.SetHeader/SendHeader outside a real transport
+type gatewayTransportStream struct {
+	method string
+}
+
+func (s *gatewayTransportStream) Method() string                  { return s.method }
+func (s *gatewayTransportStream) SetHeader(md metadata.MD) error  { return nil }
+func (s *gatewayTransportStream) SendHeader(md metadata.MD) error { return nil }
+func (s *gatewayTransportStream) SetTrailer(md metadata.MD) error { return nil }
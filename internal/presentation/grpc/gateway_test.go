@@ -0,0 +1,292 @@
+//go:build unit
+
+package grpc_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	grpcserver "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/presentation/grpc"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestHTTPGateway verifies that annotated gRPC methods are served as JSON over gin
+// Following BDD Given/When/Then pattern
+func TestHTTPGateway(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Given: A gateway over a custodian gRPC server, served by gin
+	cfg := &config.Config{ServiceName: "custodian-simulator"}
+	logger := quietLogger()
+	server := grpcserver.NewCustodianGRPCServerWithDependencies(cfg, services.NewCustodianService(cfg, logger), logger, nil)
+
+	gateway, err := grpcserver.NewHTTPGateway(server, logger, nil)
+	if err != nil {
+		t.Fatalf("NewHTTPGateway failed: %v", err)
+	}
+
+	router := gin.New()
+	gateway.Register(router)
+	httpServer := httptest.NewServer(router)
+	defer httpServer.Close()
+	defer gateway.Close()
+
+	var accountID string
+
+	t.Run("creates_account_from_json_body", func(t *testing.T) {
+		// When: An account is created over HTTP
+		var resp struct {
+			Account struct {
+				ID     string `json:"id"`
+				Status string `json:"status"`
+			} `json:"account"`
+		}
+		code := doJSON(t, http.MethodPost, httpServer.URL+"/api/v1/accounts", `{"accountType":"trading"}`, &resp)
+
+		// Then: The gRPC response is returned as JSON
+		if code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d", code)
+		}
+		if resp.Account.ID == "" || resp.Account.Status != "active" {
+			t.Errorf("Unexpected account: %+v", resp.Account)
+		}
+		accountID = resp.Account.ID
+	})
+
+	t.Run("binds_path_parameters", func(t *testing.T) {
+		// When: Funds are deposited using the account ID from the path
+		code := doJSON(t, http.MethodPost, httpServer.URL+"/api/v1/accounts/"+accountID+"/deposits", `{"assetId":"BTC","amount":1.5}`, nil)
+		if code != http.StatusOK {
+			t.Fatalf("Expected 200 for deposit, got %d", code)
+		}
+
+		// Then: The balance is readable via GET with both IDs in the path
+		var resp struct {
+			Balance   float64 `json:"balance"`
+			Available float64 `json:"available"`
+		}
+		code = doJSON(t, http.MethodGet, httpServer.URL+"/api/v1/accounts/"+accountID+"/balances/BTC", "", &resp)
+		if code != http.StatusOK {
+			t.Fatalf("Expected 200 for balance, got %d", code)
+		}
+		if resp.Balance != 1.5 || resp.Available != 1.5 {
+			t.Errorf("Expected balance 1.5, got %+v", resp)
+		}
+	})
+
+	t.Run("maps_grpc_status_to_http_status", func(t *testing.T) {
+		var resp struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		code := doJSON(t, http.MethodGet, httpServer.URL+"/api/v1/accounts/ACCT_missing/balances/BTC", "", &resp)
+
+		if code != http.StatusNotFound {
+			t.Errorf("Expected 404, got %d", code)
+		}
+		if resp.Code != 5 || resp.Message == "" {
+			t.Errorf("Expected NotFound error body, got %+v", resp)
+		}
+	})
+
+	t.Run("streams_events_as_ndjson", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// When: A client subscribes with query parameters, resuming from the start
+		url := httpServer.URL + "/api/v1/account-events?accountIds=" + accountID + "&resumeAfterSequence=0"
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Subscribe request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		// Then: The earlier deposit is replayed as a result line
+		line, err := bufio.NewReader(resp.Body).ReadString('\n')
+		if err != nil {
+			t.Fatalf("Failed to read stream: %v", err)
+		}
+
+		var event struct {
+			Result struct {
+				Type          string `json:"type"`
+				BalanceChange struct {
+					Balance float64 `json:"balance"`
+				} `json:"balanceChange"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			t.Fatalf("Invalid stream line %q: %v", line, err)
+		}
+		if event.Result.Type != "ACCOUNT_EVENT_TYPE_BALANCE_CHANGED" || event.Result.BalanceChange.Balance != 1.5 {
+			t.Errorf("Unexpected event: %s", line)
+		}
+	})
+}
+
+// TestHTTPGatewayInterceptors verifies HTTP calls run the gRPC server's interceptor chain
+// Following BDD Given/When/Then pattern
+func TestHTTPGatewayInterceptors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("recovers_panics_and_records_red_metrics", func(t *testing.T) {
+		// Given: A gateway over a server recording RED metrics, whose handlers panic
+		// for want of a custodian service
+		cfg := &config.Config{ServiceName: "custodian-simulator"}
+		logger := quietLogger()
+		metrics := &recordingMetrics{}
+		server := grpcserver.NewCustodianGRPCServerWithDependencies(cfg, nil, logger, metrics)
+		gateway, err := grpcserver.NewHTTPGateway(server, logger, nil)
+		if err != nil {
+			t.Fatalf("NewHTTPGateway failed: %v", err)
+		}
+		router := gin.New()
+		gateway.Register(router)
+		httpServer := httptest.NewServer(router)
+		defer httpServer.Close()
+		defer gateway.Close()
+
+		// When: An account is created over HTTP
+		var resp struct {
+			Code int `json:"code"`
+		}
+		code := doJSON(t, http.MethodPost, httpServer.URL+"/api/v1/accounts", `{"accountType":"trading"}`, &resp)
+
+		// Then: The panic is recovered as an internal error
+		if code != http.StatusInternalServerError || resp.Code != int(codes.Internal) {
+			t.Errorf("Expected 500 with code Internal, got %d with %+v", code, resp)
+		}
+
+		// And: The call is counted as a failed gRPC request
+		want := map[string]string{"method": "/custodian.v1.CustodianService/CreateAccount", "code": codes.Internal.String()}
+		for _, name := range []string{"grpc_requests_total", "grpc_request_errors_total"} {
+			if got := metrics.count(name, want); got != 1 {
+				t.Errorf("Expected 1 %s for %v, got %d", name, want, got)
+			}
+		}
+	})
+}
+
+// recordingMetrics counts the counter increments it receives
+type recordingMetrics struct {
+	mu       sync.Mutex
+	counters []recordedCounter
+}
+
+type recordedCounter struct {
+	name   string
+	labels map[string]string
+}
+
+func (m *recordingMetrics) IncCounter(name string, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters = append(m.counters, recordedCounter{name: name, labels: labels})
+}
+
+func (m *recordingMetrics) ObserveHistogram(string, float64, map[string]string) {}
+func (m *recordingMetrics) SetGauge(string, float64, map[string]string)         {}
+func (m *recordingMetrics) GetHTTPHandler() http.Handler                        { return http.NotFoundHandler() }
+
+func (m *recordingMetrics) count(name string, labels map[string]string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n := 0
+	for _, counter := range m.counters {
+		if counter.name == name && counter.labels["method"] == labels["method"] && counter.labels["code"] == labels["code"] {
+			n++
+		}
+	}
+	return n
+}
+
+func TestServerReflection(t *testing.T) {
+	// Given: A server with reflection enabled by config
+	cfg := &config.Config{ServiceName: "custodian-simulator", GRPCReflectionEnabled: true}
+	logger := quietLogger()
+	server := grpcserver.NewCustodianGRPCServerWithDependencies(cfg, services.NewCustodianService(cfg, logger), logger, nil)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.GracefulStop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// When: A reflection client lists services
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		t.Fatalf("ServerReflectionInfo failed: %v", err)
+	}
+	if err := stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv failed: %v", err)
+	}
+
+	// Then: The custodian service is listed
+	found := false
+	for _, svc := range resp.GetListServicesResponse().GetService() {
+		if svc.GetName() == "custodian.v1.CustodianService" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected custodian.v1.CustodianService in %v", resp.GetListServicesResponse().GetService())
+	}
+}
+
+func doJSON(t *testing.T, method, url, body string, out interface{}) int {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+	}
+	return resp.StatusCode
+}
@@ -0,0 +1,272 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/security"
+)
+
+// CorrelationUnaryInterceptor extracts the correlation ID from incoming metadata
+// (generating one if the caller did not send it), stores it in the request context
+// and echoes it back to the caller in the response header. The W3C trace ID and the
+// caller identity are stored alongside it for audit records.
+func CorrelationUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx = withIncomingRequestContext(ctx)
+		return handler(ctx, req)
+	}
+}
+
+// CorrelationStreamInterceptor is the streaming counterpart of CorrelationUnaryInterceptor
+func CorrelationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := withIncomingRequestContext(ss.Context())
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// LoggingUnaryInterceptor writes one structured log line per unary RPC
+func LoggingUnaryInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		logRequest(ctx, logger, info.FullMethod, "unary", err, time.Since(start))
+		return resp, err
+	}
+}
+
+// LoggingStreamInterceptor writes one structured log line when a stream finishes
+func LoggingStreamInterceptor(logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		logRequest(ss.Context(), logger, info.FullMethod, "stream", err, time.Since(start))
+		return err
+	}
+}
+
+// RecoveryUnaryInterceptor converts handler panics into codes.Internal errors
+// so a single faulty request cannot bring down the server
+func RecoveryUnaryInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(ctx, logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamInterceptor is the streaming counterpart of RecoveryUnaryInterceptor
+func RecoveryStreamInterceptor(logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(ss.Context(), logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+// chainUnaryInterceptors composes interceptors into one, the first outermost, as
+// grpc.ChainUnaryInterceptor does
+func chainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// chainStreamInterceptors is the streaming counterpart of chainUnaryInterceptors
+func chainStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return handler(srv, ss)
+	}
+}
+
+// connectionStatsHandler tracks transport-level connections and RPCs for GetMetrics
+type connectionStatsHandler struct {
+	server *CustodianGRPCServer
+}
+
+func (h *connectionStatsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (h *connectionStatsHandler) HandleRPC(_ context.Context, rs stats.RPCStats) {
+	if begin, ok := rs.(*stats.Begin); ok && !begin.IsClient() {
+		h.server.incrementTotalRequests()
+	}
+}
+
+func (h *connectionStatsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (h *connectionStatsHandler) HandleConn(_ context.Context, cs stats.ConnStats) {
+	if cs.IsClient() {
+		return
+	}
+
+	switch cs.(type) {
+	case *stats.ConnBegin:
+		h.server.adjustActiveConnections(1)
+	case *stats.ConnEnd:
+		h.server.adjustActiveConnections(-1)
+	}
+}
+
+// contextServerStream overrides the context of a wrapped grpc.ServerStream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func withIncomingRequestContext(ctx context.Context) context.Context {
+	ctx = withIncomingCorrelationID(ctx)
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(observability.TraceParentKey); len(values) > 0 {
+		if traceID, ok := observability.TraceIDFromTraceParent(values[0]); ok {
+			ctx = observability.WithTraceID(ctx, traceID)
+		}
+	}
+
+	actor := observability.Actor{ID: "anonymous"}
+	if identity, ok := security.IdentityFromPeer(ctx); ok {
+		actor = observability.Actor{ID: identity, Verified: true}
+	} else if values := md.Get(observability.ClientIDKey); len(values) > 0 && values[0] != "" {
+		actor = observability.Actor{ID: values[0]}
+	}
+	return observability.WithActor(ctx, actor)
+}
+
+func withIncomingCorrelationID(ctx context.Context) context.Context {
+	correlationID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(observability.CorrelationIDKey); len(values) > 0 {
+			correlationID = values[0]
+		}
+	}
+
+	if correlationID == "" {
+		correlationID = observability.NewCorrelationID()
+	}
+
+	// Best effort: headers may already have been sent for some streams
+	_ = grpc.SetHeader(ctx, metadata.Pairs(observability.CorrelationIDKey, correlationID))
+
+	return observability.WithCorrelationID(ctx, correlationID)
+}
+
+func logRequest(ctx context.Context, logger *logrus.Logger, fullMethod, rpcType string, err error, duration time.Duration) {
+	code := status.Code(err)
+
+	fields := logrus.Fields{
+		"grpc_method":    fullMethod,
+		"grpc_type":      rpcType,
+		"grpc_code":      code.String(),
+		"duration_ms":    float64(duration.Microseconds()) / 1000,
+		"correlation_id": observability.CorrelationIDFromContext(ctx),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields["peer"] = p.Addr.String()
+	}
+
+	entry := logger.WithFields(fields)
+
+	switch code {
+	case codes.OK:
+		entry.Info("gRPC request completed")
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		entry.WithError(err).Error("gRPC request failed")
+	default:
+		entry.WithError(err).Warn("gRPC request failed")
+	}
+}
+
+func recoverPanic(ctx context.Context, logger *logrus.Logger, fullMethod string, r interface{}) error {
+	logger.WithFields(logrus.Fields{
+		"grpc_method":    fullMethod,
+		"correlation_id": observability.CorrelationIDFromContext(ctx),
+		"panic":          fmt.Sprintf("%v", r),
+		"stack":          string(debug.Stack()),
+	}).Error("Recovered from panic in gRPC handler")
+
+	return status.Errorf(codes.Internal, "internal error")
+}
@@ -0,0 +1,171 @@
+//go:build unit
+
+package grpc_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
+	grpcserver "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/presentation/grpc"
+)
+
+func TestRecoveryUnaryInterceptor(t *testing.T) {
+	t.Run("converts_panics_to_internal_errors", func(t *testing.T) {
+		// Given: A recovery interceptor
+		interceptor := grpcserver.RecoveryUnaryInterceptor(quietLogger())
+		info := &grpc.UnaryServerInfo{FullMethod: "/custodian.v1.CustodianService/Transfer"}
+
+		// And: A handler that panics
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			panic("boom")
+		}
+
+		// When: The RPC is handled
+		_, err := interceptor(context.Background(), nil, info, handler)
+
+		// Then: The panic should surface as codes.Internal
+		if status.Code(err) != codes.Internal {
+			t.Errorf("Expected codes.Internal, got %v", status.Code(err))
+		}
+	})
+}
+
+func TestRecoveryStreamInterceptor(t *testing.T) {
+	t.Run("converts_stream_panics_to_internal_errors", func(t *testing.T) {
+		// Given: A recovery interceptor for streams
+		interceptor := grpcserver.RecoveryStreamInterceptor(quietLogger())
+		info := &grpc.StreamServerInfo{FullMethod: "/custodian.v1.CustodianService/Subscribe"}
+
+		// And: A stream handler that panics
+		handler := func(srv interface{}, ss grpc.ServerStream) error {
+			panic("boom")
+		}
+
+		// When: The stream is handled
+		err := interceptor(nil, &fakeServerStream{ctx: context.Background()}, info, handler)
+
+		// Then: The panic should surface as codes.Internal
+		if status.Code(err) != codes.Internal {
+			t.Errorf("Expected codes.Internal, got %v", status.Code(err))
+		}
+	})
+}
+
+func TestCorrelationUnaryInterceptor(t *testing.T) {
+	t.Run("propagates_incoming_correlation_id", func(t *testing.T) {
+		// Given: An incoming request carrying a correlation ID
+		ctx := metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs(observability.CorrelationIDKey, "settlement-xyz789"))
+		interceptor := grpcserver.CorrelationUnaryInterceptor()
+		info := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+
+		// When: The RPC is handled
+		var seen string
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			seen = observability.CorrelationIDFromContext(ctx)
+			return nil, nil
+		}
+		_, _ = interceptor(ctx, nil, info, handler)
+
+		// Then: The handler should see the caller's correlation ID
+		if seen != "settlement-xyz789" {
+			t.Errorf("Expected correlation ID settlement-xyz789, got %q", seen)
+		}
+	})
+
+	t.Run("generates_correlation_id_when_missing", func(t *testing.T) {
+		// Given: An incoming request without a correlation ID
+		interceptor := grpcserver.CorrelationUnaryInterceptor()
+		info := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+
+		// When: The RPC is handled
+		var seen string
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			seen = observability.CorrelationIDFromContext(ctx)
+			return nil, nil
+		}
+		_, _ = interceptor(context.Background(), nil, info, handler)
+
+		// Then: A correlation ID should have been generated
+		if seen == "" {
+			t.Error("Expected a generated correlation ID")
+		}
+	})
+
+	t.Run("propagates_trace_id_and_self_reported_actor", func(t *testing.T) {
+		// Given: A plaintext request carrying a traceparent and a client ID
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			observability.TraceParentKey, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			observability.ClientIDKey, "exchange-simulator",
+		))
+		interceptor := grpcserver.CorrelationUnaryInterceptor()
+		info := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+
+		// When: The RPC is handled
+		var traceID string
+		var actor observability.Actor
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			traceID = observability.TraceIDFromContext(ctx)
+			actor = observability.ActorFromContext(ctx)
+			return nil, nil
+		}
+		_, _ = interceptor(ctx, nil, info, handler)
+
+		// Then: The trace ID is extracted and the actor is recorded as unverified
+		if traceID != "4bf92f3577b34da6a3ce929d0e0e4736" {
+			t.Errorf("Expected trace ID from traceparent, got %q", traceID)
+		}
+		if actor.ID != "exchange-simulator" || actor.Verified {
+			t.Errorf("Expected unverified actor exchange-simulator, got %+v", actor)
+		}
+	})
+
+	t.Run("defaults_to_anonymous_actor", func(t *testing.T) {
+		// Given: A request with a malformed traceparent and no client identity
+		ctx := metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs(observability.TraceParentKey, "not-a-traceparent"))
+		interceptor := grpcserver.CorrelationUnaryInterceptor()
+		info := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+
+		// When: The RPC is handled
+		var traceID string
+		var actor observability.Actor
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			traceID = observability.TraceIDFromContext(ctx)
+			actor = observability.ActorFromContext(ctx)
+			return nil, nil
+		}
+		_, _ = interceptor(ctx, nil, info, handler)
+
+		// Then: No trace ID is recorded and the actor is anonymous
+		if traceID != "" {
+			t.Errorf("Expected no trace ID, got %q", traceID)
+		}
+		if actor.ID != "anonymous" || actor.Verified {
+			t.Errorf("Expected anonymous actor, got %+v", actor)
+		}
+	})
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func quietLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
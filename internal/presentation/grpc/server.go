@@ -10,21 +10,47 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
+	custodianv1 "github.com/quantfidential/trading-ecosystem/custodian-simulator-go/api/custodian/v1"
 	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/infrastructure/observability"
 	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
 )
 
+// defaultReadinessInterval is used when the config does not specify a health check interval
+const defaultReadinessInterval = 5 * time.Second
+
+// ReadinessCheck reports whether a dependency the server needs is usable; a non-nil
+// error takes the server out of rotation until the check passes again
+type ReadinessCheck func(ctx context.Context) error
+
+type namedReadinessCheck struct {
+	name  string
+	check ReadinessCheck
+}
+
 type CustodianGRPCServer struct {
 	config            *config.Config
 	server            *grpc.Server
 	healthSrv         *health.Server
 	custodianSvc      *services.CustodianService
+	custodianAPI      *custodianServiceServer
+	unaryInterceptor  grpc.UnaryServerInterceptor
+	streamInterceptor grpc.StreamServerInterceptor
+	metricsPort       ports.MetricsPort
 	logger            *logrus.Logger
 	startTime         time.Time
 	activeConnections int64
 	totalRequests     int64
+	serving           bool
+	readinessChecks   []namedReadinessCheck
 	mutex             sync.RWMutex
+
+	// Closed on shutdown to stop readiness monitoring and end subscription streams
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
 }
 
 type ServerMetrics struct {
@@ -34,33 +60,74 @@ type ServerMetrics struct {
 	Uptime            time.Duration     `json:"uptime"`
 }
 
+// NewCustodianGRPCServer creates a standalone gRPC server with its own logger and CustodianService
+// Prefer NewCustodianGRPCServerWithDependencies when the service is shared with other transports
 func NewCustodianGRPCServer(cfg *config.Config) *CustodianGRPCServer {
 	logger := logrus.New()
 	logger.SetLevel(getLogLevel(cfg.LogLevel))
 
-	server := grpc.NewServer(
-		grpc.UnaryInterceptor(requestMetricsInterceptor),
-	)
+	custodianSvc := services.NewCustodianService(cfg, logger)
 
-	healthSrv := health.NewServer()
-	grpc_health_v1.RegisterHealthServer(server, healthSrv)
+	return NewCustodianGRPCServerWithDependencies(cfg, custodianSvc, logger, cfg.GetMetricsPort())
+}
 
-	custodianSvc := services.NewCustodianService(cfg, logger)
+// NewCustodianGRPCServerWithDependencies creates a gRPC server around an existing CustodianService
+// metricsPort may be nil, in which case RED metrics are not recorded.
+// opts are appended to the server's own options, e.g. transport credentials and
+// authorization interceptors (which then run after the built-in chain)
+func NewCustodianGRPCServerWithDependencies(
+	cfg *config.Config,
+	custodianSvc *services.CustodianService,
+	logger *logrus.Logger,
+	metricsPort ports.MetricsPort,
+	opts ...grpc.ServerOption,
+) *CustodianGRPCServer {
+	healthSrv := health.NewServer()
 
 	grpcServer := &CustodianGRPCServer{
 		config:       cfg,
-		server:       server,
 		healthSrv:    healthSrv,
 		custodianSvc: custodianSvc,
+		metricsPort:  metricsPort,
 		logger:       logger,
 		startTime:    time.Now(),
+		shutdown:     make(chan struct{}),
+	}
+
+	// Interceptor order: correlation first so every later stage can log it,
+	// recovery last so panics surface as codes.Internal to logging and metrics.
+	// The HTTP gateway runs the same chains.
+	grpcServer.unaryInterceptor = chainUnaryInterceptors(
+		CorrelationUnaryInterceptor(),
+		LoggingUnaryInterceptor(logger),
+		observability.REDMetricsUnaryInterceptor(metricsPort),
+		RecoveryUnaryInterceptor(logger),
+	)
+	grpcServer.streamInterceptor = chainStreamInterceptors(
+		CorrelationStreamInterceptor(),
+		LoggingStreamInterceptor(logger),
+		observability.REDMetricsStreamInterceptor(metricsPort),
+		RecoveryStreamInterceptor(logger),
+	)
+	serverOpts := []grpc.ServerOption{
+		grpc.StatsHandler(&connectionStatsHandler{server: grpcServer}),
+		grpc.ChainUnaryInterceptor(grpcServer.unaryInterceptor),
+		grpc.ChainStreamInterceptor(grpcServer.streamInterceptor),
+	}
+	server := grpc.NewServer(append(serverOpts, opts...)...)
+	grpcServer.server = server
+
+	grpc_health_v1.RegisterHealthServer(server, healthSrv)
+	grpcServer.custodianAPI = newCustodianServiceServer(custodianSvc, logger, cfg.TLSEnabled, grpcServer.shutdown)
+	custodianv1.RegisterCustodianServiceServer(server, grpcServer.custodianAPI)
+
+	if cfg.GRPCReflectionEnabled {
+		reflection.Register(server)
+		logger.Info("gRPC server reflection enabled")
 	}
 
-	// Set health status for services
-	healthSrv.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
-	healthSrv.SetServingStatus(cfg.ServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
-	healthSrv.SetServingStatus("custodian", grpc_health_v1.HealthCheckResponse_SERVING)
-	healthSrv.SetServingStatus("settlement", grpc_health_v1.HealthCheckResponse_SERVING)
+	// Not serving until Serve is called and the custodian service reports ready
+	grpcServer.setServingStatus(false)
 
 	logger.WithFields(logrus.Fields{
 		"service": cfg.ServiceName,
@@ -73,47 +140,183 @@ func NewCustodianGRPCServer(cfg *config.Config) *CustodianGRPCServer {
 
 func (s *CustodianGRPCServer) Serve(lis net.Listener) error {
 	s.logger.WithField("address", lis.Addr().String()).Info("Starting custodian gRPC server")
+
+	go s.monitorReadiness()
+
 	return s.server.Serve(lis)
 }
 
+// GracefulStop waits for all in-flight RPCs and streams to finish
 func (s *CustodianGRPCServer) GracefulStop() {
 	s.logger.Info("Gracefully stopping custodian gRPC server")
 
-	// Set health status to NOT_SERVING before shutdown
-	s.healthSrv.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
-	s.healthSrv.SetServingStatus(s.config.ServiceName, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
-	s.healthSrv.SetServingStatus("custodian", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
-	s.healthSrv.SetServingStatus("settlement", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	s.markShuttingDown()
 
 	s.server.GracefulStop()
 	s.logger.Info("Custodian gRPC server stopped")
 }
 
+// Shutdown stops the server gracefully, forcing it closed if ctx expires first
+// Returns ctx.Err() when in-flight RPCs had to be cancelled
+func (s *CustodianGRPCServer) Shutdown(ctx context.Context) error {
+	s.logger.Info("Gracefully stopping custodian gRPC server")
+
+	s.markShuttingDown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		s.logger.Info("Custodian gRPC server stopped")
+		return nil
+	case <-ctx.Done():
+		s.logger.WithError(ctx.Err()).Warn("Graceful stop timed out, forcing custodian gRPC server to stop")
+		s.server.Stop()
+		<-stopped
+		return ctx.Err()
+	}
+}
+
 func (s *CustodianGRPCServer) GetMetrics() ServerMetrics {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
+	status := "not_serving"
+	if s.serving {
+		status = "serving"
+	}
+
 	return ServerMetrics{
 		ActiveConnections: s.activeConnections,
 		TotalRequests:     s.totalRequests,
 		ServiceStatus: map[string]string{
-			"custodian":  "serving",
-			"settlement": "serving",
+			"custodian":  status,
+			"settlement": status,
 			"health":     "serving",
 		},
 		Uptime: time.Since(s.startTime),
 	}
 }
 
-func requestMetricsInterceptor(
-	ctx context.Context,
-	req interface{},
-	info *grpc.UnaryServerInfo,
-	handler grpc.UnaryHandler,
-) (interface{}, error) {
-	// This would be enhanced to track actual metrics
-	// For now, it's a placeholder that satisfies the interface
-	return handler(ctx, req)
+// AddReadinessCheck makes readiness also depend on check, e.g. the data adapter, the
+// event stream's Redis or the settlement scheduler. Add checks before Serve.
+func (s *CustodianGRPCServer) AddReadinessCheck(name string, check ReadinessCheck) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.readinessChecks = append(s.readinessChecks, namedReadinessCheck{name: name, check: check})
+}
+
+// monitorReadiness keeps the health service in line with the custodian service
+// readiness and the registered readiness checks
+func (s *CustodianGRPCServer) monitorReadiness() {
+	interval := s.config.HealthCheckInterval
+	if interval <= 0 {
+		interval = defaultReadinessInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	s.checkReadiness()
+
+	for {
+		select {
+		case <-s.shutdown:
+			return
+		case <-ticker.C:
+			s.checkReadiness()
+		}
+	}
+}
+
+func (s *CustodianGRPCServer) checkReadiness() {
+	timeout := s.config.RequestTimeout
+	if timeout <= 0 {
+		timeout = defaultReadinessInterval
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	status, err := s.custodianSvc.GetHealth(ctx)
+	if err != nil {
+		s.logger.WithError(err).Warn("Custodian service readiness check failed")
+	}
+
+	// "starting" is still able to accept requests; only failures take the service out of rotation
+	ready := err == nil && status != "unhealthy"
+
+	s.mutex.RLock()
+	checks := s.readinessChecks
+	s.mutex.RUnlock()
+
+	for _, check := range checks {
+		if err := check.check(ctx); err != nil {
+			s.logger.WithError(err).WithField("check", check.name).Warn("Readiness check failed")
+			ready = false
+		}
+	}
+
+	s.setServingStatus(ready)
+}
+
+func (s *CustodianGRPCServer) setServingStatus(serving bool) {
+	s.mutex.Lock()
+	changed := s.serving != serving
+	s.serving = serving
+	s.mutex.Unlock()
+
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+
+	for _, name := range s.healthServiceNames() {
+		s.healthSrv.SetServingStatus(name, status)
+	}
+
+	if changed {
+		s.logger.WithField("status", status.String()).Info("Custodian gRPC health status changed")
+	}
+}
+
+// markShuttingDown stops readiness monitoring, ends subscription streams and permanently reports NOT_SERVING
+func (s *CustodianGRPCServer) markShuttingDown() {
+	s.shutdownOnce.Do(func() {
+		close(s.shutdown)
+	})
+
+	s.mutex.Lock()
+	s.serving = false
+	s.mutex.Unlock()
+
+	// Shutdown sets every service to NOT_SERVING and ignores later updates
+	s.healthSrv.Shutdown()
+}
+
+func (s *CustodianGRPCServer) healthServiceNames() []string {
+	return []string{"", s.config.ServiceName, "custodian", "settlement"}
+}
+
+func (s *CustodianGRPCServer) incrementTotalRequests() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.totalRequests++
+}
+
+func (s *CustodianGRPCServer) adjustActiveConnections(delta int64) {
+	s.mutex.Lock()
+	s.activeConnections += delta
+	active := s.activeConnections
+	s.mutex.Unlock()
+
+	if s.metricsPort != nil {
+		s.metricsPort.SetGauge("grpc_active_connections", float64(active), map[string]string{})
+	}
 }
 
 func getLogLevel(level string) logrus.Level {
@@ -129,4 +332,4 @@ func getLogLevel(level string) logrus.Level {
 	default:
 		return logrus.InfoLevel
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,485 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Operations that can require approval
+const (
+	ApprovalOperationTransfer   = "transfer"
+	ApprovalOperationWithdrawal = "withdrawal"
+)
+
+// Approval request statuses
+const (
+	ApprovalStatusPending   = "pending"
+	ApprovalStatusApproved  = "approved"
+	ApprovalStatusRejected  = "rejected"
+	ApprovalStatusExpired   = "expired"
+	ApprovalStatusCancelled = "cancelled" // The operation was withdrawn while it waited
+)
+
+// ApprovalPolicy decides which operations need an N-of-M approver quorum. An
+// operation qualifies when its amount reaches the threshold for its account and
+// asset; accounts may override the thresholds and the quorum.
+type ApprovalPolicy struct {
+	Approvers  []string
+	Quorum     int
+	Thresholds map[string]float64 // Asset -> amount; "default" applies to other assets
+	Expiry     time.Duration      // Pending requests expire after this; 0 never expires
+
+	accountQuorums    map[string]int
+	accountThresholds map[string]map[string]float64 // Account -> asset -> amount
+}
+
+// ApprovalDecision records one approver's approval or rejection
+type ApprovalDecision struct {
+	Approver string    `json:"approver"`
+	Approved bool      `json:"approved"`
+	Reason   string    `json:"reason,omitempty"`
+	At       time.Time `json:"at"`
+}
+
+// ApprovalRequest holds a qualifying operation until its quorum approves it, one
+// approver rejects it or it expires
+type ApprovalRequest struct {
+	ID          string             `json:"id"`
+	Operation   string             `json:"operation"`
+	ResourceID  string             `json:"resource_id"` // Settlement or withdrawal ID
+	AccountID   string             `json:"account_id"`
+	AssetID     string             `json:"asset_id"`
+	Amount      float64            `json:"amount"`
+	RequestedBy string             `json:"requested_by,omitempty"` // The maker, who cannot approve
+	Quorum      int                `json:"quorum"`
+	Approvers   []string           `json:"approvers"` // Eligible approvers
+	Decisions   []ApprovalDecision `json:"decisions,omitempty"`
+	Status      string             `json:"status"`
+	CreatedAt   time.Time          `json:"created_at"`
+	ExpiresAt   *time.Time         `json:"expires_at,omitempty"`
+	ResolvedAt  *time.Time         `json:"resolved_at,omitempty"`
+}
+
+// NewApprovalPolicy builds the approval policy in cfg, or returns nil when no
+// approvers are configured
+func NewApprovalPolicy(cfg *config.Config) (*ApprovalPolicy, error) {
+	var approvers []string
+	for _, approver := range strings.Split(cfg.ApprovalApprovers, ",") {
+		if approver = strings.TrimSpace(approver); approver != "" {
+			approvers = append(approvers, approver)
+		}
+	}
+	if len(approvers) == 0 {
+		return nil, nil
+	}
+
+	policy := &ApprovalPolicy{
+		Approvers:         approvers,
+		Quorum:            cfg.ApprovalQuorum,
+		Expiry:            cfg.ApprovalExpiry,
+		accountQuorums:    make(map[string]int),
+		accountThresholds: make(map[string]map[string]float64),
+	}
+	if err := validateQuorum(policy.Quorum, len(approvers)); err != nil {
+		return nil, err
+	}
+
+	thresholds, err := parseThresholds(cfg.ApprovalThresholds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid approval thresholds: %w", err)
+	}
+	policy.Thresholds = thresholds
+
+	quorums, err := parsePairs(cfg.ApprovalAccountQuorums)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account approval quorums: %w", err)
+	}
+	for account, value := range quorums {
+		quorum, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid approval quorum %q for %s", value, account)
+		}
+		if err := validateQuorum(quorum, len(approvers)); err != nil {
+			return nil, fmt.Errorf("account %s: %w", account, err)
+		}
+		policy.accountQuorums[account] = quorum
+	}
+
+	accountThresholds, err := parseThresholds(cfg.ApprovalAccountThresholds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account approval thresholds: %w", err)
+	}
+	for key, amount := range accountThresholds {
+		account, asset, ok := strings.Cut(key, ":")
+		if !ok || account == "" || asset == "" {
+			return nil, fmt.Errorf("invalid account approval threshold %q, expected ACCOUNT:ASSET=amount", key)
+		}
+		if policy.accountThresholds[account] == nil {
+			policy.accountThresholds[account] = make(map[string]float64)
+		}
+		policy.accountThresholds[account][asset] = amount
+	}
+
+	return policy, nil
+}
+
+// Requirement returns the quorum an operation needs, or 0 when it needs no approval
+func (p *ApprovalPolicy) Requirement(accountID, assetID string, amount float64) int {
+	if p == nil {
+		return 0
+	}
+
+	threshold, ok := lookupThreshold(p.accountThresholds[accountID], assetID)
+	if !ok {
+		threshold, ok = lookupThreshold(p.Thresholds, assetID)
+	}
+	if !ok || amount < threshold {
+		return 0
+	}
+
+	if quorum, ok := p.accountQuorums[accountID]; ok {
+		return quorum
+	}
+	return p.Quorum
+}
+
+// SetApprovalPolicy registers the policy deciding which transfers and withdrawals
+// need approval; nil disables approvals
+func (s *CustodianService) SetApprovalPolicy(policy *ApprovalPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.approvalPolicy = policy
+}
+
+// GetApprovalRequest returns a copy of an approval request by ID
+func (s *CustodianService) GetApprovalRequest(ctx context.Context, approvalID string) (*ApprovalRequest, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	request, exists := s.approvals[approvalID]
+	if !exists {
+		return nil, fmt.Errorf("approval request %s %w", approvalID, ErrNotFound)
+	}
+
+	result := *request
+	return &result, nil
+}
+
+// ListApprovalRequests returns approval requests oldest first, filtered by status
+// unless status is empty
+func (s *CustodianService) ListApprovalRequests(ctx context.Context, status string) []ApprovalRequest {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	list := make([]ApprovalRequest, 0, len(s.approvals))
+	for _, request := range s.approvals {
+		if status == "" || request.Status == status {
+			list = append(list, *request)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
+
+// ApproveOperation records an approver's approval. Once the quorum is reached the
+// operation is carried out and its outcome recorded on the settlement or withdrawal.
+// Operations without a recorded maker cannot be approved, only rejected.
+func (s *CustodianService) ApproveOperation(ctx context.Context, approvalID, approver string) (*ApprovalRequest, error) {
+	return s.decideApproval(ctx, approvalID, ApprovalDecision{Approver: approver, Approved: true})
+}
+
+// RejectOperation records an approver's rejection, which cancels the operation
+func (s *CustodianService) RejectOperation(ctx context.Context, approvalID, approver, reason string) (*ApprovalRequest, error) {
+	return s.decideApproval(ctx, approvalID, ApprovalDecision{Approver: approver, Reason: reason})
+}
+
+// ExpireApprovalRequests expires pending requests past their expiry and returns
+// how many expired
+func (s *CustodianService) ExpireApprovalRequests(ctx context.Context, now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expired := 0
+	for _, request := range s.approvals {
+		if request.Status == ApprovalStatusPending && request.ExpiresAt != nil && now.After(*request.ExpiresAt) {
+			s.resolveApprovalLocked(ctx, request, ApprovalStatusExpired, now, "approval expired")
+			expired++
+		}
+	}
+	return expired
+}
+
+func (s *CustodianService) decideApproval(ctx context.Context, approvalID string, decision ApprovalDecision) (*ApprovalRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	request, exists := s.approvals[approvalID]
+	if !exists {
+		return nil, fmt.Errorf("approval request %s %w", approvalID, ErrNotFound)
+	}
+
+	now := time.Now()
+	if request.Status == ApprovalStatusPending && request.ExpiresAt != nil && now.After(*request.ExpiresAt) {
+		s.resolveApprovalLocked(ctx, request, ApprovalStatusExpired, now, "approval expired")
+	}
+	if request.Status != ApprovalStatusPending {
+		return nil, fmt.Errorf("%w: approval request %s is %s", ErrInvalidRequest, approvalID, request.Status)
+	}
+	if decision.Approver == request.RequestedBy {
+		return nil, fmt.Errorf("%w: %s requested the operation and cannot also approve it", ErrInvalidRequest, decision.Approver)
+	}
+	if !containsString(request.Approvers, decision.Approver) {
+		return nil, fmt.Errorf("%w: %q is not an approver for %s", ErrInvalidRequest, decision.Approver, approvalID)
+	}
+	if decision.Approved && request.RequestedBy == "" {
+		return nil, fmt.Errorf("%w: approval request %s records no maker to keep apart from its approvers; it can only be rejected", ErrInvalidRequest, approvalID)
+	}
+	for _, previous := range request.Decisions {
+		if previous.Approver == decision.Approver {
+			return nil, fmt.Errorf("%w: %s has already decided on %s", ErrAlreadyExists, decision.Approver, approvalID)
+		}
+	}
+
+	decision.At = now
+	decisions := make([]ApprovalDecision, len(request.Decisions), len(request.Decisions)+1)
+	copy(decisions, request.Decisions)
+	request.Decisions = append(decisions, decision)
+
+	event := approvalAuditEvent(request, "approval.decide")
+	event.Details["approver"] = decision.Approver
+	event.Details["approved"] = strconv.FormatBool(decision.Approved)
+	s.recordAudit(ctx, event)
+
+	switch {
+	case !decision.Approved:
+		reason := "rejected by " + decision.Approver
+		if decision.Reason != "" {
+			reason += ": " + decision.Reason
+		}
+		s.resolveApprovalLocked(ctx, request, ApprovalStatusRejected, now, reason)
+	case approvalCount(request) >= request.Quorum:
+		s.resolveApprovalLocked(ctx, request, ApprovalStatusApproved, now, "")
+	}
+
+	result := *request
+	return &result, nil
+}
+
+// checkApprovalReachableLocked refuses an operation the policy would hold for a
+// quorum its approvers cannot reach without the maker, as when the maker is one of
+// the approvers on an M-of-M policy
+func (s *CustodianService) checkApprovalReachableLocked(accountID, assetID string, amount float64, requestedBy string) error {
+	quorum := s.approvalPolicy.Requirement(accountID, assetID, amount)
+	if eligible := len(s.approvalPolicy.eligibleApprovers(requestedBy)); quorum > eligible {
+		return fmt.Errorf("%w: the approval quorum of %d cannot be reached by the %d approvers other than %s", ErrInvalidRequest, quorum, eligible, requestedBy)
+	}
+	return nil
+}
+
+// requireApprovalLocked opens an approval request when the policy requires one for
+// the operation, returning nil when the operation may proceed now
+func (s *CustodianService) requireApprovalLocked(ctx context.Context, operation, resourceID, accountID, assetID string, amount float64, requestedBy string) *ApprovalRequest {
+	quorum := s.approvalPolicy.Requirement(accountID, assetID, amount)
+	if quorum == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	request := &ApprovalRequest{
+		ID:          generateApprovalID(),
+		Operation:   operation,
+		ResourceID:  resourceID,
+		AccountID:   accountID,
+		AssetID:     assetID,
+		Amount:      amount,
+		RequestedBy: requestedBy,
+		Quorum:      quorum,
+		Approvers:   s.approvalPolicy.eligibleApprovers(requestedBy),
+		Status:      ApprovalStatusPending,
+		CreatedAt:   now,
+	}
+	if s.approvalPolicy.Expiry > 0 {
+		expiresAt := now.Add(s.approvalPolicy.Expiry)
+		request.ExpiresAt = &expiresAt
+	}
+	s.approvals[request.ID] = request
+
+	s.recordAudit(ctx, approvalAuditEvent(request, "approval.request"))
+	s.logger.WithFields(logrus.Fields{
+		"approval_id": request.ID,
+		"operation":   operation,
+		"resource_id": resourceID,
+		"quorum":      quorum,
+	}).Info("Operation awaiting approval")
+
+	return request
+}
+
+// resolveApprovalLocked closes a request and carries out or abandons its operation
+func (s *CustodianService) resolveApprovalLocked(ctx context.Context, request *ApprovalRequest, status string, now time.Time, reason string) {
+	request.Status = status
+	request.ResolvedAt = &now
+
+	event := approvalAuditEvent(request, "approval.resolve")
+	if reason != "" {
+		event.Details["reason"] = reason
+	}
+	s.recordAudit(ctx, event)
+
+	switch request.Operation {
+	case ApprovalOperationTransfer:
+		if settlement, exists := s.settlements[request.ResourceID]; exists {
+			s.resolveSettlementApprovalLocked(ctx, settlement, status, reason)
+		}
+	case ApprovalOperationWithdrawal:
+		if withdrawal, exists := s.withdrawals[request.ResourceID]; exists {
+			s.resolveWithdrawalApprovalLocked(ctx, withdrawal, status, reason)
+		}
+	}
+}
+
+func (s *CustodianService) resolveSettlementApprovalLocked(ctx context.Context, settlement *Settlement, status, reason string) {
+	if settlement.Status != SettlementStatusPendingApproval {
+		return
+	}
+
+	switch status {
+	case ApprovalStatusApproved:
+		settlement.Status = SettlementStatusPending
+		s.publishSettlementTransition(ctx, settlement, "")
+		if !settlement.SettlementDate.After(time.Now()) {
+			// The outcome is recorded on the settlement
+			_ = s.processSettlementLocked(ctx, settlement)
+		}
+	case ApprovalStatusRejected:
+		settlement.Status = SettlementStatusRejected
+		settlement.Reason = reason
+		s.publishSettlementTransition(ctx, settlement, reason)
+	case ApprovalStatusExpired:
+		settlement.Status = SettlementStatusExpired
+		settlement.Reason = reason
+		s.publishSettlementTransition(ctx, settlement, reason)
+	}
+}
+
+func approvalCount(request *ApprovalRequest) int {
+	count := 0
+	for _, decision := range request.Decisions {
+		if decision.Approved {
+			count++
+		}
+	}
+	return count
+}
+
+func approvalAuditEvent(request *ApprovalRequest, action string) ports.AuditEvent {
+	details := map[string]string{
+		"operation":    request.Operation,
+		"resource_id":  request.ResourceID,
+		"account_id":   request.AccountID,
+		"asset_id":     request.AssetID,
+		"amount":       formatAmount(request.Amount),
+		"quorum":       strconv.Itoa(request.Quorum),
+		"approvals":    strconv.Itoa(approvalCount(request)),
+		"status":       request.Status,
+		"requested_by": request.RequestedBy,
+	}
+	var approvers []string
+	for _, decision := range request.Decisions {
+		if decision.Approved {
+			approvers = append(approvers, decision.Approver)
+		}
+	}
+	if len(approvers) > 0 {
+		details["approved_by"] = strings.Join(approvers, ",")
+	}
+
+	return ports.AuditEvent{
+		Action:       action,
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "approval",
+		ResourceID:   request.ID,
+		Details:      details,
+	}
+}
+
+// parseThresholds parses "KEY=amount;..." into non-negative amounts
+func parseThresholds(spec string) (map[string]float64, error) {
+	pairs, err := parsePairs(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholds := make(map[string]float64, len(pairs))
+	for key, value := range pairs {
+		amount, err := strconv.ParseFloat(value, 64)
+		if err != nil || amount < 0 {
+			return nil, fmt.Errorf("invalid amount %q for %s", value, key)
+		}
+		thresholds[key] = amount
+	}
+	return thresholds, nil
+}
+
+func lookupThreshold(thresholds map[string]float64, assetID string) (float64, bool) {
+	if threshold, ok := thresholds[assetID]; ok {
+		return threshold, true
+	}
+	threshold, ok := thresholds["default"]
+	return threshold, ok
+}
+
+// eligibleApprovers returns the approvers who may decide on an operation made by
+// requestedBy: all of them, less the maker when the maker is one of them
+func (p *ApprovalPolicy) eligibleApprovers(requestedBy string) []string {
+	if p == nil {
+		return nil
+	}
+	if !containsString(p.Approvers, requestedBy) {
+		return append([]string(nil), p.Approvers...)
+	}
+
+	eligible := make([]string, 0, len(p.Approvers)-1)
+	for _, approver := range p.Approvers {
+		if approver != requestedBy {
+			eligible = append(eligible, approver)
+		}
+	}
+	return eligible
+}
+
+// validateQuorum keeps a quorum reachable by the configured approvers
+func validateQuorum(quorum, approvers int) error {
+	if quorum < 1 || quorum > approvers {
+		return fmt.Errorf("approval quorum %d must be between 1 and the %d configured approvers", quorum, approvers)
+	}
+	return nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func generateApprovalID() string {
+	// Simple ID generation for simulation
+	return fmt.Sprintf("APPR_%d", nextIDNanos())
+}
@@ -0,0 +1,282 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestApprovalPolicies verifies large transfers and withdrawals wait for an approver quorum
+// Following BDD Given/When/Then pattern
+func TestApprovalPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("transfers_below_the_threshold_settle_immediately", func(t *testing.T) {
+		// Given: A 2-of-3 policy for BTC transfers of 10 or more
+		svc := newTestService(t, withApprovals("BTC=10"))
+		from, to := fundedPair(t, svc, "BTC", 20)
+
+		// When: 5 BTC is transferred
+		if _, err := svc.Transfer(from, to, "BTC", 5); err != nil {
+			t.Fatalf("Transfer failed: %v", err)
+		}
+
+		// Then: It settles without approval
+		assertBalance(t, svc, to, "BTC", 5)
+		if pending := svc.ListApprovalRequests(ctx, services.ApprovalStatusPending); len(pending) != 0 {
+			t.Errorf("Expected no pending approvals, got %d", len(pending))
+		}
+	})
+
+	t.Run("large_transfers_settle_once_the_quorum_approves", func(t *testing.T) {
+		// Given: A 2-of-3 policy and a transfer above the threshold by alice
+		svc := newTestService(t, withApprovals("BTC=10"))
+		from, to := fundedPair(t, svc, "BTC", 20)
+		settlement, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 15, InitiatedBy: "alice"})
+		if err != nil {
+			t.Fatalf("SubmitSettlementInstruction failed: %v", err)
+		}
+
+		// Then: It waits for approval
+		stored, _ := svc.GetSettlement(ctx, settlement.ID)
+		if stored.Status != services.SettlementStatusPendingApproval || stored.ApprovalID == "" {
+			t.Fatalf("Expected pending_approval, got %s", stored.Status)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+
+		// And: The maker cannot approve their own transfer
+		if _, err := svc.ApproveOperation(ctx, stored.ApprovalID, "alice"); !errors.Is(err, services.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest for self-approval, got %v", err)
+		}
+
+		// When: Two other approvers approve
+		if _, err := svc.ApproveOperation(ctx, stored.ApprovalID, "bob"); err != nil {
+			t.Fatalf("ApproveOperation failed: %v", err)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+		request, err := svc.ApproveOperation(ctx, stored.ApprovalID, "carol")
+		if err != nil {
+			t.Fatalf("ApproveOperation failed: %v", err)
+		}
+
+		// Then: The transfer settles and the approvers are recorded
+		if request.Status != services.ApprovalStatusApproved || len(request.Decisions) != 2 || request.Decisions[1].Approver != "carol" {
+			t.Errorf("Unexpected approval request %+v", request)
+		}
+		assertBalance(t, svc, to, "BTC", 15)
+	})
+
+	t.Run("rejected_withdrawals_leave_the_balance", func(t *testing.T) {
+		// Given: A withdrawal above the default threshold to a whitelisted address
+		svc := newTestService(t, withApprovals("default=100"))
+		from, _ := fundedPair(t, svc, "ETH", 500)
+		if _, err := svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "ETH", Address: "0xabc"}); err != nil {
+			t.Fatalf("AddWithdrawalAddress failed: %v", err)
+		}
+		withdrawal, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "ETH", Amount: 200, Address: "0xabc", RequestedBy: "alice"})
+		if err != nil {
+			t.Fatalf("Withdraw failed: %v", err)
+		}
+		if withdrawal.Status != services.WithdrawalStatusPendingApproval {
+			t.Fatalf("Expected pending_approval, got %s", withdrawal.Status)
+		}
+
+		// When: One approver rejects it
+		if _, err := svc.RejectOperation(ctx, withdrawal.ApprovalID, "bob", "unknown address"); err != nil {
+			t.Fatalf("RejectOperation failed: %v", err)
+		}
+
+		// Then: The withdrawal is rejected and nothing left the account
+		withdrawal, _ = svc.GetWithdrawal(ctx, withdrawal.ID)
+		if withdrawal.Status != services.WithdrawalStatusRejected {
+			t.Errorf("Expected rejected, got %s", withdrawal.Status)
+		}
+		assertBalance(t, svc, from, "ETH", 500)
+	})
+
+	t.Run("account_overrides_and_expiry", func(t *testing.T) {
+		// Given: One account needing three of four approvers for any BTC transfer
+		svc := newTestService(t, withApprovals("BTC=10"))
+		from, to := fundedPair(t, svc, "BTC", 20)
+		reconfigureTestService(t, svc, withApprovals("BTC=10"), func(cfg *config.Config) {
+			cfg.ApprovalApprovers = "alice,bob,carol,dave"
+			cfg.ApprovalAccountQuorums = from + "=3"
+			cfg.ApprovalAccountThresholds = from + ":BTC=0"
+			cfg.ApprovalExpiry = time.Hour
+		})
+
+		// When: A small transfer is made and the approval is left to expire
+		id, _ := svc.Transfer(from, to, "BTC", 1)
+		settlement, _ := svc.GetSettlement(ctx, id)
+		request, _ := svc.GetApprovalRequest(ctx, settlement.ApprovalID)
+		if request.Quorum != 3 {
+			t.Errorf("Expected the account quorum of 3, got %d", request.Quorum)
+		}
+		if expired := svc.ExpireApprovalRequests(ctx, time.Now().Add(2*time.Hour)); expired != 1 {
+			t.Fatalf("Expected 1 expired approval, got %d", expired)
+		}
+
+		// Then: The transfer expires without moving funds
+		settlement, _ = svc.GetSettlement(ctx, id)
+		if settlement.Status != services.SettlementStatusExpired {
+			t.Errorf("Expected expired, got %s", settlement.Status)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+	})
+
+	t.Run("operations_without_a_maker_can_only_be_rejected", func(t *testing.T) {
+		// Given: A transfer above the threshold with no recorded maker
+		svc := newTestService(t, withApprovals("BTC=10"))
+		from, to := fundedPair(t, svc, "BTC", 20)
+		id, _ := svc.Transfer(from, to, "BTC", 15)
+		settlement, _ := svc.GetSettlement(ctx, id)
+
+		// When: An approver approves it
+		_, err := svc.ApproveOperation(ctx, settlement.ApprovalID, "bob")
+
+		// Then: The approval is refused, but a rejection is accepted
+		if !errors.Is(err, services.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest, got %v", err)
+		}
+		if _, err := svc.RejectOperation(ctx, settlement.ApprovalID, "bob", "no maker"); err != nil {
+			t.Errorf("RejectOperation failed: %v", err)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+	})
+
+	t.Run("quorums_must_be_reachable_by_the_approvers", func(t *testing.T) {
+		// Given: Three approvers
+		for _, quorums := range []*config.Config{
+			{ApprovalApprovers: "alice,bob,carol", ApprovalQuorum: 4},
+			{ApprovalApprovers: "alice,bob,carol", ApprovalQuorum: 0},
+			{ApprovalApprovers: "alice,bob,carol", ApprovalQuorum: 2, ApprovalAccountQuorums: "ACC_1=4"},
+		} {
+			// When: A quorum needs more approvers than are configured, or none
+			_, err := services.NewApprovalPolicy(quorums)
+
+			// Then: The policy is refused
+			if err == nil {
+				t.Errorf("Expected an error for %+v", quorums)
+			}
+		}
+
+		// And: Single-approver and M-of-M policies are accepted
+		for _, quorums := range []*config.Config{
+			{ApprovalApprovers: "alice", ApprovalQuorum: 1},
+			{ApprovalApprovers: "alice,bob,carol", ApprovalQuorum: 3},
+		} {
+			if _, err := services.NewApprovalPolicy(quorums); err != nil {
+				t.Errorf("Expected %+v to be accepted: %v", quorums, err)
+			}
+		}
+	})
+
+	t.Run("makers_outside_an_m_of_m_policy_can_be_approved", func(t *testing.T) {
+		// Given: A 3-of-3 policy and a transfer by dave, who is not an approver
+		svc := newTestService(t, withApprovals("BTC=10"), func(cfg *config.Config) { cfg.ApprovalQuorum = 3 })
+		from, to := fundedPair(t, svc, "BTC", 20)
+		settlement, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{
+			FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 15, InitiatedBy: "dave", SettlementDate: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("SubmitSettlementInstruction failed: %v", err)
+		}
+
+		// When: All three approvers approve
+		for _, approver := range []string{"alice", "bob", "carol"} {
+			if _, err := svc.ApproveOperation(ctx, settlement.ApprovalID, approver); err != nil {
+				t.Fatalf("ApproveOperation by %s failed: %v", approver, err)
+			}
+		}
+
+		// Then: The transfer settles
+		assertBalance(t, svc, to, "BTC", 15)
+	})
+
+	t.Run("makers_among_an_m_of_m_policy_are_refused", func(t *testing.T) {
+		// Given: A 3-of-3 policy
+		svc := newTestService(t, withApprovals("BTC=10"), func(cfg *config.Config) { cfg.ApprovalQuorum = 3 })
+		from, to := fundedPair(t, svc, "BTC", 20)
+
+		// When: alice, one of the three approvers, submits a transfer needing approval
+		_, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{
+			FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 15, InitiatedBy: "alice", SettlementDate: time.Now(),
+		})
+
+		// Then: It is refused, as the other two could never reach the quorum
+		if !errors.Is(err, services.ErrInvalidRequest) {
+			t.Fatalf("Expected ErrInvalidRequest, got %v", err)
+		}
+		if settlements := svc.ListSettlements(ctx, ""); len(settlements) != 0 {
+			t.Errorf("Expected no stored settlements, got %d", len(settlements))
+		}
+
+		// And: A transfer below the threshold is unaffected
+		if _, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{
+			FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 5, InitiatedBy: "alice", SettlementDate: time.Now(),
+		}); err != nil {
+			t.Errorf("Expected a small transfer to be accepted: %v", err)
+		}
+	})
+
+	t.Run("settlement_instructions_wait_for_approval", func(t *testing.T) {
+		// Given: A 2-of-3 policy for BTC transfers of 10 or more
+		svc := newTestService(t, withApprovals("BTC=10"))
+		from, to := fundedPair(t, svc, "BTC", 20)
+
+		// When: A 15 BTC instruction due now is submitted by alice
+		settlement, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{
+			FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 15, InitiatedBy: "alice", SettlementDate: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("SubmitSettlementInstruction failed: %v", err)
+		}
+
+		// Then: It waits for approval, even when the scheduler runs
+		svc.ProcessDueSettlements(ctx, time.Now())
+		settlement, _ = svc.GetSettlement(ctx, settlement.ID)
+		if settlement.Status != services.SettlementStatusPendingApproval || settlement.ApprovalID == "" {
+			t.Fatalf("Expected pending_approval, got %s", settlement.Status)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+
+		// And: It settles once the quorum approves
+		_, _ = svc.ApproveOperation(ctx, settlement.ApprovalID, "bob")
+		_, _ = svc.ApproveOperation(ctx, settlement.ApprovalID, "carol")
+		assertBalance(t, svc, to, "BTC", 15)
+	})
+
+	t.Run("matched_settlements_wait_for_approval", func(t *testing.T) {
+		// Given: A 2-of-3 policy for BTC transfers of 10 or more
+		svc := newTestService(t, withApprovals("BTC=10"))
+		from, to := matchingCounterparties(t, svc, "BTC", 20)
+		leg := services.MatchingInstruction{TradeReference: "TRADE-1", FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 15}
+
+		// When: Both sides instruct and match
+		deliver, receive := leg, leg
+		deliver.Side, deliver.SubmittedBy = services.MatchingSideDeliver, "DELIVERER"
+		receive.Side, receive.SubmittedBy = services.MatchingSideReceive, "RECEIVER"
+		_, _ = svc.SubmitMatchingInstruction(ctx, deliver)
+		matched, err := svc.SubmitMatchingInstruction(ctx, receive)
+		if err != nil {
+			t.Fatalf("SubmitMatchingInstruction failed: %v", err)
+		}
+
+		// Then: The settlement waits for approval with the deliverer as maker
+		settlement, _ := svc.GetSettlement(ctx, matched.SettlementID)
+		if settlement.Status != services.SettlementStatusPendingApproval {
+			t.Fatalf("Expected pending_approval, got %s", settlement.Status)
+		}
+		request, _ := svc.GetApprovalRequest(ctx, settlement.ApprovalID)
+		if request.RequestedBy != "DELIVERER" {
+			t.Errorf("Expected the deliverer as the maker, got %q", request.RequestedBy)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+	})
+}
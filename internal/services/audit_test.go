@@ -0,0 +1,177 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// capturingAudit records audit events in memory
+type capturingAudit struct {
+	mu     sync.Mutex
+	events []ports.AuditEvent
+}
+
+func (c *capturingAudit) Record(_ context.Context, event ports.AuditEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.events = append(c.events, event)
+}
+
+func (c *capturingAudit) last(action string) (ports.AuditEvent, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := len(c.events) - 1; i >= 0; i-- {
+		if c.events[i].Action == action {
+			return c.events[i], true
+		}
+	}
+	return ports.AuditEvent{}, false
+}
+
+// TestCustodianServiceAudit verifies state-changing operations emit audit events
+// Following BDD Given/When/Then pattern
+func TestCustodianServiceAudit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("records_deposit_with_before_and_after_balance", func(t *testing.T) {
+		// Given: An account holding 5 BTC with an audit port attached
+		svc := newTestService(t)
+		audit := &capturingAudit{}
+		svc.SetAuditPort(audit)
+		account, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.Deposit(ctx, account.ID, "BTC", 5)
+
+		// When: Another 2 BTC is deposited
+		if _, err := svc.Deposit(ctx, account.ID, "BTC", 2); err != nil {
+			t.Fatalf("Deposit failed: %v", err)
+		}
+
+		// Then: The deposit is audited with the position before and after
+		event, ok := audit.last("account.deposit")
+		if !ok {
+			t.Fatal("Expected an account.deposit audit event")
+		}
+		if event.ResourceID != account.ID || event.Outcome != ports.AuditOutcomeSuccess {
+			t.Errorf("Unexpected deposit event: %+v", event)
+		}
+		if len(event.Balances) != 1 || event.Balances[0].BalanceBefore != 5 || event.Balances[0].BalanceAfter != 7 {
+			t.Errorf("Expected balance 5 -> 7, got %+v", event.Balances)
+		}
+
+		// And: Account creation was audited too
+		if _, ok := audit.last("account.create"); !ok {
+			t.Error("Expected an account.create audit event")
+		}
+	})
+
+	t.Run("records_hold_as_available_balance_change", func(t *testing.T) {
+		// Given: An account holding 10 ETH
+		svc := newTestService(t)
+		audit := &capturingAudit{}
+		svc.SetAuditPort(audit)
+		account, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.Deposit(ctx, account.ID, "ETH", 10)
+
+		// When: 4 ETH is put on hold and released
+		hold, err := svc.PlaceHold(ctx, account.ID, "ETH", 4, "pending withdrawal")
+		if err != nil {
+			t.Fatalf("PlaceHold failed: %v", err)
+		}
+		if err := svc.ReleaseHold(ctx, hold.ID); err != nil {
+			t.Fatalf("ReleaseHold failed: %v", err)
+		}
+
+		// Then: Both are audited; only the available balance moves
+		placed, _ := audit.last("hold.place")
+		if placed.ResourceID != hold.ID || len(placed.Balances) != 1 {
+			t.Fatalf("Unexpected hold.place event: %+v", placed)
+		}
+		if b := placed.Balances[0]; b.BalanceBefore != 10 || b.BalanceAfter != 10 || b.AvailableBefore != 10 || b.AvailableAfter != 6 {
+			t.Errorf("Expected available 10 -> 6 with balance unchanged, got %+v", b)
+		}
+		released, _ := audit.last("hold.release")
+		if b := released.Balances[0]; b.AvailableBefore != 6 || b.AvailableAfter != 10 {
+			t.Errorf("Expected available 6 -> 10, got %+v", b)
+		}
+	})
+
+	t.Run("records_settlement_for_both_accounts", func(t *testing.T) {
+		// Given: Two accounts, the sender holding 3 BTC
+		svc := newTestService(t)
+		audit := &capturingAudit{}
+		svc.SetAuditPort(audit)
+		from, _ := svc.CreateAccount(ctx, "TRADING")
+		to, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.Deposit(ctx, from.ID, "BTC", 3)
+
+		// When: 1 BTC is settled between them
+		settlement := services.Settlement{ID: "SETTLE_AUDIT", FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 1}
+		if _, err := svc.SubmitSettlementInstruction(ctx, settlement); err != nil {
+			t.Fatalf("SubmitSettlementInstruction failed: %v", err)
+		}
+
+		// Then: One event carries both legs
+		event, _ := audit.last("settlement.process")
+		if event.ResourceID != "SETTLE_AUDIT" || event.Outcome != ports.AuditOutcomeSuccess || len(event.Balances) != 2 {
+			t.Fatalf("Unexpected settlement event: %+v", event)
+		}
+		if b := event.Balances[0]; b.AccountID != from.ID || b.BalanceBefore != 3 || b.BalanceAfter != 2 {
+			t.Errorf("Unexpected sender leg: %+v", b)
+		}
+		if b := event.Balances[1]; b.AccountID != to.ID || b.BalanceBefore != 0 || b.BalanceAfter != 1 {
+			t.Errorf("Unexpected receiver leg: %+v", b)
+		}
+	})
+
+	t.Run("records_transfers_as_submitted_settlements", func(t *testing.T) {
+		// Given: A sender holding 3 BTC
+		svc := newTestService(t)
+		audit := &capturingAudit{}
+		svc.SetAuditPort(audit)
+		from, to := fundedPair(t, svc, "BTC", 3)
+
+		// When: 1 BTC is transferred
+		id, err := svc.Transfer(from, to, "BTC", 1)
+		if err != nil {
+			t.Fatalf("Transfer failed: %v", err)
+		}
+
+		// Then: It is audited as submitted like any other settlement, then as processed
+		if event, ok := audit.last("settlement.submit"); !ok || event.ResourceID != id {
+			t.Errorf("Expected a settlement.submit event for %s, got %+v", id, event)
+		}
+		if event, ok := audit.last("settlement.process"); !ok || event.ResourceID != id || event.Outcome != ports.AuditOutcomeSuccess {
+			t.Errorf("Expected a successful settlement.process event for %s, got %+v", id, event)
+		}
+	})
+
+	t.Run("records_failed_settlement_without_balances", func(t *testing.T) {
+		// Given: A sender with no funds
+		svc := newTestService(t)
+		audit := &capturingAudit{}
+		svc.SetAuditPort(audit)
+		from, _ := svc.CreateAccount(ctx, "TRADING")
+		to, _ := svc.CreateAccount(ctx, "TRADING")
+
+		// When: A settlement is attempted
+		settlement, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_FAIL", FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 1})
+		if err != nil {
+			t.Fatalf("SubmitSettlementInstruction failed: %v", err)
+		}
+
+		// Then: The failure is audited with its error
+		if settlement.ReasonCode != services.FailReasonInsufficientBalance {
+			t.Fatalf("Expected %s, got %+v", services.FailReasonInsufficientBalance, settlement)
+		}
+		event, _ := audit.last("settlement.process")
+		if event.Outcome != ports.AuditOutcomeFailure || event.Error == "" || len(event.Balances) != 0 {
+			t.Errorf("Unexpected failed settlement event: %+v", event)
+		}
+	})
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+)
+
+// BusinessCalendar says on which days an asset settles and by when instructions must
+// arrive to count for the day. Dates and the cut-off are UTC.
+type BusinessCalendar struct {
+	WeekdaysOnly bool
+	Holidays     map[string]bool // "2006-01-02"
+	CutOff       time.Duration   // Offset from midnight; 0 means no cut-off
+}
+
+// IsBusinessDay reports whether the calendar settles on t's date
+func (c BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	t = t.UTC()
+	if c.WeekdaysOnly && (t.Weekday() == time.Saturday || t.Weekday() == time.Sunday) {
+		return false
+	}
+	return !c.Holidays[t.Format(time.DateOnly)]
+}
+
+// BusinessCalendars holds the calendar of each asset. Assets without one, crypto
+// in particular, settle every day with no cut-off.
+type BusinessCalendars struct {
+	calendars map[string]BusinessCalendar
+}
+
+// NewBusinessCalendars builds calendars from the weekday-only assets, cut-offs and
+// holidays in cfg
+func NewBusinessCalendars(cfg *config.Config) (*BusinessCalendars, error) {
+	calendars := make(map[string]BusinessCalendar)
+
+	for _, asset := range strings.Split(cfg.BusinessDayAssets, ",") {
+		if asset = strings.TrimSpace(asset); asset != "" {
+			calendar := calendars[asset]
+			calendar.WeekdaysOnly = true
+			calendars[asset] = calendar
+		}
+	}
+
+	cutOffs, err := parsePairs(cfg.SettlementCutOffs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid settlement cut-offs: %w", err)
+	}
+	for asset, value := range cutOffs {
+		cutOff, err := parseCutOff(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid settlement cut-off for %s: %w", asset, err)
+		}
+		calendar := calendars[asset]
+		calendar.CutOff = cutOff
+		calendars[asset] = calendar
+	}
+
+	holidays, err := parsePairs(cfg.SettlementHolidays)
+	if err != nil {
+		return nil, fmt.Errorf("invalid settlement holidays: %w", err)
+	}
+	for asset, value := range holidays {
+		calendar := calendars[asset]
+		calendar.Holidays = make(map[string]bool)
+		for _, date := range strings.Split(value, ",") {
+			date = strings.TrimSpace(date)
+			if _, err := time.Parse(time.DateOnly, date); err != nil {
+				return nil, fmt.Errorf("invalid holiday %q for %s, expected YYYY-MM-DD", date, asset)
+			}
+			calendar.Holidays[date] = true
+		}
+		calendars[asset] = calendar
+	}
+
+	return &BusinessCalendars{calendars: calendars}, nil
+}
+
+// Calendar returns the calendar for an asset
+func (b *BusinessCalendars) Calendar(assetID string) BusinessCalendar {
+	if b == nil {
+		return BusinessCalendar{}
+	}
+	return b.calendars[assetID]
+}
+
+// IsBusinessDay reports whether every one of the assets settles on t's date
+func (b *BusinessCalendars) IsBusinessDay(t time.Time, assets ...string) bool {
+	for _, asset := range assets {
+		if !b.Calendar(asset).IsBusinessDay(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// SettlementDate applies cut-offs and a T+N business-day cycle to a submission
+// time. The earliest of cutOff and the assets' cut-offs applies; submissions after
+// it or on a non-business day count from the start of the next business day. Each
+// cycle day then moves to the next day that is a business day for every asset.
+func (b *BusinessCalendars) SettlementDate(submittedAt time.Time, cycleDays int, cutOff time.Duration, assets ...string) time.Time {
+	for _, asset := range assets {
+		if c := b.Calendar(asset).CutOff; c > 0 && (cutOff == 0 || c < cutOff) {
+			cutOff = c
+		}
+	}
+
+	date := submittedAt.UTC()
+	midnight := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	if !b.IsBusinessDay(date, assets...) || (cutOff > 0 && date.Sub(midnight) >= cutOff) {
+		date = b.nextBusinessDay(midnight, assets...)
+	}
+
+	for i := 0; i < cycleDays; i++ {
+		date = b.nextBusinessDay(date, assets...)
+	}
+	return date
+}
+
+// nextBusinessDay returns t moved forward to the next date that is a business day
+// for every asset
+func (b *BusinessCalendars) nextBusinessDay(t time.Time, assets ...string) time.Time {
+	t = t.AddDate(0, 0, 1)
+	for !b.IsBusinessDay(t, assets...) {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t
+}
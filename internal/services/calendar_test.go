@@ -0,0 +1,131 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestBusinessCalendars verifies settlement dates honour business days, holidays and cut-offs
+// Following BDD Given/When/Then pattern
+func TestBusinessCalendars(t *testing.T) {
+	// Given: USD settling on weekdays with a 21:00 cut-off and a Christmas holiday; crypto 24/7
+	calendars, err := services.NewBusinessCalendars(&config.Config{
+		BusinessDayAssets:  "USD",
+		SettlementCutOffs:  "USD=21:00",
+		SettlementHolidays: "USD=2026-12-25",
+	})
+	if err != nil {
+		t.Fatalf("NewBusinessCalendars failed: %v", err)
+	}
+	friday := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	saturday := time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name        string
+		submittedAt time.Time
+		cycleDays   int
+		assets      []string
+		want        time.Time
+	}{
+		{"crypto_settles_on_weekends", saturday, 1, []string{"BTC"}, time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC)},
+		{"usd_t1_skips_the_weekend", friday, 1, []string{"USD"}, time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)},
+		{"usd_t0_before_cut_off_settles_today", friday, 0, []string{"USD"}, friday},
+		{"usd_after_cut_off_rolls_to_next_business_day", friday.Add(12 * time.Hour), 0, []string{"USD"}, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{"usd_t1_after_cut_off", friday.Add(12 * time.Hour), 1, []string{"USD"}, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)},
+		{"usd_skips_holidays", time.Date(2026, 12, 24, 9, 0, 0, 0, time.UTC), 1, []string{"USD"}, time.Date(2026, 12, 28, 9, 0, 0, 0, time.UTC)},
+		{"pairs_settle_when_both_assets_do", saturday, 0, []string{"BTC", "USD"}, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When: The settlement date is computed
+			got := calendars.SettlementDate(tc.submittedAt, tc.cycleDays, 0, tc.assets...)
+
+			// Then: It lands on the expected business day
+			if !got.Equal(tc.want) {
+				t.Errorf("Expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	t.Run("settlements_submitted_on_a_holiday_or_after_cut_off_wait_for_the_next_business_day", func(t *testing.T) {
+		now := time.Now().UTC()
+		if now.Hour() == 0 && now.Minute() == 0 || now.Hour() == 23 && now.Minute() == 59 {
+			t.Skip("too close to midnight for today's holiday and cut-off to hold while submitting")
+		}
+		ctx := context.Background()
+
+		// Given: A custodian whose USD calendar closes today and whose EUR cut-off has passed
+		svc := newTestService(t, func(cfg *config.Config) {
+			cfg.SettlementHolidays = "USD=" + now.Format(time.DateOnly)
+			cfg.SettlementCutOffs = "EUR=" + now.Format("15:04")
+		})
+		nextDay := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+
+		for _, asset := range []string{"USD", "EUR"} {
+			from, to := fundedPair(t, svc, asset, 100)
+
+			// When: A settlement without a settlement date is submitted
+			settlement, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{FromAccount: from, ToAccount: to, AssetID: asset, Amount: 10})
+			if err != nil {
+				t.Fatalf("SubmitSettlementInstruction failed: %v", err)
+			}
+
+			// Then: It stays pending until the start of the next business day
+			if settlement.Status != services.SettlementStatusPending || !settlement.SettlementDate.Equal(nextDay) {
+				t.Errorf("Expected %s pending until %v, got %s until %v", asset, nextDay, settlement.Status, settlement.SettlementDate)
+			}
+			assertBalance(t, svc, to, asset, 0)
+		}
+	})
+
+	t.Run("transfers_settle_immediately_outside_business_days", func(t *testing.T) {
+		now := time.Now().UTC()
+		if now.Hour() == 23 && now.Minute() == 59 {
+			t.Skip("too close to midnight for today's holiday to hold while transferring")
+		}
+
+		// Given: A custodian whose USD calendar closes today
+		svc := newTestService(t, func(cfg *config.Config) {
+			cfg.SettlementHolidays = "USD=" + now.Format(time.DateOnly)
+		})
+		from, to := fundedPair(t, svc, "USD", 100)
+
+		// When: USD is transferred on the holiday
+		id, err := svc.Transfer(from, to, "USD", 10)
+		if err != nil {
+			t.Fatalf("Transfer failed: %v", err)
+		}
+
+		// Then: It settles without waiting for the next business day
+		settlement, err := svc.GetSettlement(context.Background(), id)
+		if err != nil {
+			t.Fatalf("GetSettlement failed: %v", err)
+		}
+		if settlement.Status != services.SettlementStatusCompleted {
+			t.Errorf("Expected the transfer completed, got %s", settlement.Status)
+		}
+		assertBalance(t, svc, to, "USD", 10)
+	})
+
+	t.Run("rejects_invalid_configuration", func(t *testing.T) {
+		for _, cfg := range []*config.Config{
+			{SettlementCutOffs: "USD=5pm"},
+			{SettlementHolidays: "USD=25/12/2026"},
+		} {
+			// When: Calendars are built from invalid settings
+			_, err := services.NewBusinessCalendars(cfg)
+
+			// Then: They are rejected
+			if err == nil {
+				t.Errorf("Expected an error for %+v", cfg)
+			}
+		}
+	})
+}
@@ -0,0 +1,467 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Chain transaction directions
+const (
+	ChainDirectionDeposit    = "deposit"
+	ChainDirectionWithdrawal = "withdrawal"
+)
+
+// Chain transaction statuses
+const (
+	ChainTxStatusMempool    = "mempool"    // Broadcast, not yet in a block
+	ChainTxStatusConfirming = "confirming" // In a block, short of the required confirmations
+	ChainTxStatusConfirmed  = "confirmed"  // Final; deposits are credited and withdrawals complete
+)
+
+// ChainNetwork configures one simulated blockchain
+type ChainNetwork struct {
+	Network       string        `json:"network"`
+	BlockInterval time.Duration `json:"block_interval"`
+	Confirmations int           `json:"confirmations"` // Blocks needed before a transaction is final
+	Fee           float64       `json:"fee"`           // Charged per withdrawal in the withdrawn asset
+}
+
+// ChainSimulation configures the simulated blockchains. Reorgs never reach deeper
+// than a network's confirmations, so final transactions stay final.
+type ChainSimulation struct {
+	Networks         map[string]ChainNetwork
+	MempoolInclusion float64 // Chance a mempool transaction makes the next block
+	ReorgProbability float64 // Chance a block is preceded by a reorg
+	ReorgMaxDepth    int
+	Seed             int64 // 0 seeds from the clock
+}
+
+// ChainTransaction is a deposit or withdrawal on a simulated blockchain
+type ChainTransaction struct {
+	Hash          string     `json:"hash"`
+	Network       string     `json:"network"`
+	Direction     string     `json:"direction"`
+	ResourceID    string     `json:"resource_id,omitempty"` // Withdrawal ID for withdrawals
+	AccountID     string     `json:"account_id"`
+	AssetID       string     `json:"asset_id"`
+	Amount        float64    `json:"amount"`
+	Fee           float64    `json:"fee"`
+	Address       string     `json:"address"` // Destination for withdrawals, source for deposits
+	Status        string     `json:"status"`
+	BlockHeight   int64      `json:"block_height,omitempty"`
+	BlockHash     string     `json:"block_hash,omitempty"`
+	Confirmations int        `json:"confirmations"`
+	Reorgs        int        `json:"reorgs"` // Times a reorg returned it to the mempool
+	BroadcastAt   time.Time  `json:"broadcast_at"`
+	ConfirmedAt   *time.Time `json:"confirmed_at,omitempty"`
+}
+
+// ChainBlock is one block mined on a simulated blockchain
+type ChainBlock struct {
+	Network      string    `json:"network"`
+	Height       int64     `json:"height"`
+	Hash         string    `json:"hash"`
+	MinedAt      time.Time `json:"mined_at"`
+	Transactions []string  `json:"transactions"`
+	ReorgDepth   int       `json:"reorg_depth,omitempty"` // Blocks orphaned just before this one
+}
+
+// ChainStatus describes the tip and mempool of a simulated blockchain
+type ChainStatus struct {
+	ChainNetwork
+	Height      int64     `json:"height"`
+	TipHash     string    `json:"tip_hash"`
+	TipMinedAt  time.Time `json:"tip_mined_at"`
+	NextBlockAt time.Time `json:"next_block_at"`
+	MempoolSize int       `json:"mempool_size"`
+}
+
+// simulatedChain is the state of one network, guarded by CustodianService.mu
+type simulatedChain struct {
+	ChainNetwork
+	height      int64
+	blocks      []ChainBlock // Most recent last, enough to reorg and count confirmations
+	mempool     []string     // Transaction hashes, oldest first
+	nextBlockAt time.Time
+}
+
+// NewChainSimulation builds the simulated blockchains in cfg, or returns nil when
+// chain simulation is disabled
+func NewChainSimulation(cfg *config.Config) (*ChainSimulation, error) {
+	if !cfg.ChainSimulationEnabled {
+		return nil, nil
+	}
+
+	intervals, err := parsePairs(cfg.ChainBlockIntervals)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chain block intervals: %w", err)
+	}
+	confirmations, err := parsePairs(cfg.ChainConfirmations)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chain confirmations: %w", err)
+	}
+	fees, err := parseThresholds(cfg.ChainFees)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chain fees: %w", err)
+	}
+
+	sim := &ChainSimulation{
+		Networks:         make(map[string]ChainNetwork),
+		MempoolInclusion: cfg.ChainMempoolInclusion,
+		ReorgProbability: cfg.ChainReorgProbability,
+		ReorgMaxDepth:    cfg.ChainReorgMaxDepth,
+		Seed:             int64(cfg.ChainSeed),
+	}
+	for _, network := range strings.Split(cfg.ChainNetworks, ",") {
+		if network = strings.TrimSpace(network); network == "" {
+			continue
+		}
+
+		chain := ChainNetwork{Network: network, BlockInterval: 10 * time.Second, Confirmations: 1, Fee: fees[network]}
+		if value, ok := intervals[network]; ok {
+			if chain.BlockInterval, err = time.ParseDuration(value); err != nil || chain.BlockInterval <= 0 {
+				return nil, fmt.Errorf("invalid block interval %q for %s", value, network)
+			}
+		}
+		if value, ok := confirmations[network]; ok {
+			if chain.Confirmations, err = strconv.Atoi(value); err != nil || chain.Confirmations < 1 {
+				return nil, fmt.Errorf("invalid confirmations %q for %s", value, network)
+			}
+		}
+		sim.Networks[network] = chain
+	}
+	return sim, nil
+}
+
+// SetChainSimulation starts the simulated blockchains from height 0; nil removes
+// them and withdrawals complete immediately again
+func (s *CustodianService) SetChainSimulation(sim *ChainSimulation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.chainSim = sim
+	s.chains = make(map[string]*simulatedChain)
+	if sim == nil {
+		return
+	}
+
+	seed := sim.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	s.chainRand = rand.New(rand.NewSource(seed))
+
+	now := time.Now()
+	for network, config := range sim.Networks {
+		s.chains[network] = &simulatedChain{ChainNetwork: config, nextBlockAt: now.Add(config.BlockInterval)}
+	}
+}
+
+// SubmitChainDeposit broadcasts an inbound transfer to an account on a simulated
+// network. The account is credited once the transaction is final. Each network
+// carries only the asset it is named after, e.g. BTC on "BTC".
+func (s *CustodianService) SubmitChainDeposit(ctx context.Context, accountID, assetID, network string, amount float64, fromAddress string) (*ChainTransaction, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w: deposit amount must be positive", ErrInvalidRequest)
+	}
+	if network == "" {
+		network = assetID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.accounts[accountID]; !exists {
+		return nil, fmt.Errorf("account %s %w", accountID, ErrNotFound)
+	}
+	chain, exists := s.chains[network]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s is not a simulated network", ErrInvalidRequest, network)
+	}
+	if assetID != chain.Network {
+		return nil, fmt.Errorf("%w: %q is not carried on the %s network", ErrInvalidRequest, assetID, network)
+	}
+
+	tx := s.broadcastLocked(chain, ChainTransaction{
+		Direction: ChainDirectionDeposit,
+		AccountID: accountID,
+		AssetID:   assetID,
+		Amount:    amount,
+		Address:   fromAddress,
+	}, time.Now())
+
+	result := *tx
+	return &result, nil
+}
+
+// GetChainTransaction returns a copy of a simulated chain transaction by hash
+func (s *CustodianService) GetChainTransaction(ctx context.Context, network, hash string) (*ChainTransaction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tx, exists := s.chainTxs[hash]
+	if !exists || tx.Network != network {
+		return nil, fmt.Errorf("transaction %s on %s %w", hash, network, ErrNotFound)
+	}
+
+	result := *tx
+	return &result, nil
+}
+
+// ChainStatus describes a simulated network's tip and mempool
+func (s *CustodianService) ChainStatus(ctx context.Context, network string) (*ChainStatus, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	chain, exists := s.chains[network]
+	if !exists {
+		return nil, fmt.Errorf("simulated network %s %w", network, ErrNotFound)
+	}
+
+	status := &ChainStatus{
+		ChainNetwork: chain.ChainNetwork,
+		Height:       chain.height,
+		NextBlockAt:  chain.nextBlockAt,
+		MempoolSize:  len(chain.mempool),
+	}
+	if len(chain.blocks) > 0 {
+		tip := chain.blocks[len(chain.blocks)-1]
+		status.TipHash, status.TipMinedAt = tip.Hash, tip.MinedAt
+	}
+	return status, nil
+}
+
+// MineBlock mines the next block on a network at now, after a reorg when the
+// simulation rolls one
+func (s *CustodianService) MineBlock(ctx context.Context, network string, now time.Time) (*ChainBlock, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chain, exists := s.chains[network]
+	if !exists {
+		return nil, fmt.Errorf("simulated network %s %w", network, ErrNotFound)
+	}
+
+	block := s.mineBlockLocked(ctx, chain, now)
+	return &block, nil
+}
+
+// AdvanceChains mines every block due by now and returns how many were mined. A
+// network that has fallen far behind skips ahead rather than mining a backlog.
+func (s *CustodianService) AdvanceChains(ctx context.Context, now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	networks := make([]string, 0, len(s.chains))
+	for network := range s.chains {
+		networks = append(networks, network)
+	}
+	sort.Strings(networks)
+
+	mined := 0
+	for _, network := range networks {
+		chain := s.chains[network]
+		if behind := now.Sub(chain.nextBlockAt); behind > 10*chain.BlockInterval {
+			chain.nextBlockAt = now.Add(-chain.BlockInterval)
+		}
+		for !chain.nextBlockAt.After(now) {
+			s.mineBlockLocked(ctx, chain, chain.nextBlockAt)
+			chain.nextBlockAt = chain.nextBlockAt.Add(chain.BlockInterval)
+			mined++
+		}
+	}
+	return mined
+}
+
+// broadcastLocked adds a transaction to a network's mempool
+func (s *CustodianService) broadcastLocked(chain *simulatedChain, tx ChainTransaction, now time.Time) *ChainTransaction {
+	tx.Hash = s.randomHashLocked()
+	tx.Network = chain.Network
+	tx.Status = ChainTxStatusMempool
+	tx.BroadcastAt = now
+
+	stored := &tx
+	s.chainTxs[stored.Hash] = stored
+	chain.mempool = append(chain.mempool, stored.Hash)
+
+	s.logger.WithFields(logrus.Fields{
+		"network":   chain.Network,
+		"tx_hash":   stored.Hash,
+		"direction": stored.Direction,
+		"amount":    stored.Amount,
+	}).Info("Chain transaction broadcast")
+
+	return stored
+}
+
+func (s *CustodianService) mineBlockLocked(ctx context.Context, chain *simulatedChain, now time.Time) ChainBlock {
+	block := ChainBlock{Network: chain.Network, MinedAt: now}
+	if depth := s.reorgDepthLocked(chain); depth > 0 {
+		s.reorgLocked(chain, depth)
+		block.ReorgDepth = depth
+	}
+
+	chain.height++
+	block.Height = chain.height
+	block.Hash = s.randomHashLocked()
+
+	var waiting []string
+	for _, hash := range chain.mempool {
+		if s.chainRand.Float64() >= s.chainSim.MempoolInclusion {
+			waiting = append(waiting, hash)
+			continue
+		}
+		tx := s.chainTxs[hash]
+		tx.Status = ChainTxStatusConfirming
+		tx.BlockHeight, tx.BlockHash = block.Height, block.Hash
+		block.Transactions = append(block.Transactions, hash)
+	}
+	chain.mempool = waiting
+
+	// Keep enough blocks to reorg and to count confirmations of unfinal transactions
+	chain.blocks = append(chain.blocks, block)
+	if keep := max(chain.Confirmations, s.chainSim.ReorgMaxDepth) + 1; len(chain.blocks) > keep {
+		chain.blocks = append([]ChainBlock(nil), chain.blocks[len(chain.blocks)-keep:]...)
+	}
+
+	for _, recent := range chain.blocks {
+		for _, hash := range recent.Transactions {
+			tx := s.chainTxs[hash]
+			if tx.Status != ChainTxStatusConfirming {
+				continue
+			}
+			tx.Confirmations = int(chain.height-recent.Height) + 1
+			if tx.Confirmations >= chain.Confirmations {
+				s.finalizeChainTxLocked(ctx, tx, now)
+			} else {
+				s.syncWithdrawalConfirmationsLocked(tx)
+			}
+		}
+	}
+
+	return block
+}
+
+// reorgDepthLocked rolls whether a reorg precedes the next block and how deep it
+// goes, never deeper than the blocks holding unfinal transactions
+func (s *CustodianService) reorgDepthLocked(chain *simulatedChain) int {
+	limit := min(s.chainSim.ReorgMaxDepth, chain.Confirmations-1, len(chain.blocks))
+	if limit < 1 || s.chainRand.Float64() >= s.chainSim.ReorgProbability {
+		return 0
+	}
+	return s.chainRand.Intn(limit) + 1
+}
+
+// reorgLocked orphans the top depth blocks, returning their transactions to the
+// front of the mempool
+func (s *CustodianService) reorgLocked(chain *simulatedChain, depth int) {
+	orphaned := chain.blocks[len(chain.blocks)-depth:]
+	var returned []string
+	for _, block := range orphaned {
+		for _, hash := range block.Transactions {
+			tx := s.chainTxs[hash]
+			tx.Status = ChainTxStatusMempool
+			tx.BlockHeight, tx.BlockHash, tx.Confirmations = 0, "", 0
+			tx.Reorgs++
+			s.syncWithdrawalConfirmationsLocked(tx)
+			returned = append(returned, hash)
+		}
+	}
+
+	chain.blocks = append([]ChainBlock(nil), chain.blocks[:len(chain.blocks)-depth]...)
+	chain.mempool = append(returned, chain.mempool...)
+	chain.height -= int64(depth)
+
+	s.logger.WithFields(logrus.Fields{
+		"network":      chain.Network,
+		"depth":        depth,
+		"height":       chain.height,
+		"transactions": len(returned),
+	}).Warn("Simulated chain reorganisation")
+}
+
+// finalizeChainTxLocked credits a final deposit or completes a final withdrawal
+func (s *CustodianService) finalizeChainTxLocked(ctx context.Context, tx *ChainTransaction, now time.Time) {
+	tx.Status = ChainTxStatusConfirmed
+	tx.ConfirmedAt = &now
+
+	switch tx.Direction {
+	case ChainDirectionDeposit:
+		before := s.auditBalanceLocked(tx.AccountID, tx.AssetID)
+		balances := s.balances[tx.AccountID]
+		balances[tx.AssetID] += tx.Amount
+		s.creditHotWalletLocked(tx.AssetID, tx.Amount)
+		if account, exists := s.accounts[tx.AccountID]; exists {
+			account.UpdatedAt = now
+		}
+
+		s.publishBalanceChange(ctx, tx.AccountID, tx.AssetID, tx.Amount, balances[tx.AssetID], "")
+		s.recordAudit(ctx, ports.AuditEvent{
+			Action:       "account.deposit",
+			Outcome:      ports.AuditOutcomeSuccess,
+			ResourceType: "account",
+			ResourceID:   tx.AccountID,
+			Balances:     []ports.AuditBalance{s.completeAuditBalanceLocked(before)},
+			Details:      chainTxAuditDetails(tx),
+		})
+	case ChainDirectionWithdrawal:
+		withdrawal, exists := s.withdrawals[tx.ResourceID]
+		if !exists {
+			break
+		}
+		withdrawal.Status = WithdrawalStatusCompleted
+		withdrawal.Confirmations = tx.Confirmations
+		withdrawal.CompletedAt = &now
+
+		event := withdrawalAuditEvent(withdrawal)
+		event.Action = "withdrawal.confirm"
+		event.Details["status"] = withdrawal.Status
+		for key, value := range chainTxAuditDetails(tx) {
+			event.Details[key] = value
+		}
+		s.recordAudit(ctx, event)
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"network":       tx.Network,
+		"tx_hash":       tx.Hash,
+		"direction":     tx.Direction,
+		"confirmations": tx.Confirmations,
+	}).Info("Chain transaction final")
+}
+
+func (s *CustodianService) syncWithdrawalConfirmationsLocked(tx *ChainTransaction) {
+	if tx.Direction != ChainDirectionWithdrawal {
+		return
+	}
+	if withdrawal, exists := s.withdrawals[tx.ResourceID]; exists {
+		withdrawal.Confirmations = tx.Confirmations
+	}
+}
+
+func (s *CustodianService) randomHashLocked() string {
+	return fmt.Sprintf("%016x%016x", s.chainRand.Uint64(), s.chainRand.Uint64())
+}
+
+func chainTxAuditDetails(tx *ChainTransaction) map[string]string {
+	return map[string]string{
+		"network":       tx.Network,
+		"tx_hash":       tx.Hash,
+		"block_height":  strconv.FormatInt(tx.BlockHeight, 10),
+		"confirmations": strconv.Itoa(tx.Confirmations),
+		"fee":           formatAmount(tx.Fee),
+		"address":       tx.Address,
+		"amount":        formatAmount(tx.Amount),
+		"asset_id":      tx.AssetID,
+	}
+}
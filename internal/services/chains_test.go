@@ -0,0 +1,158 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestChainSimulation verifies crypto deposits and withdrawals follow a simulated chain
+// Following BDD Given/When/Then pattern
+func TestChainSimulation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("withdrawal_completes_after_its_confirmations", func(t *testing.T) {
+		// Given: An ETH chain needing 3 confirmations with a 0.5 ETH fee
+		svc := newTestService(t, withChains(), func(cfg *config.Config) {
+			cfg.ChainConfirmations = "ETH=3"
+			cfg.ChainFees = "ETH=0.5"
+			cfg.ChainMempoolInclusion = 1
+		})
+		from, _ := fundedPair(t, svc, "ETH", 10)
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "ETH", Address: "0xabc"})
+
+		// When: 1 ETH is withdrawn
+		withdrawal, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "ETH", Amount: 1, Address: "0xabc"})
+		if err != nil {
+			t.Fatalf("Withdraw failed: %v", err)
+		}
+
+		// Then: It is broadcast and the amount plus fee is debited
+		if withdrawal.Status != services.WithdrawalStatusBroadcast || withdrawal.TxHash == "" || withdrawal.Fee != 0.5 {
+			t.Errorf("Expected a broadcast withdrawal with a 0.5 fee, got %+v", withdrawal)
+		}
+		assertBalance(t, svc, from, "ETH", 8.5)
+
+		// And: It is still broadcast after two blocks
+		now := time.Now()
+		for i := 1; i <= 2; i++ {
+			_, _ = svc.MineBlock(ctx, "ETH", now.Add(time.Duration(i)*time.Second))
+		}
+		pending, _ := svc.GetWithdrawal(ctx, withdrawal.ID)
+		if pending.Status != services.WithdrawalStatusBroadcast || pending.Confirmations != 2 {
+			t.Errorf("Expected broadcast with 2 confirmations, got %s with %d", pending.Status, pending.Confirmations)
+		}
+
+		// And: The third block completes it
+		_, _ = svc.MineBlock(ctx, "ETH", now.Add(3*time.Second))
+		completed, _ := svc.GetWithdrawal(ctx, withdrawal.ID)
+		if completed.Status != services.WithdrawalStatusCompleted || completed.CompletedAt == nil {
+			t.Errorf("Expected completed, got %+v", completed)
+		}
+		tx, err := svc.GetChainTransaction(ctx, "ETH", withdrawal.TxHash)
+		if err != nil || tx.Status != services.ChainTxStatusConfirmed || tx.Confirmations != 3 {
+			t.Errorf("Expected a confirmed transaction with 3 confirmations, got %+v, %v", tx, err)
+		}
+	})
+
+	t.Run("deposit_is_credited_once_final", func(t *testing.T) {
+		// Given: A BTC chain needing 2 confirmations
+		svc := newTestService(t, withChains(), func(cfg *config.Config) {
+			cfg.ChainConfirmations = "BTC=2"
+			cfg.ChainMempoolInclusion = 1
+		})
+		account, _ := svc.CreateAccount(ctx, "TRADING")
+
+		// When: A 0.5 BTC deposit is broadcast and one block is mined
+		tx, err := svc.SubmitChainDeposit(ctx, account.ID, "BTC", "", 0.5, "bc1qsender")
+		if err != nil {
+			t.Fatalf("SubmitChainDeposit failed: %v", err)
+		}
+		now := time.Now()
+		_, _ = svc.MineBlock(ctx, "BTC", now)
+
+		// Then: Nothing is credited yet
+		assertBalance(t, svc, account.ID, "BTC", 0)
+
+		// And: The second confirmation credits the account
+		_, _ = svc.MineBlock(ctx, "BTC", now.Add(time.Minute))
+		assertBalance(t, svc, account.ID, "BTC", 0.5)
+		final, _ := svc.GetChainTransaction(ctx, "BTC", tx.Hash)
+		if final.Status != services.ChainTxStatusConfirmed {
+			t.Errorf("Expected confirmed, got %s", final.Status)
+		}
+	})
+
+	t.Run("deposits_must_be_in_the_networks_asset", func(t *testing.T) {
+		// Given: Simulated BTC and ETH networks
+		svc := newTestService(t, withChains())
+		account, _ := svc.CreateAccount(ctx, "TRADING")
+
+		// When: An ETH deposit is broadcast on the BTC network
+		_, err := svc.SubmitChainDeposit(ctx, account.ID, "ETH", "BTC", 1, "bc1qsender")
+
+		// Then: It is refused
+		if !errors.Is(err, services.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest, got %v", err)
+		}
+	})
+
+	t.Run("reorg_returns_transactions_to_the_mempool", func(t *testing.T) {
+		// Given: A chain that reorganises one block before every block
+		svc := newTestService(t, withChains(), func(cfg *config.Config) {
+			cfg.ChainConfirmations = "BTC=3"
+			cfg.ChainMempoolInclusion = 1
+			cfg.ChainReorgProbability = 1
+			cfg.ChainReorgMaxDepth = 1
+		})
+		account, _ := svc.CreateAccount(ctx, "TRADING")
+		tx, _ := svc.SubmitChainDeposit(ctx, account.ID, "BTC", "BTC", 1, "bc1qsender")
+		now := time.Now()
+		_, _ = svc.MineBlock(ctx, "BTC", now)
+
+		// When: The next block is mined
+		block, err := svc.MineBlock(ctx, "BTC", now.Add(time.Minute))
+		if err != nil {
+			t.Fatalf("MineBlock failed: %v", err)
+		}
+
+		// Then: The first block was orphaned and the deposit re-mined in its place
+		if block.ReorgDepth != 1 || block.Height != 1 {
+			t.Errorf("Expected a depth 1 reorg replacing height 1, got %+v", block)
+		}
+		reorged, _ := svc.GetChainTransaction(ctx, "BTC", tx.Hash)
+		if reorged.Reorgs != 1 || reorged.Confirmations != 1 || reorged.BlockHash != block.Hash {
+			t.Errorf("Expected the deposit re-mined with 1 confirmation, got %+v", reorged)
+		}
+		assertBalance(t, svc, account.ID, "BTC", 0)
+	})
+
+	t.Run("advance_mines_blocks_as_they_fall_due", func(t *testing.T) {
+		// Given: A 10 second ETH chain
+		svc := newTestService(t, withChains(), func(cfg *config.Config) {
+			cfg.ChainBlockIntervals = "ETH=10s"
+			cfg.ChainMempoolInclusion = 1
+		})
+
+		// When: The chains advance 25 seconds
+		mined := svc.AdvanceChains(ctx, time.Now().Add(25*time.Second))
+
+		// Then: Two ETH blocks are mined
+		status, err := svc.ChainStatus(ctx, "ETH")
+		if err != nil {
+			t.Fatalf("ChainStatus failed: %v", err)
+		}
+		if status.Height != 2 {
+			t.Errorf("Expected ETH at height 2, got %d", status.Height)
+		}
+		if mined < 2 {
+			t.Errorf("Expected at least 2 blocks mined, got %d", mined)
+		}
+	})
+}
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Compliance review statuses
+const (
+	ComplianceReviewPending   = "pending"
+	ComplianceReviewReleased  = "released"
+	ComplianceReviewRejected  = "rejected"
+	ComplianceReviewCancelled = "cancelled" // The operation was withdrawn while it waited
+)
+
+// ComplianceReview is an operation the compliance screener held for a compliance
+// officer to release or reject
+type ComplianceReview struct {
+	ID         string     `json:"id"`
+	Operation  string     `json:"operation"`   // ApprovalOperationTransfer or ApprovalOperationWithdrawal
+	ResourceID string     `json:"resource_id"` // Settlement or withdrawal ID
+	AccountID  string     `json:"account_id"`
+	AssetID    string     `json:"asset_id"`
+	Amount     float64    `json:"amount"`
+	Reasons    []string   `json:"reasons,omitempty"`
+	Status     string     `json:"status"`
+	Reviewer   string     `json:"reviewer,omitempty"`
+	Notes      string     `json:"notes,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+}
+
+// SetComplianceScreener registers the screener consulted before transfers and
+// withdrawals execute; nil approves everything
+func (s *CustodianService) SetComplianceScreener(screener ports.ComplianceScreenerPort) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.screener = screener
+}
+
+// GetComplianceReview returns a copy of a compliance review by ID
+func (s *CustodianService) GetComplianceReview(ctx context.Context, reviewID string) (*ComplianceReview, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	review, exists := s.reviews[reviewID]
+	if !exists {
+		return nil, fmt.Errorf("compliance review %s %w", reviewID, ErrNotFound)
+	}
+
+	result := *review
+	return &result, nil
+}
+
+// ListComplianceReviews returns the review queue oldest first, filtered by status
+// unless status is empty
+func (s *CustodianService) ListComplianceReviews(ctx context.Context, status string) []ComplianceReview {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	list := make([]ComplianceReview, 0, len(s.reviews))
+	for _, review := range s.reviews {
+		if status == "" || review.Status == status {
+			list = append(list, *review)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
+
+// ReleaseComplianceReview lets a held operation continue, subject to the approval
+// policy like any other
+func (s *CustodianService) ReleaseComplianceReview(ctx context.Context, reviewID, reviewer, notes string) (*ComplianceReview, error) {
+	return s.resolveComplianceReview(ctx, reviewID, reviewer, notes, ComplianceReviewReleased)
+}
+
+// RejectComplianceReview abandons a held operation
+func (s *CustodianService) RejectComplianceReview(ctx context.Context, reviewID, reviewer, notes string) (*ComplianceReview, error) {
+	return s.resolveComplianceReview(ctx, reviewID, reviewer, notes, ComplianceReviewRejected)
+}
+
+func (s *CustodianService) resolveComplianceReview(ctx context.Context, reviewID, reviewer, notes, status string) (*ComplianceReview, error) {
+	if reviewer == "" {
+		return nil, fmt.Errorf("%w: reviewer is required", ErrInvalidRequest)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	review, exists := s.reviews[reviewID]
+	if !exists {
+		return nil, fmt.Errorf("compliance review %s %w", reviewID, ErrNotFound)
+	}
+	if review.Status != ComplianceReviewPending {
+		return nil, fmt.Errorf("%w: compliance review %s is %s", ErrInvalidRequest, reviewID, review.Status)
+	}
+	if maker := s.reviewMakerLocked(review); maker != "" && reviewer == maker {
+		return nil, fmt.Errorf("%w: %s requested the held operation and cannot also review it", ErrInvalidRequest, reviewer)
+	}
+
+	now := time.Now()
+	review.Status = status
+	review.Reviewer = reviewer
+	review.Notes = notes
+	review.ResolvedAt = &now
+
+	event := complianceReviewAuditEvent(review, "compliance.review")
+	event.Details["reviewer"] = reviewer
+	if notes != "" {
+		event.Details["notes"] = notes
+	}
+	s.recordAudit(ctx, event)
+
+	reason := "rejected by compliance review"
+	if notes != "" {
+		reason += ": " + notes
+	}
+	switch review.Operation {
+	case ApprovalOperationTransfer:
+		if settlement, exists := s.settlements[review.ResourceID]; exists && settlement.Status == SettlementStatusPendingReview {
+			if status == ComplianceReviewReleased {
+				settlement.Status = SettlementStatusPending
+				// The outcome is recorded on the settlement
+				_ = s.continueTransferLocked(ctx, settlement)
+			} else {
+				settlement.Status = SettlementStatusRejected
+				settlement.Reason = reason
+				s.publishSettlementTransition(ctx, settlement, reason)
+			}
+		}
+	case ApprovalOperationWithdrawal:
+		if withdrawal, exists := s.withdrawals[review.ResourceID]; exists && withdrawal.Status == WithdrawalStatusPendingReview {
+			if status == ComplianceReviewReleased {
+				// The outcome is recorded on the withdrawal
+				_ = s.continueWithdrawalLocked(ctx, withdrawal)
+			} else {
+				withdrawal.Status = WithdrawalStatusRejected
+				withdrawal.Reason = reason
+			}
+		}
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"review_id":   review.ID,
+		"resource_id": review.ResourceID,
+		"status":      status,
+		"reviewer":    reviewer,
+	}).Info("Compliance review resolved")
+
+	result := *review
+	return &result, nil
+}
+
+// reviewMakerLocked returns who requested the operation a review holds, or "" when
+// it records no maker
+func (s *CustodianService) reviewMakerLocked(review *ComplianceReview) string {
+	switch review.Operation {
+	case ApprovalOperationTransfer:
+		if settlement, exists := s.settlements[review.ResourceID]; exists {
+			return settlement.InitiatedBy
+		}
+	case ApprovalOperationWithdrawal:
+		if withdrawal, exists := s.withdrawals[review.ResourceID]; exists {
+			return withdrawal.RequestedBy
+		}
+	}
+	return ""
+}
+
+// cancelComplianceReviewLocked closes a pending review whose operation was withdrawn
+func (s *CustodianService) cancelComplianceReviewLocked(ctx context.Context, review *ComplianceReview, now time.Time) {
+	review.Status = ComplianceReviewCancelled
+	review.ResolvedAt = &now
+	s.recordAudit(ctx, complianceReviewAuditEvent(review, "compliance.review"))
+}
+
+// screenLocked asks the compliance screener about an operation. Rejected operations
+// return ErrComplianceRejected; held ones return the review they wait in.
+func (s *CustodianService) screenLocked(ctx context.Context, subject ports.ComplianceSubject) (*ComplianceReview, error) {
+	if s.screener == nil {
+		return nil, nil
+	}
+
+	decision := s.screener.Screen(ctx, subject)
+	resourceType := "settlement"
+	if subject.Operation == ApprovalOperationWithdrawal {
+		resourceType = "withdrawal"
+	}
+	event := ports.AuditEvent{
+		Action:       "compliance.screen",
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: resourceType,
+		ResourceID:   subject.ResourceID,
+		Details: map[string]string{
+			"operation":    subject.Operation,
+			"from_account": subject.FromAccount,
+			"asset_id":     subject.AssetID,
+			"amount":       formatAmount(subject.Amount),
+			"decision":     decision.Decision,
+		},
+	}
+	if len(decision.Reasons) > 0 {
+		event.Details["reasons"] = strings.Join(decision.Reasons, ",")
+	}
+
+	switch decision.Decision {
+	case ports.ComplianceReject:
+		err := fmt.Errorf("%w: %s", ErrComplianceRejected, strings.Join(decision.Reasons, ", "))
+		event.Outcome = ports.AuditOutcomeFailure
+		event.Error = err.Error()
+		s.recordAudit(ctx, event)
+		s.logger.WithFields(logrus.Fields{
+			"resource_id": subject.ResourceID,
+			"reasons":     decision.Reasons,
+		}).Warn("Operation rejected by compliance screening")
+		return nil, err
+	case ports.ComplianceHold:
+		review := &ComplianceReview{
+			ID:         generateComplianceReviewID(),
+			Operation:  subject.Operation,
+			ResourceID: subject.ResourceID,
+			AccountID:  subject.FromAccount,
+			AssetID:    subject.AssetID,
+			Amount:     subject.Amount,
+			Reasons:    decision.Reasons,
+			Status:     ComplianceReviewPending,
+			CreatedAt:  time.Now(),
+		}
+		s.reviews[review.ID] = review
+		event.Details["review_id"] = review.ID
+		s.recordAudit(ctx, event)
+		s.logger.WithFields(logrus.Fields{
+			"review_id":   review.ID,
+			"resource_id": subject.ResourceID,
+			"reasons":     decision.Reasons,
+		}).Info("Operation held for compliance review")
+		return review, nil
+	default:
+		s.recordAudit(ctx, event)
+		return nil, nil
+	}
+}
+
+func transferComplianceSubject(settlement *Settlement) ports.ComplianceSubject {
+	return ports.ComplianceSubject{
+		Operation:   ApprovalOperationTransfer,
+		ResourceID:  settlement.ID,
+		FromAccount: settlement.FromAccount,
+		ToAccount:   settlement.ToAccount,
+		AssetID:     settlement.AssetID,
+		Amount:      settlement.Amount,
+		TravelRule:  settlement.TravelRule,
+	}
+}
+
+func withdrawalComplianceSubject(withdrawal *Withdrawal) ports.ComplianceSubject {
+	return ports.ComplianceSubject{
+		Operation:   ApprovalOperationWithdrawal,
+		ResourceID:  withdrawal.ID,
+		FromAccount: withdrawal.AccountID,
+		AssetID:     withdrawal.AssetID,
+		Amount:      withdrawal.Amount,
+		Network:     withdrawal.Network,
+		Address:     withdrawal.Address,
+		TravelRule:  withdrawal.TravelRule,
+	}
+}
+
+func complianceReviewAuditEvent(review *ComplianceReview, action string) ports.AuditEvent {
+	return ports.AuditEvent{
+		Action:       action,
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "compliance_review",
+		ResourceID:   review.ID,
+		Details: map[string]string{
+			"operation":   review.Operation,
+			"resource_id": review.ResourceID,
+			"account_id":  review.AccountID,
+			"asset_id":    review.AssetID,
+			"amount":      formatAmount(review.Amount),
+			"reasons":     strings.Join(review.Reasons, ","),
+			"status":      review.Status,
+		},
+	}
+}
+
+func generateComplianceReviewID() string {
+	// Simple ID generation for simulation
+	return fmt.Sprintf("REV_%d", nextIDNanos())
+}
@@ -0,0 +1,184 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestComplianceScreening verifies screened operations are approved, rejected or held for review
+// Following BDD Given/When/Then pattern
+func TestComplianceScreening(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rejected_transfers_never_settle", func(t *testing.T) {
+		// Given: A screener rejecting everything, with an audit trail
+		svc := newTestService(t)
+		audit := &capturingAudit{}
+		svc.SetAuditPort(audit)
+		svc.SetComplianceScreener(fixedScreener{Decision: ports.ComplianceReject, Reasons: []string{"sanctioned_entity"}})
+		from, to := fundedPair(t, svc, "BTC", 5)
+
+		// When: A transfer is made
+		_, err := svc.Transfer(from, to, "BTC", 1)
+
+		// Then: It is rejected and the decision audited
+		if !errors.Is(err, services.ErrComplianceRejected) {
+			t.Errorf("Expected ErrComplianceRejected, got %v", err)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+		event, ok := audit.last("compliance.screen")
+		if !ok || event.Details["decision"] != ports.ComplianceReject || event.Outcome != ports.AuditOutcomeFailure {
+			t.Errorf("Expected an audited rejection, got %+v", event)
+		}
+	})
+
+	t.Run("held_transfers_settle_once_released", func(t *testing.T) {
+		// Given: A screener holding everything
+		svc := newTestService(t)
+		svc.SetComplianceScreener(fixedScreener{Decision: ports.ComplianceHold, Reasons: []string{"travel_rule_missing"}})
+		from, to := fundedPair(t, svc, "BTC", 5)
+
+		// When: A transfer is made
+		id, err := svc.Transfer(from, to, "BTC", 2)
+		if err != nil {
+			t.Fatalf("Transfer failed: %v", err)
+		}
+
+		// Then: It waits in the review queue
+		settlement, _ := svc.GetSettlement(ctx, id)
+		queue := svc.ListComplianceReviews(ctx, services.ComplianceReviewPending)
+		if settlement.Status != services.SettlementStatusPendingReview || len(queue) != 1 || queue[0].ResourceID != id {
+			t.Fatalf("Expected a pending review for %s, got %s / %+v", id, settlement.Status, queue)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+
+		// When: A compliance officer releases it
+		review, err := svc.ReleaseComplianceReview(ctx, settlement.ReviewID, "officer-1", "travel rule data received")
+		if err != nil {
+			t.Fatalf("ReleaseComplianceReview failed: %v", err)
+		}
+
+		// Then: The transfer settles and the reviewer is recorded
+		if review.Status != services.ComplianceReviewReleased || review.Reviewer != "officer-1" {
+			t.Errorf("Unexpected review %+v", review)
+		}
+		assertBalance(t, svc, to, "BTC", 2)
+	})
+
+	t.Run("rejected_reviews_abandon_withdrawals", func(t *testing.T) {
+		// Given: A held withdrawal to a whitelisted address
+		svc := newTestService(t)
+		svc.SetComplianceScreener(fixedScreener{Decision: ports.ComplianceHold})
+		from, _ := fundedPair(t, svc, "ETH", 5)
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "ETH", Address: "0xabc"})
+		withdrawal, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "ETH", Amount: 1, Address: "0xabc"})
+		if err != nil || withdrawal.Status != services.WithdrawalStatusPendingReview {
+			t.Fatalf("Expected a held withdrawal, got %+v / %v", withdrawal, err)
+		}
+
+		// When: The review is rejected
+		if _, err := svc.RejectComplianceReview(ctx, withdrawal.ReviewID, "officer-1", "unexplained source of funds"); err != nil {
+			t.Fatalf("RejectComplianceReview failed: %v", err)
+		}
+
+		// Then: The withdrawal is rejected and the funds stay
+		withdrawal, _ = svc.GetWithdrawal(ctx, withdrawal.ID)
+		if withdrawal.Status != services.WithdrawalStatusRejected {
+			t.Errorf("Expected rejected, got %s", withdrawal.Status)
+		}
+		assertBalance(t, svc, from, "ETH", 5)
+	})
+
+	t.Run("makers_cannot_release_their_own_held_operations", func(t *testing.T) {
+		// Given: A held instruction submitted by officer-1
+		svc := newTestService(t)
+		svc.SetComplianceScreener(fixedScreener{Decision: ports.ComplianceHold})
+		from, to := fundedPair(t, svc, "BTC", 5)
+		settlement, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{
+			FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 2, InitiatedBy: "officer-1", SettlementDate: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("SubmitSettlementInstruction failed: %v", err)
+		}
+
+		// When: officer-1 tries to release it
+		_, err = svc.ReleaseComplianceReview(ctx, settlement.ReviewID, "officer-1", "")
+
+		// Then: The release is refused and the review stays pending
+		if !errors.Is(err, services.ErrInvalidRequest) {
+			t.Fatalf("Expected ErrInvalidRequest, got %v", err)
+		}
+		review, _ := svc.GetComplianceReview(ctx, settlement.ReviewID)
+		if review.Status != services.ComplianceReviewPending {
+			t.Errorf("Expected the review to stay pending, got %s", review.Status)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+
+		// And: Another officer can release it
+		if _, err := svc.ReleaseComplianceReview(ctx, settlement.ReviewID, "officer-2", ""); err != nil {
+			t.Fatalf("ReleaseComplianceReview failed: %v", err)
+		}
+		assertBalance(t, svc, to, "BTC", 2)
+	})
+
+	t.Run("settlement_instructions_are_held_until_released", func(t *testing.T) {
+		// Given: A screener holding everything
+		svc := newTestService(t)
+		svc.SetComplianceScreener(fixedScreener{Decision: ports.ComplianceHold})
+		from, to := fundedPair(t, svc, "BTC", 5)
+
+		// When: An instruction due now is submitted
+		settlement, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{
+			FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 2, SettlementDate: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("SubmitSettlementInstruction failed: %v", err)
+		}
+
+		// Then: It waits for review, even when the scheduler runs
+		svc.ProcessDueSettlements(ctx, time.Now())
+		settlement, _ = svc.GetSettlement(ctx, settlement.ID)
+		if settlement.Status != services.SettlementStatusPendingReview || settlement.ReviewID == "" {
+			t.Fatalf("Expected pending_review with a review, got %+v", settlement)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+
+		// And: It settles once released
+		_, _ = svc.ReleaseComplianceReview(ctx, settlement.ReviewID, "officer-1", "")
+		assertBalance(t, svc, to, "BTC", 2)
+	})
+
+	t.Run("rejected_counterparty_settlements_are_not_stored", func(t *testing.T) {
+		// Given: A screener rejecting everything and SSIs for both counterparties
+		svc := newTestService(t)
+		svc.SetComplianceScreener(fixedScreener{Decision: ports.ComplianceReject, Reasons: []string{"sanctioned_entity"}})
+		from, to := fundedPair(t, svc, "USD", 1000)
+		_, _ = svc.PutStandingSettlementInstruction(ctx, services.StandingSettlementInstruction{Counterparty: "FUND_X", AssetID: "USD", AccountID: from}, 0)
+		_, _ = svc.PutStandingSettlementInstruction(ctx, services.StandingSettlementInstruction{Counterparty: "BROKER_Y", AssetID: "USD", AccountID: to}, 0)
+
+		// When: A counterparty settlement is submitted
+		_, err := svc.SubmitCounterpartySettlement(ctx, "FUND_X", "BROKER_Y", "USD", 250, "alice")
+
+		// Then: It is rejected and nothing is stored
+		if !errors.Is(err, services.ErrComplianceRejected) {
+			t.Errorf("Expected ErrComplianceRejected, got %v", err)
+		}
+		if list := svc.ListSettlements(ctx, ""); len(list) != 0 {
+			t.Errorf("Expected no settlements, got %+v", list)
+		}
+	})
+}
+
+// fixedScreener returns the same decision for every operation
+type fixedScreener ports.ComplianceDecision
+
+func (s fixedScreener) Screen(ctx context.Context, subject ports.ComplianceSubject) ports.ComplianceDecision {
+	return ports.ComplianceDecision(s)
+}
@@ -3,12 +3,35 @@ package services
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Account statuses
+const (
+	AccountStatusActive    = "active"
+	AccountStatusSuspended = "suspended"
+	AccountStatusClosed    = "closed"
+)
+
+// Settlement statuses
+const (
+	SettlementStatusPendingReview   = "pending_review"   // Held by compliance screening; see compliance.go
+	SettlementStatusPendingApproval = "pending_approval" // Held for the approval policy's quorum; see approvals.go
+	SettlementStatusPending         = "pending"
+	SettlementStatusCompleted       = "completed"
+	SettlementStatusFailed          = "failed"  // Retried until FailDeadline when one is set
+	SettlementStatusExpired         = "expired" // Still failing at its FailDeadline, or not approved in time
+	SettlementStatusCancelled       = "cancelled"
+	SettlementStatusRejected        = "rejected" // Rejected by an approver or compliance review
 )
 
 type CustodianService struct {
@@ -20,26 +43,126 @@ type CustodianService struct {
 	mu       sync.RWMutex
 	accounts map[string]*Account
 	balances map[string]map[string]float64 // accountID -> assetID -> balance
+	holds    map[string]*Hold
+
+	// Settlement instructions and their outcomes, by settlement ID
+	settlements map[string]*Settlement
+
+	// Standing settlement instructions by counterparty/asset, oldest version first
+	ssis map[string][]*StandingSettlementInstruction
+
+	// One side of a bilateral settlement each, by instruction ID
+	matchingInstructions map[string]*MatchingInstruction
+
+	// Business days and cut-offs per asset; nil settles every day with no cut-off
+	calendars *BusinessCalendars
+
+	// Withdrawals to external addresses, by withdrawal ID
+	withdrawals map[string]*Withdrawal
+
+	// Whitelisted withdrawal destinations by account, oldest first
+	withdrawalAddresses map[string][]*WithdrawalAddress
+
+	// Operations held for approval, by approval ID; nil policy approves nothing
+	approvals      map[string]*ApprovalRequest
+	approvalPolicy *ApprovalPolicy
+
+	// Per-transaction and velocity limits, and the outflows counted against them by
+	// account/asset; nil limits allow any amount
+	transferLimits *TransferLimits
+	outflows       map[string][]outflow
+
+	// Compliance screening of transfers and withdrawals (optional), and the
+	// operations it held for review by review ID
+	screener ports.ComplianceScreenerPort
+	reviews  map[string]*ComplianceReview
+
+	// Simulated blockchains by network, and their transactions by hash; withdrawals
+	// to other networks complete immediately
+	chainSim  *ChainSimulation
+	chains    map[string]*simulatedChain
+	chainTxs  map[string]*ChainTransaction
+	chainRand *rand.Rand
+
+	// Simulated fiat rails and their payments by ID; nil rails move fiat instantly
+	fiatRails    *FiatRails
+	fiatPayments map[string]*FiatPayment
+	fiatRand     *rand.Rand
+	fiatSeq      int
+
+	// Hot, warm and cold holdings of tiered assets by asset and tier, and the
+	// rebalance operations between them by ID; nil tiering pays withdrawals at once
+	walletTiering  *WalletTiering
+	walletBalances map[string]map[string]float64
+	rebalances     map[string]*RebalanceOperation
+
+	// Account event fan-out
+	events *AccountEventBroker
+
+	// Outbound settlement notifications (optional)
+	notifier ports.SettlementNotifierPort
+
+	// Audit trail of state-changing operations (optional)
+	audit ports.AuditPort
+
+	// Domain events for other services (optional)
+	publisher ports.DomainEventPublisherPort
 }
 
 type Account struct {
-	ID          string            `json:"id"`
-	Type        string            `json:"type"`
-	Status      string            `json:"status"`
-	Balances    map[string]float64 `json:"balances"`
-	CreatedAt   time.Time         `json:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at"`
+	ID        string             `json:"id"`
+	Type      string             `json:"type"`
+	Status    string             `json:"status"`
+	Balances  map[string]float64 `json:"balances"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
 }
 
 type Settlement struct {
-	ID            string    `json:"id"`
-	FromAccount   string    `json:"from_account"`
-	ToAccount     string    `json:"to_account"`
-	AssetID       string    `json:"asset_id"`
-	Amount        float64   `json:"amount"`
-	Status        string    `json:"status"`
+	ID             string    `json:"id"`
+	FromAccount    string    `json:"from_account"`
+	ToAccount      string    `json:"to_account"`
+	AssetID        string    `json:"asset_id"`
+	Amount         float64   `json:"amount"`
+	Status         string    `json:"status"`
 	SettlementDate time.Time `json:"settlement_date"`
-	CreatedAt     time.Time `json:"created_at"`
+	CreatedAt      time.Time `json:"created_at"`
+	TradeID        string    `json:"trade_id,omitempty"` // Set for instructions created from exchange trades
+	Reason         string    `json:"reason,omitempty"`   // Why the settlement failed
+	AllowPartial   bool      `json:"allow_partial,omitempty"`
+	InitiatedBy    string    `json:"initiated_by,omitempty"` // The maker, for the approval policy
+	ApprovalID     string    `json:"approval_id,omitempty"`  // Set when the approval policy applied
+	ReviewID       string    `json:"review_id,omitempty"`    // Set when compliance screening held it
+
+	// Originator and beneficiary for compliance screening (optional)
+	TravelRule *ports.TravelRuleData `json:"travel_rule,omitempty"`
+
+	// Failure handling; see settlement_fails.go
+	ReasonCode    string     `json:"reason_code,omitempty"`
+	SettledAmount float64    `json:"settled_amount"` // Amount moved so far, below Amount after a partial settlement
+	Attempts      int        `json:"attempts"`
+	FailedAt      *time.Time `json:"failed_at,omitempty"`     // First failure
+	FailDeadline  *time.Time `json:"fail_deadline,omitempty"` // Retries stop after this
+	ResolvedAt    *time.Time `json:"resolved_at,omitempty"`   // Completed, expired or cancelled after failing, or failed with no retries
+
+	// Cancellation and amendment requests, oldest first; see settlement_amendments.go
+	Amendments []SettlementAmendment `json:"amendments,omitempty"`
+
+	// Set for settlements created by counterparty through standing settlement instructions
+	FromCounterparty string `json:"from_counterparty,omitempty"`
+	ToCounterparty   string `json:"to_counterparty,omitempty"`
+	FromSSIVersion   int    `json:"from_ssi_version,omitempty"`
+	ToSSIVersion     int    `json:"to_ssi_version,omitempty"`
+}
+
+// Hold reserves part of an account balance so it cannot be settled elsewhere
+type Hold struct {
+	ID        string    `json:"id"`
+	AccountID string    `json:"account_id"`
+	AssetID   string    `json:"asset_id"`
+	Amount    float64   `json:"amount"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 func NewCustodianService(cfg *config.Config, logger *logrus.Logger) *CustodianService {
@@ -49,9 +172,56 @@ func NewCustodianService(cfg *config.Config, logger *logrus.Logger) *CustodianSe
 		startTime: time.Now(),
 		accounts:  make(map[string]*Account),
 		balances:  make(map[string]map[string]float64),
+		holds:     make(map[string]*Hold),
+		events:    NewAccountEventBroker(cfg.AccountEventBufferSize),
+
+		settlements: make(map[string]*Settlement),
+		ssis:        make(map[string][]*StandingSettlementInstruction),
+
+		matchingInstructions: make(map[string]*MatchingInstruction),
+
+		withdrawals: make(map[string]*Withdrawal),
+		approvals:   make(map[string]*ApprovalRequest),
+
+		withdrawalAddresses: make(map[string][]*WithdrawalAddress),
+		outflows:            make(map[string][]outflow),
+		chains:              make(map[string]*simulatedChain),
+		chainTxs:            make(map[string]*ChainTransaction),
+		fiatPayments:        make(map[string]*FiatPayment),
+		walletBalances:      make(map[string]map[string]float64),
+		rebalances:          make(map[string]*RebalanceOperation),
+		reviews:             make(map[string]*ComplianceReview),
 	}
 }
 
+// SetSettlementNotifier registers the port told about every settlement status change
+func (s *CustodianService) SetSettlementNotifier(notifier ports.SettlementNotifierPort) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.notifier = notifier
+}
+
+// SetAuditPort registers the port that records every state-changing operation
+func (s *CustodianService) SetAuditPort(audit ports.AuditPort) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.audit = audit
+}
+
+// SetDomainEventPublisher registers the port that publishes domain events to other services
+func (s *CustodianService) SetDomainEventPublisher(publisher ports.DomainEventPublisherPort) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.publisher = publisher
+}
+
+// SetBusinessCalendars registers the calendars used to compute settlement dates
+func (s *CustodianService) SetBusinessCalendars(calendars *BusinessCalendars) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calendars = calendars
+}
+
 func (s *CustodianService) GetHealth(ctx context.Context) (string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -81,6 +251,13 @@ func (s *CustodianService) GetBalance(account string, asset string) (float64, er
 	return balances[asset], nil
 }
 
+// Transfer settles amount between two accounts now, through the same controls as
+// settlement instructions but without waiting for the asset's business day. It is
+// refused, and nothing stored, when it breaks the sender's transfer limits or is
+// otherwise invalid, or when compliance screening rejects it. Once stored, its ID
+// is returned with a nil error whatever the outcome: a transfer that fails is kept
+// on the settlement, with its reason, for retry, and one held for review or
+// approval settles when released; see GetSettlement.
 func (s *CustodianService) Transfer(fromAccount, toAccount, asset string, amount float64) (string, error) {
 	s.logger.WithFields(logrus.Fields{
 		"fromAccount": fromAccount,
@@ -89,25 +266,59 @@ func (s *CustodianService) Transfer(fromAccount, toAccount, asset string, amount
 		"amount":      amount,
 	}).Info("Processing transfer")
 
-	settlement := &Settlement{
-		ID:            generateSettlementID(),
-		FromAccount:   fromAccount,
-		ToAccount:     toAccount,
-		AssetID:       asset,
-		Amount:        amount,
-		Status:        "completed",
-		SettlementDate: time.Now(),
-		CreatedAt:     time.Now(),
+	if err := s.checkUnilateralSettlement(); err != nil {
+		return "", err
 	}
+	if amount <= 0 {
+		return "", fmt.Errorf("%w: settlement amount must be positive", ErrInvalidRequest)
+	}
+
+	ctx := context.Background()
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	err := s.ProcessSettlement(context.Background(), settlement)
+	now := time.Now()
+	if err := s.checkTransferLimitsLocked(ctx, fromAccount, asset, amount, now); err != nil {
+		return "", err
+	}
+	settlement, err := s.submitSettlementLocked(ctx, Settlement{
+		FromAccount:    fromAccount,
+		ToAccount:      toAccount,
+		AssetID:        asset,
+		Amount:         amount,
+		SettlementDate: now,
+	})
 	if err != nil {
 		return "", err
 	}
-
 	return settlement.ID, nil
 }
 
+// ProcessSettlement stores a settlement and settles it now unless it is dated later,
+// writing the outcome back to settlement. Settlements breaking the sender's
+// transfer limits are refused with ErrLimitExceeded and not stored; a settlement
+// that fails when processed is kept for retry and its error returned.
+func (s *CustodianService) ProcessSettlement(ctx context.Context, settlement *Settlement) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if err := s.checkTransferLimitsLocked(ctx, settlement.FromAccount, settlement.AssetID, settlement.Amount, now); err != nil {
+		return err
+	}
+	if settlement.SettlementDate.IsZero() {
+		settlement.SettlementDate = now
+	}
+	stored, review, err := s.storeSettlementLocked(ctx, *settlement)
+	if err != nil {
+		return err
+	}
+
+	err = s.admitTransferLocked(ctx, stored, review)
+	*settlement = *stored
+	return err
+}
+
 func (s *CustodianService) CreateAccount(ctx context.Context, accountType string) (*Account, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -115,7 +326,7 @@ func (s *CustodianService) CreateAccount(ctx context.Context, accountType string
 	account := &Account{
 		ID:        generateAccountID(),
 		Type:      accountType,
-		Status:    "active",
+		Status:    AccountStatusActive,
 		Balances:  make(map[string]float64),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -124,6 +335,19 @@ func (s *CustodianService) CreateAccount(ctx context.Context, accountType string
 	s.accounts[account.ID] = account
 	s.balances[account.ID] = make(map[string]float64)
 
+	s.publishDomainEvent(ctx, ports.DomainEventAccountCreated, account.ID, ports.AccountCreatedData{
+		AccountID:   account.ID,
+		AccountType: account.Type,
+		Status:      account.Status,
+	})
+	s.recordAudit(ctx, ports.AuditEvent{
+		Action:       "account.create",
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "account",
+		ResourceID:   account.ID,
+		Details:      map[string]string{"account_type": accountType},
+	})
+
 	s.logger.WithFields(logrus.Fields{
 		"account_id": account.ID,
 		"type":       accountType,
@@ -132,42 +356,279 @@ func (s *CustodianService) CreateAccount(ctx context.Context, accountType string
 	return account, nil
 }
 
-func (s *CustodianService) ProcessSettlement(ctx context.Context, settlement *Settlement) error {
+// GetAccount returns a copy of the account record
+func (s *CustodianService) GetAccount(ctx context.Context, accountID string) (*Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	account, exists := s.accounts[accountID]
+	if !exists {
+		return nil, fmt.Errorf("account %s %w", accountID, ErrNotFound)
+	}
+
+	snapshot := *account
+	snapshot.Balances = make(map[string]float64, len(s.balances[accountID]))
+	for assetID, balance := range s.balances[accountID] {
+		snapshot.Balances[assetID] = balance
+	}
+
+	return &snapshot, nil
+}
+
+// Deposit credits an account with an external inflow of an asset
+func (s *CustodianService) Deposit(ctx context.Context, accountID, assetID string, amount float64) (float64, error) {
+	if amount <= 0 {
+		return 0, fmt.Errorf("%w: deposit amount must be positive", ErrInvalidRequest)
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// Verify accounts exist
-	fromAccount, exists := s.accounts[settlement.FromAccount]
+	account, exists := s.accounts[accountID]
 	if !exists {
-		return fmt.Errorf("from account %s not found", settlement.FromAccount)
+		return 0, fmt.Errorf("account %s %w", accountID, ErrNotFound)
+	}
+	if account.Status != AccountStatusActive {
+		return 0, fmt.Errorf("%w: account %s is %s", ErrAccountInactive, accountID, account.Status)
 	}
 
-	toAccount, exists := s.accounts[settlement.ToAccount]
+	before := s.auditBalanceLocked(accountID, assetID)
+
+	balances := s.balances[accountID]
+	balances[assetID] += amount
+	account.UpdatedAt = time.Now()
+	s.creditHotWalletLocked(assetID, amount)
+
+	s.publishBalanceChange(ctx, accountID, assetID, amount, balances[assetID], "")
+	s.recordAudit(ctx, ports.AuditEvent{
+		Action:       "account.deposit",
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "account",
+		ResourceID:   accountID,
+		Balances:     []ports.AuditBalance{s.completeAuditBalanceLocked(before)},
+		Details:      map[string]string{"amount": formatAmount(amount)},
+	})
+
+	s.logger.WithFields(logrus.Fields{
+		"account_id": accountID,
+		"asset_id":   assetID,
+		"amount":     amount,
+	}).Info("Deposit credited")
+
+	return balances[assetID], nil
+}
+
+// UpdateAccountStatus moves an account between active, suspended and closed
+func (s *CustodianService) UpdateAccountStatus(ctx context.Context, accountID, status string) error {
+	switch status {
+	case AccountStatusActive, AccountStatusSuspended, AccountStatusClosed:
+	default:
+		return fmt.Errorf("%w: invalid account status %q", ErrInvalidRequest, status)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, exists := s.accounts[accountID]
 	if !exists {
-		return fmt.Errorf("to account %s not found", settlement.ToAccount)
+		return fmt.Errorf("account %s %w", accountID, ErrNotFound)
+	}
+	if account.Status == AccountStatusClosed {
+		return fmt.Errorf("%w: account %s is closed", ErrAccountInactive, accountID)
+	}
+	if account.Status == status {
+		return nil
 	}
 
-	// Check balance
-	fromBalances := s.balances[settlement.FromAccount]
-	if fromBalances[settlement.AssetID] < settlement.Amount {
-		return fmt.Errorf("insufficient balance in account %s for asset %s",
-			settlement.FromAccount, settlement.AssetID)
+	previous := account.Status
+	account.Status = status
+	account.UpdatedAt = time.Now()
+
+	s.events.Publish(AccountEvent{
+		Type:           AccountEventAccountStatusChanged,
+		AccountID:      accountID,
+		PreviousStatus: previous,
+		AccountStatus:  status,
+	})
+	s.recordAudit(ctx, ports.AuditEvent{
+		Action:       "account.status_change",
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "account",
+		ResourceID:   accountID,
+		Details:      map[string]string{"previous_status": previous, "status": status},
+	})
+
+	s.logger.WithFields(logrus.Fields{
+		"account_id":      accountID,
+		"previous_status": previous,
+		"status":          status,
+	}).Info("Account status changed")
+
+	return nil
+}
+
+// PlaceHold reserves part of the available balance of an asset
+func (s *CustodianService) PlaceHold(ctx context.Context, accountID, assetID string, amount float64, reason string) (*Hold, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w: hold amount must be positive", ErrInvalidRequest)
 	}
 
-	// Process settlement
-	fromBalances[settlement.AssetID] -= settlement.Amount
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	toBalances := s.balances[settlement.ToAccount]
-	if toBalances == nil {
-		toBalances = make(map[string]float64)
-		s.balances[settlement.ToAccount] = toBalances
+	if _, exists := s.accounts[accountID]; !exists {
+		return nil, fmt.Errorf("account %s %w", accountID, ErrNotFound)
 	}
-	toBalances[settlement.AssetID] += settlement.Amount
 
-	// Update account timestamps
-	now := time.Now()
-	fromAccount.UpdatedAt = now
-	toAccount.UpdatedAt = now
+	if s.availableBalanceLocked(accountID, assetID) < amount {
+		return nil, fmt.Errorf("%w in account %s for asset %s", ErrInsufficientBalance, accountID, assetID)
+	}
+
+	before := s.auditBalanceLocked(accountID, assetID)
+	hold := &Hold{
+		ID:        generateHoldID(),
+		AccountID: accountID,
+		AssetID:   assetID,
+		Amount:    amount,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+	s.holds[hold.ID] = hold
+
+	s.events.Publish(AccountEvent{
+		Type:       AccountEventHoldPlaced,
+		AccountID:  accountID,
+		AssetID:    assetID,
+		HoldID:     hold.ID,
+		HoldAmount: amount,
+		Reason:     reason,
+	})
+	s.recordAudit(ctx, ports.AuditEvent{
+		Action:       "hold.place",
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "hold",
+		ResourceID:   hold.ID,
+		Balances:     []ports.AuditBalance{s.completeAuditBalanceLocked(before)},
+		Details:      map[string]string{"amount": formatAmount(amount), "reason": reason},
+	})
+
+	s.logger.WithFields(logrus.Fields{
+		"hold_id":    hold.ID,
+		"account_id": accountID,
+		"asset_id":   assetID,
+		"amount":     amount,
+	}).Info("Hold placed")
+
+	return hold, nil
+}
+
+// ReleaseHold returns held funds to the available balance
+func (s *CustodianService) ReleaseHold(ctx context.Context, holdID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hold, exists := s.holds[holdID]
+	if !exists {
+		return fmt.Errorf("hold %s %w", holdID, ErrNotFound)
+	}
+	before := s.auditBalanceLocked(hold.AccountID, hold.AssetID)
+	delete(s.holds, holdID)
+
+	s.events.Publish(AccountEvent{
+		Type:       AccountEventHoldReleased,
+		AccountID:  hold.AccountID,
+		AssetID:    hold.AssetID,
+		HoldID:     hold.ID,
+		HoldAmount: hold.Amount,
+	})
+	s.recordAudit(ctx, ports.AuditEvent{
+		Action:       "hold.release",
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "hold",
+		ResourceID:   hold.ID,
+		Balances:     []ports.AuditBalance{s.completeAuditBalanceLocked(before)},
+		Details:      map[string]string{"amount": formatAmount(hold.Amount)},
+	})
+
+	s.logger.WithFields(logrus.Fields{
+		"hold_id":    hold.ID,
+		"account_id": hold.AccountID,
+	}).Info("Hold released")
+
+	return nil
+}
+
+// checkUnilateralSettlement refuses settlements instructed by one side alone when
+// settlement matching is required
+func (s *CustodianService) checkUnilateralSettlement() error {
+	if s.config.SettlementMatchingRequired {
+		return fmt.Errorf("%w: settlements between accounts must be instructed by both counterparties and matched", ErrInvalidRequest)
+	}
+	return nil
+}
+
+// admitTransferLocked holds a stored transfer in pending_review when screening
+// opened a review for it, and otherwise continues it
+func (s *CustodianService) admitTransferLocked(ctx context.Context, settlement *Settlement, review *ComplianceReview) error {
+	if review != nil {
+		settlement.Status = SettlementStatusPendingReview
+		settlement.ReviewID = review.ID
+		s.publishSettlementTransition(ctx, settlement, "")
+		return nil
+	}
+	return s.continueTransferLocked(ctx, settlement)
+}
+
+// continueTransferLocked holds a screened transfer for approval when the policy
+// requires it, and otherwise processes it once due
+func (s *CustodianService) continueTransferLocked(ctx context.Context, settlement *Settlement) error {
+	if request := s.requireApprovalLocked(ctx, ApprovalOperationTransfer, settlement.ID, settlement.FromAccount, settlement.AssetID, settlement.Amount, settlement.InitiatedBy); request != nil {
+		settlement.Status = SettlementStatusPendingApproval
+		settlement.ApprovalID = request.ID
+		s.publishSettlementTransition(ctx, settlement, "")
+		return nil
+	}
+
+	if settlement.SettlementDate.After(time.Now()) {
+		return nil
+	}
+	return s.processSettlementLocked(ctx, settlement)
+}
+
+// processSettlementLocked moves the outstanding funds of a stored settlement and
+// records the outcome. Failures are kept on the settlement for retry; see
+// failSettlementLocked.
+func (s *CustodianService) processSettlementLocked(ctx context.Context, settlement *Settlement) error {
+	before := []ports.AuditBalance{
+		s.auditBalanceLocked(settlement.FromAccount, settlement.AssetID),
+		s.auditBalanceLocked(settlement.ToAccount, settlement.AssetID),
+	}
+	settlement.Attempts++
+
+	if err := s.applySettlementLocked(ctx, settlement, settlement.Amount-settlement.SettledAmount); err != nil {
+		partial := s.settlePartiallyLocked(ctx, settlement, err)
+		var balances []ports.AuditBalance
+		if partial {
+			balances = []ports.AuditBalance{
+				s.completeAuditBalanceLocked(before[0]),
+				s.completeAuditBalanceLocked(before[1]),
+			}
+		}
+		return s.failSettlementLocked(ctx, settlement, err, partial, balances)
+	}
+
+	wasFailed := settlement.Status == SettlementStatusFailed
+	settlement.SettledAmount = settlement.Amount
+	settlement.Status = SettlementStatusCompleted
+	if wasFailed {
+		now := time.Now()
+		settlement.ResolvedAt = &now
+	}
+	s.publishSettlementTransition(ctx, settlement, "")
+	s.recordAudit(ctx, settlementAuditEvent(settlement, []ports.AuditBalance{
+		s.completeAuditBalanceLocked(before[0]),
+		s.completeAuditBalanceLocked(before[1]),
+	}, nil))
 
 	s.logger.WithFields(logrus.Fields{
 		"settlement_id": settlement.ID,
@@ -185,7 +646,7 @@ func (s *CustodianService) GetAccountBalance(ctx context.Context, accountID, ass
 	defer s.mu.RUnlock()
 
 	if _, exists := s.accounts[accountID]; !exists {
-		return 0, fmt.Errorf("account %s not found", accountID)
+		return 0, fmt.Errorf("account %s %w", accountID, ErrNotFound)
 	}
 
 	balances := s.balances[accountID]
@@ -196,12 +657,271 @@ func (s *CustodianService) GetAccountBalance(ctx context.Context, accountID, ass
 	return balances[assetID], nil
 }
 
+// GetAvailableBalance returns the balance not reserved by holds
+func (s *CustodianService) GetAvailableBalance(ctx context.Context, accountID, assetID string) (float64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, exists := s.accounts[accountID]; !exists {
+		return 0, fmt.Errorf("account %s %w", accountID, ErrNotFound)
+	}
+
+	return s.availableBalanceLocked(accountID, assetID), nil
+}
+
+// SubscribeAccountEvents subscribes to events for the given accounts
+// See AccountEventBroker.Subscribe for resume semantics
+func (s *CustodianService) SubscribeAccountEvents(accountIDs []string, resumeAfter *uint64) (*AccountEventSubscription, error) {
+	if len(accountIDs) == 0 {
+		return nil, fmt.Errorf("%w: at least one account is required", ErrInvalidRequest)
+	}
+
+	return s.events.Subscribe(accountIDs, resumeAfter)
+}
+
+// applySettlementLocked moves amount of the settlement's asset between its accounts
+func (s *CustodianService) applySettlementLocked(ctx context.Context, settlement *Settlement, amount float64) error {
+	if amount <= 0 {
+		return fmt.Errorf("%w: settlement amount must be positive", ErrInvalidRequest)
+	}
+
+	// Verify accounts exist
+	fromAccount, exists := s.accounts[settlement.FromAccount]
+	if !exists {
+		return fmt.Errorf("from account %s %w", settlement.FromAccount, ErrNotFound)
+	}
+
+	toAccount, exists := s.accounts[settlement.ToAccount]
+	if !exists {
+		return fmt.Errorf("to account %s %w", settlement.ToAccount, ErrNotFound)
+	}
+
+	if fromAccount.Status != AccountStatusActive {
+		return fmt.Errorf("%w: from account %s is %s", ErrAccountInactive, settlement.FromAccount, fromAccount.Status)
+	}
+	if toAccount.Status != AccountStatusActive {
+		return fmt.Errorf("%w: to account %s is %s", ErrAccountInactive, settlement.ToAccount, toAccount.Status)
+	}
+
+	// Every settlement debits here, however it was submitted
+	now := time.Now()
+	if err := s.transferLimitErrorLocked(settlement.FromAccount, settlement.AssetID, amount, now); err != nil {
+		return err
+	}
+
+	// Check balance (held funds are not available for settlement)
+	if s.availableBalanceLocked(settlement.FromAccount, settlement.AssetID) < amount {
+		return fmt.Errorf("%w in account %s for asset %s",
+			ErrInsufficientBalance, settlement.FromAccount, settlement.AssetID)
+	}
+
+	// Process settlement
+	fromBalances := s.balances[settlement.FromAccount]
+	fromBalances[settlement.AssetID] -= amount
+
+	toBalances := s.balances[settlement.ToAccount]
+	if toBalances == nil {
+		toBalances = make(map[string]float64)
+		s.balances[settlement.ToAccount] = toBalances
+	}
+	toBalances[settlement.AssetID] += amount
+
+	// Update account timestamps
+	fromAccount.UpdatedAt = now
+	toAccount.UpdatedAt = now
+	s.recordOutflowLocked(settlement.FromAccount, settlement.AssetID, amount, now)
+
+	s.publishBalanceChange(ctx, settlement.FromAccount, settlement.AssetID, -amount, fromBalances[settlement.AssetID], settlement.ID)
+	s.publishBalanceChange(ctx, settlement.ToAccount, settlement.AssetID, amount, toBalances[settlement.AssetID], settlement.ID)
+
+	return nil
+}
+
+func (s *CustodianService) availableBalanceLocked(accountID, assetID string) float64 {
+	available := s.balances[accountID][assetID]
+	for _, hold := range s.holds {
+		if hold.AccountID == accountID && hold.AssetID == assetID {
+			available -= hold.Amount
+		}
+	}
+	return available
+}
+
+func (s *CustodianService) publishBalanceChange(ctx context.Context, accountID, assetID string, delta, balance float64, settlementID string) {
+	s.events.Publish(AccountEvent{
+		Type:         AccountEventBalanceChanged,
+		AccountID:    accountID,
+		AssetID:      assetID,
+		Delta:        delta,
+		Balance:      balance,
+		SettlementID: settlementID,
+	})
+	s.publishDomainEvent(ctx, ports.DomainEventBalanceChanged, accountID, ports.BalanceChangedData{
+		AccountID:    accountID,
+		AssetID:      assetID,
+		Delta:        delta,
+		Balance:      balance,
+		SettlementID: settlementID,
+	})
+}
+
+// publishSettlementTransition notifies both counterparties of a settlement status change,
+// and other services through the settlement notifier when one is registered
+func (s *CustodianService) publishSettlementTransition(ctx context.Context, settlement *Settlement, reason string) {
+	for _, accountID := range []string{settlement.FromAccount, settlement.ToAccount} {
+		s.events.Publish(AccountEvent{
+			Type:             AccountEventSettlementStatusChanged,
+			AccountID:        accountID,
+			AssetID:          settlement.AssetID,
+			SettlementID:     settlement.ID,
+			SettlementStatus: settlement.Status,
+			Reason:           reason,
+		})
+	}
+
+	eventType := ""
+	switch settlement.Status {
+	case SettlementStatusCompleted:
+		eventType = ports.DomainEventSettlementSettled
+	case SettlementStatusFailed:
+		eventType = ports.DomainEventSettlementFailed
+	case SettlementStatusExpired:
+		eventType = ports.DomainEventSettlementExpired
+	case SettlementStatusCancelled:
+		eventType = ports.DomainEventSettlementCancelled
+	}
+	if eventType != "" {
+		s.publishDomainEvent(ctx, eventType, settlement.ID, ports.SettlementEventData{
+			SettlementID:  settlement.ID,
+			FromAccountID: settlement.FromAccount,
+			ToAccountID:   settlement.ToAccount,
+			AssetID:       settlement.AssetID,
+			Amount:        settlement.Amount,
+			SettledAmount: settlement.SettledAmount,
+			Status:        settlement.Status,
+			Reason:        reason,
+			ReasonCode:    settlement.ReasonCode,
+		})
+	}
+
+	if s.notifier == nil {
+		return
+	}
+
+	// The notifier only records to its outbox here; delivery happens asynchronously
+	err := s.notifier.NotifySettlement(ctx, ports.SettlementNotification{
+		SettlementID:  settlement.ID,
+		Status:        settlement.Status,
+		FromAccountID: settlement.FromAccount,
+		ToAccountID:   settlement.ToAccount,
+		AssetID:       settlement.AssetID,
+		Amount:        settlement.Amount,
+		Reason:        reason,
+		OccurredAt:    time.Now(),
+	})
+	if err != nil {
+		s.logger.WithError(err).WithField("settlement_id", settlement.ID).Error("Failed to record settlement notification")
+	}
+}
+
+// publishDomainEvent hands an event to the domain event publisher, if one is registered
+func (s *CustodianService) publishDomainEvent(ctx context.Context, eventType, aggregateID string, data interface{}) {
+	if s.publisher == nil {
+		return
+	}
+	s.publisher.PublishDomainEvent(ctx, ports.DomainEvent{
+		Type:        eventType,
+		AggregateID: aggregateID,
+		Data:        data,
+	})
+}
+
+// recordAudit hands an event to the audit port, if one is registered
+func (s *CustodianService) recordAudit(ctx context.Context, event ports.AuditEvent) {
+	if s.audit == nil {
+		return
+	}
+	s.audit.Record(ctx, event)
+}
+
+// auditBalanceLocked captures the current position for the "before" half of an audit balance
+func (s *CustodianService) auditBalanceLocked(accountID, assetID string) ports.AuditBalance {
+	return ports.AuditBalance{
+		AccountID:       accountID,
+		AssetID:         assetID,
+		BalanceBefore:   s.balances[accountID][assetID],
+		AvailableBefore: s.availableBalanceLocked(accountID, assetID),
+	}
+}
+
+// completeAuditBalanceLocked fills in the "after" half of a captured audit balance
+func (s *CustodianService) completeAuditBalanceLocked(before ports.AuditBalance) ports.AuditBalance {
+	before.BalanceAfter = s.balances[before.AccountID][before.AssetID]
+	before.AvailableAfter = s.availableBalanceLocked(before.AccountID, before.AssetID)
+	return before
+}
+
+func settlementAuditEvent(settlement *Settlement, balances []ports.AuditBalance, err error) ports.AuditEvent {
+	event := ports.AuditEvent{
+		Action:       "settlement.process",
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "settlement",
+		ResourceID:   settlement.ID,
+		Balances:     balances,
+		Details: map[string]string{
+			"from_account": settlement.FromAccount,
+			"to_account":   settlement.ToAccount,
+			"asset_id":     settlement.AssetID,
+			"amount":       formatAmount(settlement.Amount),
+			"status":       settlement.Status,
+		},
+	}
+	if settlement.ReasonCode != "" {
+		event.Details["reason_code"] = settlement.ReasonCode
+	}
+	if settlement.SettledAmount > 0 && settlement.SettledAmount < settlement.Amount {
+		event.Details["settled_amount"] = formatAmount(settlement.SettledAmount)
+	}
+	if err != nil {
+		event.Outcome = ports.AuditOutcomeFailure
+		event.Error = err.Error()
+	}
+	return event
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
+
+// lastIDNanos is the timestamp behind the last generated ID
+var lastIDNanos atomic.Int64
+
+// nextIDNanos returns the current Unix nanoseconds for an ID, moved past the last
+// one handed out so IDs generated within the same clock tick never collide
+func nextIDNanos() int64 {
+	for {
+		last := lastIDNanos.Load()
+		next := time.Now().UnixNano()
+		if next <= last {
+			next = last + 1
+		}
+		if lastIDNanos.CompareAndSwap(last, next) {
+			return next
+		}
+	}
+}
+
 func generateAccountID() string {
 	// Simple ID generation for simulation
-	return fmt.Sprintf("ACCT_%d", time.Now().UnixNano())
+	return fmt.Sprintf("ACCT_%d", nextIDNanos())
 }
 
 func generateSettlementID() string {
 	// Simple ID generation for simulation
-	return fmt.Sprintf("SETTLE_%d", time.Now().UnixNano())
-}
\ No newline at end of file
+	return fmt.Sprintf("SETTLE_%d", nextIDNanos())
+}
+
+func generateHoldID() string {
+	// Simple ID generation for simulation
+	return fmt.Sprintf("HOLD_%d", nextIDNanos())
+}
@@ -0,0 +1,18 @@
+package services
+
+import "errors"
+
+// Sentinel errors wrapped by CustodianService operations
+// Transports map them to protocol status codes with errors.Is
+var (
+	ErrNotFound              = errors.New("not found")
+	ErrInvalidRequest        = errors.New("invalid request")
+	ErrInsufficientBalance   = errors.New("insufficient balance")
+	ErrAccountInactive       = errors.New("account inactive")
+	ErrAlreadyExists         = errors.New("already exists")
+	ErrVersionConflict       = errors.New("version conflict")
+	ErrSettlementInProgress  = errors.New("settlement in progress")
+	ErrAddressNotWhitelisted = errors.New("address not whitelisted")
+	ErrLimitExceeded         = errors.New("transfer limit exceeded")
+	ErrComplianceRejected    = errors.New("rejected by compliance screening")
+)
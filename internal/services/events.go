@@ -0,0 +1,202 @@
+package services
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+type AccountEventType string
+
+const (
+	AccountEventBalanceChanged          AccountEventType = "balance_changed"
+	AccountEventSettlementStatusChanged AccountEventType = "settlement_status_changed"
+	AccountEventHoldPlaced              AccountEventType = "hold_placed"
+	AccountEventHoldReleased            AccountEventType = "hold_released"
+	AccountEventAccountStatusChanged    AccountEventType = "account_status_changed"
+)
+
+const (
+	defaultAccountEventBufferSize = 10000
+	subscriberChannelSize         = 256
+)
+
+// ErrEventsUnavailable is returned when a subscriber asks to resume from a sequence
+// that has already been evicted from the replay buffer
+var ErrEventsUnavailable = errors.New("requested events are no longer buffered")
+
+// ErrSubscriberTooSlow is reported on a subscription that was dropped because it
+// could not keep up; the subscriber should reconnect using its last sequence
+var ErrSubscriberTooSlow = errors.New("subscriber could not keep up with event rate")
+
+// AccountEvent describes a state change of a single custody account
+// Only the fields relevant to Type are populated
+type AccountEvent struct {
+	Sequence  uint64           `json:"sequence"`
+	Type      AccountEventType `json:"type"`
+	AccountID string           `json:"account_id"`
+	Timestamp time.Time        `json:"timestamp"`
+
+	// Balance and hold events
+	AssetID string  `json:"asset_id,omitempty"`
+	Delta   float64 `json:"delta,omitempty"`
+	Balance float64 `json:"balance,omitempty"`
+
+	// Settlement events
+	SettlementID     string `json:"settlement_id,omitempty"`
+	SettlementStatus string `json:"settlement_status,omitempty"`
+	Reason           string `json:"reason,omitempty"`
+
+	// Hold events
+	HoldID     string  `json:"hold_id,omitempty"`
+	HoldAmount float64 `json:"hold_amount,omitempty"`
+
+	// Account status events
+	PreviousStatus string `json:"previous_status,omitempty"`
+	AccountStatus  string `json:"account_status,omitempty"`
+}
+
+// AccountEventBroker assigns sequence numbers to account events, keeps a bounded
+// replay buffer and fans events out to subscribers
+type AccountEventBroker struct {
+	mu          sync.Mutex
+	sequence    uint64
+	buffer      []AccountEvent // Ring of the latest events, oldest at head once full
+	head        int
+	bufferSize  int
+	subscribers map[*AccountEventSubscription]struct{}
+}
+
+// AccountEventSubscription delivers events for a fixed set of accounts
+// Replay holds buffered events to send before reading from Events
+type AccountEventSubscription struct {
+	Replay []AccountEvent
+	Events <-chan AccountEvent
+
+	events   chan AccountEvent
+	accounts map[string]bool
+	broker   *AccountEventBroker
+	err      error
+	closed   bool
+}
+
+func NewAccountEventBroker(bufferSize int) *AccountEventBroker {
+	if bufferSize <= 0 {
+		bufferSize = defaultAccountEventBufferSize
+	}
+
+	return &AccountEventBroker{
+		buffer:      make([]AccountEvent, 0, bufferSize),
+		bufferSize:  bufferSize,
+		subscribers: make(map[*AccountEventSubscription]struct{}),
+	}
+}
+
+// Publish stamps the event with the next sequence number and delivers it
+// Subscribers that cannot keep up are dropped rather than blocking the publisher
+func (b *AccountEventBroker) Publish(event AccountEvent) AccountEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.sequence++
+	event.Sequence = b.sequence
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	if len(b.buffer) < b.bufferSize {
+		b.buffer = append(b.buffer, event)
+	} else {
+		b.buffer[b.head] = event
+		b.head = (b.head + 1) % b.bufferSize
+	}
+
+	for sub := range b.subscribers {
+		if !sub.accounts[event.AccountID] {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			b.closeLocked(sub, ErrSubscriberTooSlow)
+		}
+	}
+
+	return event
+}
+
+// Subscribe registers a subscriber for the given accounts
+// When resumeAfter is non-nil, buffered events with a greater sequence are returned in Replay
+func (b *AccountEventBroker) Subscribe(accountIDs []string, resumeAfter *uint64) (*AccountEventSubscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	accounts := make(map[string]bool, len(accountIDs))
+	for _, id := range accountIDs {
+		accounts[id] = true
+	}
+
+	var replay []AccountEvent
+	if resumeAfter != nil && *resumeAfter > b.sequence {
+		// Sequences restart with the process, so a cursor from the future cannot be honoured
+		return nil, ErrEventsUnavailable
+	}
+	if resumeAfter != nil && *resumeAfter < b.sequence {
+		// The oldest buffered event must directly follow the last one the subscriber saw
+		if len(b.buffer) == 0 || b.buffer[b.head].Sequence > *resumeAfter+1 {
+			return nil, ErrEventsUnavailable
+		}
+
+		for i := range b.buffer {
+			event := b.buffer[(b.head+i)%len(b.buffer)]
+			if event.Sequence > *resumeAfter && accounts[event.AccountID] {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	events := make(chan AccountEvent, subscriberChannelSize)
+	sub := &AccountEventSubscription{
+		Replay:   replay,
+		Events:   events,
+		events:   events,
+		accounts: accounts,
+		broker:   b,
+	}
+	b.subscribers[sub] = struct{}{}
+
+	return sub, nil
+}
+
+// CurrentSequence returns the sequence number of the most recently published event
+func (b *AccountEventBroker) CurrentSequence() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.sequence
+}
+
+// Close unregisters the subscription and closes its Events channel
+func (s *AccountEventSubscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.closeLocked(s, nil)
+}
+
+// Err reports why the Events channel was closed (nil if closed by the subscriber)
+func (s *AccountEventSubscription) Err() error {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	return s.err
+}
+
+func (b *AccountEventBroker) closeLocked(sub *AccountEventSubscription, err error) {
+	if sub.closed {
+		return
+	}
+
+	sub.closed = true
+	sub.err = err
+	delete(b.subscribers, sub)
+	close(sub.events)
+}
@@ -0,0 +1,193 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestAccountEventBroker verifies sequencing, filtering and resume semantics
+// Following BDD Given/When/Then pattern
+func TestAccountEventBroker(t *testing.T) {
+	t.Run("delivers_only_subscribed_accounts", func(t *testing.T) {
+		// Given: A broker with a subscriber for one account
+		broker := services.NewAccountEventBroker(10)
+		sub, err := broker.Subscribe([]string{"ACCT_A"}, nil)
+		if err != nil {
+			t.Fatalf("Subscribe failed: %v", err)
+		}
+		defer sub.Close()
+
+		// When: Events are published for two accounts
+		broker.Publish(services.AccountEvent{Type: services.AccountEventBalanceChanged, AccountID: "ACCT_B"})
+		broker.Publish(services.AccountEvent{Type: services.AccountEventBalanceChanged, AccountID: "ACCT_A"})
+
+		// Then: Only the subscribed account's event is delivered, with its global sequence
+		event := <-sub.Events
+		if event.AccountID != "ACCT_A" || event.Sequence != 2 {
+			t.Errorf("Expected ACCT_A event with sequence 2, got %s/%d", event.AccountID, event.Sequence)
+		}
+		if len(sub.Events) != 0 {
+			t.Errorf("Expected no further events, got %d", len(sub.Events))
+		}
+	})
+
+	t.Run("replays_missed_events_on_resume", func(t *testing.T) {
+		// Given: A broker that has published three events
+		broker := services.NewAccountEventBroker(10)
+		for i := 0; i < 3; i++ {
+			broker.Publish(services.AccountEvent{Type: services.AccountEventBalanceChanged, AccountID: "ACCT_A"})
+		}
+
+		// When: A subscriber resumes after sequence 1
+		resumeAfter := uint64(1)
+		sub, err := broker.Subscribe([]string{"ACCT_A"}, &resumeAfter)
+		if err != nil {
+			t.Fatalf("Subscribe failed: %v", err)
+		}
+		defer sub.Close()
+
+		// Then: Sequences 2 and 3 are replayed
+		if len(sub.Replay) != 2 || sub.Replay[0].Sequence != 2 || sub.Replay[1].Sequence != 3 {
+			t.Errorf("Expected replay of sequences 2 and 3, got %+v", sub.Replay)
+		}
+	})
+
+	t.Run("rejects_resume_from_evicted_sequence", func(t *testing.T) {
+		// Given: A broker whose buffer has already evicted the oldest events
+		broker := services.NewAccountEventBroker(2)
+		for i := 0; i < 5; i++ {
+			broker.Publish(services.AccountEvent{Type: services.AccountEventBalanceChanged, AccountID: "ACCT_A"})
+		}
+
+		// When: A subscriber resumes after sequence 1
+		resumeAfter := uint64(1)
+		_, err := broker.Subscribe([]string{"ACCT_A"}, &resumeAfter)
+
+		// Then: The gap is reported instead of silently skipping events
+		if !errors.Is(err, services.ErrEventsUnavailable) {
+			t.Errorf("Expected ErrEventsUnavailable, got %v", err)
+		}
+	})
+
+	t.Run("replays_in_order_after_the_buffer_wraps", func(t *testing.T) {
+		// Given: A broker with room for three events that has published five
+		broker := services.NewAccountEventBroker(3)
+		for i := 0; i < 5; i++ {
+			broker.Publish(services.AccountEvent{Type: services.AccountEventBalanceChanged, AccountID: "ACCT_A"})
+		}
+
+		// When: A subscriber resumes after sequence 2, the last evicted event
+		resumeAfter := uint64(2)
+		sub, err := broker.Subscribe([]string{"ACCT_A"}, &resumeAfter)
+		if err != nil {
+			t.Fatalf("Subscribe failed: %v", err)
+		}
+		defer sub.Close()
+
+		// Then: The buffered sequences 3 to 5 are replayed oldest first
+		if len(sub.Replay) != 3 {
+			t.Fatalf("Expected 3 replayed events, got %d", len(sub.Replay))
+		}
+		for i, event := range sub.Replay {
+			if event.Sequence != uint64(i+3) {
+				t.Errorf("Expected sequence %d at %d, got %d", i+3, i, event.Sequence)
+			}
+		}
+	})
+
+	t.Run("drops_subscribers_that_fall_behind", func(t *testing.T) {
+		// Given: A subscriber that never reads
+		broker := services.NewAccountEventBroker(1000)
+		sub, err := broker.Subscribe([]string{"ACCT_A"}, nil)
+		if err != nil {
+			t.Fatalf("Subscribe failed: %v", err)
+		}
+
+		// When: More events are published than the subscriber can buffer
+		for i := 0; i < 500; i++ {
+			broker.Publish(services.AccountEvent{Type: services.AccountEventBalanceChanged, AccountID: "ACCT_A"})
+		}
+
+		// Then: The subscription is closed with ErrSubscriberTooSlow
+		for range sub.Events {
+		}
+		if !errors.Is(sub.Err(), services.ErrSubscriberTooSlow) {
+			t.Errorf("Expected ErrSubscriberTooSlow, got %v", sub.Err())
+		}
+	})
+}
+
+func TestCustodianService_AccountEvents(t *testing.T) {
+	t.Run("publishes_balance_settlement_hold_and_status_events", func(t *testing.T) {
+		// Given: A custodian service with two funded accounts
+		svc := newTestService(t)
+		ctx := context.Background()
+
+		from, _ := svc.CreateAccount(ctx, "trading")
+		to, _ := svc.CreateAccount(ctx, "trading")
+
+		sub, err := svc.SubscribeAccountEvents([]string{from.ID}, nil)
+		if err != nil {
+			t.Fatalf("Subscribe failed: %v", err)
+		}
+		defer sub.Close()
+
+		// When: The account is funded, settles, places a hold and is suspended
+		if _, err := svc.Deposit(ctx, from.ID, "BTC", 10); err != nil {
+			t.Fatalf("Deposit failed: %v", err)
+		}
+		if _, err := svc.Transfer(from.ID, to.ID, "BTC", 4); err != nil {
+			t.Fatalf("Transfer failed: %v", err)
+		}
+		if _, err := svc.PlaceHold(ctx, from.ID, "BTC", 1, "pending withdrawal"); err != nil {
+			t.Fatalf("PlaceHold failed: %v", err)
+		}
+		if err := svc.UpdateAccountStatus(ctx, from.ID, services.AccountStatusSuspended); err != nil {
+			t.Fatalf("UpdateAccountStatus failed: %v", err)
+		}
+
+		// Then: The subscriber sees each change in order
+		expected := []services.AccountEventType{
+			services.AccountEventBalanceChanged,          // deposit
+			services.AccountEventSettlementStatusChanged, // pending
+			services.AccountEventBalanceChanged,          // settlement debit
+			services.AccountEventSettlementStatusChanged, // completed
+			services.AccountEventHoldPlaced,
+			services.AccountEventAccountStatusChanged,
+		}
+		for i, want := range expected {
+			event := <-sub.Events
+			if event.Type != want {
+				t.Errorf("Event %d: expected %s, got %s", i, want, event.Type)
+			}
+		}
+	})
+
+	t.Run("held_funds_are_not_available_for_settlement", func(t *testing.T) {
+		// Given: An account with a balance partly on hold
+		svc := newTestService(t)
+		ctx := context.Background()
+
+		from, _ := svc.CreateAccount(ctx, "trading")
+		to, _ := svc.CreateAccount(ctx, "trading")
+		_, _ = svc.Deposit(ctx, from.ID, "ETH", 10)
+		_, _ = svc.PlaceHold(ctx, from.ID, "ETH", 8, "collateral")
+
+		// When: A settlement exceeds the unheld balance
+		id, err := svc.Transfer(from.ID, to.ID, "ETH", 5)
+		if err != nil {
+			t.Fatalf("Transfer failed: %v", err)
+		}
+
+		// Then: It fails with insufficient balance
+		settlement, _ := svc.GetSettlement(ctx, id)
+		if settlement.Status != services.SettlementStatusFailed || settlement.ReasonCode != services.FailReasonInsufficientBalance {
+			t.Errorf("Expected failed with %s, got %s with %s", services.FailReasonInsufficientBalance, settlement.Status, settlement.ReasonCode)
+		}
+	})
+}
@@ -0,0 +1,430 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Fiat payment directions
+const (
+	FiatDirectionDeposit    = "deposit"
+	FiatDirectionWithdrawal = "withdrawal"
+)
+
+// Fiat payment statuses
+const (
+	FiatPaymentQueued    = "queued"    // Waiting for the rail's next batch window
+	FiatPaymentSubmitted = "submitted" // Sent in a batch, waiting for the settlement date
+	FiatPaymentCompleted = "completed"
+	FiatPaymentReturned  = "returned" // Returned by the receiving bank with a return code
+)
+
+// FiatReturnCodes are the ACH-style return codes the simulated rails use
+var FiatReturnCodes = map[string]string{
+	"R01": "insufficient funds",
+	"R02": "account closed",
+	"R03": "no account or unable to locate account",
+	"R04": "invalid account number",
+	"R16": "account frozen",
+}
+
+// FiatRail is a simulated payment rail that moves payments in batches on business
+// days and settles them a number of business days later
+type FiatRail struct {
+	Name           string          `json:"name"`
+	BatchWindows   []time.Duration `json:"batch_windows"`   // Offsets from midnight UTC, ascending
+	SettlementDays int             `json:"settlement_days"` // 0 settles at the batch
+}
+
+// FiatRails configures the simulated fiat rails. Payments are batched only on the
+// asset's business days and before its cut-off.
+type FiatRails struct {
+	Rails          map[string]FiatRail
+	Assets         map[string]bool // Assets that move over the rails
+	DefaultRail    string          // Used when a withdrawal names no rail
+	ReturnAccounts map[string]string
+	ReturnRate     float64 // Chance any other payment is returned
+	Seed           int64   // 0 seeds from the clock
+}
+
+// FiatPayment is a deposit or withdrawal on a simulated fiat rail
+type FiatPayment struct {
+	ID          string     `json:"id"`
+	Reference   string     `json:"reference"`
+	Rail        string     `json:"rail"`
+	Direction   string     `json:"direction"`
+	ResourceID  string     `json:"resource_id,omitempty"` // Withdrawal ID for withdrawals
+	AccountID   string     `json:"account_id"`
+	AssetID     string     `json:"asset_id"`
+	Amount      float64    `json:"amount"`
+	BankAccount string     `json:"bank_account"` // Beneficiary for withdrawals, originator for deposits
+	Status      string     `json:"status"`
+	ReturnCode  string     `json:"return_code,omitempty"`
+	SubmittedAt time.Time  `json:"submitted_at"`
+	BatchAt     time.Time  `json:"batch_at"`
+	SettlesAt   time.Time  `json:"settles_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// NewFiatRails builds the simulated fiat rails in cfg, or returns nil when they are
+// disabled
+func NewFiatRails(cfg *config.Config) (*FiatRails, error) {
+	if !cfg.FiatRailsEnabled {
+		return nil, nil
+	}
+
+	windows, err := parsePairs(cfg.FiatRailBatchWindows)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fiat rail batch windows: %w", err)
+	}
+	settlementDays, err := parsePairs(cfg.FiatRailSettlementDays)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fiat rail settlement days: %w", err)
+	}
+	returnAccounts, err := parsePairs(cfg.FiatRailReturnAccounts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fiat rail return accounts: %w", err)
+	}
+	for account, code := range returnAccounts {
+		if _, known := FiatReturnCodes[code]; !known {
+			return nil, fmt.Errorf("unknown return code %q for %s", code, account)
+		}
+	}
+
+	rails := &FiatRails{
+		Rails:          make(map[string]FiatRail),
+		Assets:         make(map[string]bool),
+		DefaultRail:    cfg.FiatRailDefault,
+		ReturnAccounts: returnAccounts,
+		ReturnRate:     cfg.FiatRailReturnRate,
+		Seed:           int64(cfg.FiatRailSeed),
+	}
+	for name, spec := range windows {
+		rail := FiatRail{Name: name}
+		for _, window := range strings.Split(spec, ",") {
+			offset, err := parseCutOff(strings.TrimSpace(window))
+			if err != nil {
+				return nil, fmt.Errorf("invalid batch window for %s: %w", name, err)
+			}
+			rail.BatchWindows = append(rail.BatchWindows, offset)
+		}
+		sort.Slice(rail.BatchWindows, func(i, j int) bool { return rail.BatchWindows[i] < rail.BatchWindows[j] })
+
+		if value, ok := settlementDays[name]; ok {
+			if rail.SettlementDays, err = strconv.Atoi(value); err != nil || rail.SettlementDays < 0 {
+				return nil, fmt.Errorf("invalid settlement days %q for %s", value, name)
+			}
+		}
+		rails.Rails[name] = rail
+	}
+	if _, exists := rails.Rails[rails.DefaultRail]; !exists {
+		return nil, fmt.Errorf("default fiat rail %q has no batch windows", rails.DefaultRail)
+	}
+	for _, asset := range strings.Split(cfg.FiatRailAssets, ",") {
+		if asset = strings.TrimSpace(asset); asset != "" {
+			rails.Assets[asset] = true
+		}
+	}
+	return rails, nil
+}
+
+// railFor returns the rail a withdrawal over network moves on. Fiat assets default
+// to the default rail when the network is the asset itself.
+func (r *FiatRails) railFor(network, assetID string) (FiatRail, bool) {
+	if r == nil || !r.Assets[assetID] {
+		return FiatRail{}, false
+	}
+	if network == assetID {
+		network = r.DefaultRail
+	}
+	rail, exists := r.Rails[network]
+	return rail, exists
+}
+
+// SetFiatRails registers the simulated fiat rails; nil removes them and fiat
+// withdrawals complete immediately again
+func (s *CustodianService) SetFiatRails(rails *FiatRails) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fiatRails = rails
+	if rails == nil {
+		return
+	}
+
+	seed := rails.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	s.fiatRand = rand.New(rand.NewSource(seed))
+}
+
+// SubmitFiatDeposit queues an inbound payment to an account on a fiat rail. The
+// account is credited on the settlement date unless the payment is returned.
+func (s *CustodianService) SubmitFiatDeposit(ctx context.Context, payment FiatPayment) (*FiatPayment, error) {
+	if payment.AccountID == "" || payment.AssetID == "" || payment.BankAccount == "" {
+		return nil, fmt.Errorf("%w: account_id, asset_id and bank_account are required", ErrInvalidRequest)
+	}
+	if payment.Amount <= 0 {
+		return nil, fmt.Errorf("%w: deposit amount must be positive", ErrInvalidRequest)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.accounts[payment.AccountID]; !exists {
+		return nil, fmt.Errorf("account %s %w", payment.AccountID, ErrNotFound)
+	}
+	if payment.Rail == "" {
+		payment.Rail = payment.AssetID
+	}
+	rail, exists := s.fiatRails.railFor(payment.Rail, payment.AssetID)
+	if !exists {
+		return nil, fmt.Errorf("%w: %s cannot move over rail %s", ErrInvalidRequest, payment.AssetID, payment.Rail)
+	}
+
+	payment.Direction = FiatDirectionDeposit
+	payment.ResourceID = ""
+	stored := s.queueFiatPaymentLocked(rail, payment, time.Now())
+
+	result := *stored
+	return &result, nil
+}
+
+// GetFiatPayment returns a copy of a fiat payment by ID
+func (s *CustodianService) GetFiatPayment(ctx context.Context, paymentID string) (*FiatPayment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	payment, exists := s.fiatPayments[paymentID]
+	if !exists {
+		return nil, fmt.Errorf("fiat payment %s %w", paymentID, ErrNotFound)
+	}
+
+	result := *payment
+	return &result, nil
+}
+
+// ProcessFiatRails sends payments whose batch window has come and settles those
+// whose settlement date has, returning how many changed status
+func (s *CustodianService) ProcessFiatRails(ctx context.Context, now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	due := make([]*FiatPayment, 0)
+	for _, payment := range s.fiatPayments {
+		if (payment.Status == FiatPaymentQueued && !payment.BatchAt.After(now)) ||
+			(payment.Status == FiatPaymentSubmitted && !payment.SettlesAt.After(now)) {
+			due = append(due, payment)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].Reference < due[j].Reference })
+
+	for _, payment := range due {
+		if payment.Status == FiatPaymentQueued {
+			payment.Status = FiatPaymentSubmitted
+			if code := s.fiatReturnCodeLocked(payment); code != "" {
+				s.returnFiatPaymentLocked(ctx, payment, code, now)
+				continue
+			}
+		}
+		if !payment.SettlesAt.After(now) {
+			s.completeFiatPaymentLocked(ctx, payment, now)
+		}
+	}
+	return len(due)
+}
+
+// queueFiatPaymentLocked schedules a payment for the rail's next batch window and
+// its settlement date
+func (s *CustodianService) queueFiatPaymentLocked(rail FiatRail, payment FiatPayment, now time.Time) *FiatPayment {
+	s.fiatSeq++
+	payment.ID = generateFiatPaymentID()
+	payment.Reference = fmt.Sprintf("%s%s%06d", strings.ToUpper(rail.Name), now.UTC().Format("20060102"), s.fiatSeq)
+	payment.Rail = rail.Name
+	payment.Status = FiatPaymentQueued
+	payment.ReturnCode, payment.CompletedAt = "", nil
+	payment.SubmittedAt = now
+	payment.BatchAt = s.nextFiatBatchLocked(rail, payment.AssetID, now)
+	payment.SettlesAt = payment.BatchAt
+	if rail.SettlementDays > 0 {
+		date := payment.BatchAt.Truncate(24 * time.Hour)
+		for i := 0; i < rail.SettlementDays; i++ {
+			date = s.calendars.nextBusinessDay(date, payment.AssetID)
+		}
+		payment.SettlesAt = date
+	}
+
+	stored := &payment
+	s.fiatPayments[stored.ID] = stored
+
+	s.logger.WithFields(logrus.Fields{
+		"payment_id": stored.ID,
+		"reference":  stored.Reference,
+		"rail":       stored.Rail,
+		"direction":  stored.Direction,
+		"batch_at":   stored.BatchAt,
+		"settles_at": stored.SettlesAt,
+	}).Info("Fiat payment queued")
+
+	return stored
+}
+
+// nextFiatBatchLocked returns the rail's first batch window after t on a business
+// day of the asset, skipping windows at or past the asset's cut-off
+func (s *CustodianService) nextFiatBatchLocked(rail FiatRail, assetID string, t time.Time) time.Time {
+	cutOff := s.calendars.Calendar(assetID).CutOff
+	day := t.UTC().Truncate(24 * time.Hour)
+	// A year of days is plenty for any calendar
+	for i := 0; i < 366; i++ {
+		if s.calendars.IsBusinessDay(day, assetID) {
+			for _, window := range rail.BatchWindows {
+				if cutOff > 0 && window >= cutOff {
+					break
+				}
+				if batch := day.Add(window); batch.After(t) {
+					return batch
+				}
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+// fiatReturnCodeLocked decides whether the receiving bank returns a payment
+func (s *CustodianService) fiatReturnCodeLocked(payment *FiatPayment) string {
+	if code, listed := s.fiatRails.ReturnAccounts[payment.BankAccount]; listed {
+		return code
+	}
+	if s.fiatRand.Float64() >= s.fiatRails.ReturnRate {
+		return ""
+	}
+
+	codes := make([]string, 0, len(FiatReturnCodes))
+	for code := range FiatReturnCodes {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes[s.fiatRand.Intn(len(codes))]
+}
+
+// completeFiatPaymentLocked credits a settled deposit or completes a settled
+// withdrawal
+func (s *CustodianService) completeFiatPaymentLocked(ctx context.Context, payment *FiatPayment, now time.Time) {
+	payment.Status = FiatPaymentCompleted
+	payment.CompletedAt = &now
+
+	switch payment.Direction {
+	case FiatDirectionDeposit:
+		before := s.auditBalanceLocked(payment.AccountID, payment.AssetID)
+		balances := s.balances[payment.AccountID]
+		balances[payment.AssetID] += payment.Amount
+		s.creditHotWalletLocked(payment.AssetID, payment.Amount)
+		if account, exists := s.accounts[payment.AccountID]; exists {
+			account.UpdatedAt = now
+		}
+
+		s.publishBalanceChange(ctx, payment.AccountID, payment.AssetID, payment.Amount, balances[payment.AssetID], "")
+		s.recordAudit(ctx, ports.AuditEvent{
+			Action:       "account.deposit",
+			Outcome:      ports.AuditOutcomeSuccess,
+			ResourceType: "account",
+			ResourceID:   payment.AccountID,
+			Balances:     []ports.AuditBalance{s.completeAuditBalanceLocked(before)},
+			Details:      fiatPaymentAuditDetails(payment),
+		})
+	case FiatDirectionWithdrawal:
+		withdrawal, exists := s.withdrawals[payment.ResourceID]
+		if !exists {
+			break
+		}
+		withdrawal.Status = WithdrawalStatusCompleted
+		withdrawal.CompletedAt = &now
+
+		event := withdrawalAuditEvent(withdrawal)
+		event.Action = "withdrawal.confirm"
+		event.Details["status"] = withdrawal.Status
+		for key, value := range fiatPaymentAuditDetails(payment) {
+			event.Details[key] = value
+		}
+		s.recordAudit(ctx, event)
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"payment_id": payment.ID,
+		"reference":  payment.Reference,
+		"direction":  payment.Direction,
+	}).Info("Fiat payment settled")
+}
+
+// returnFiatPaymentLocked records a return. Deposits are never credited; returned
+// withdrawals are credited back to the account.
+func (s *CustodianService) returnFiatPaymentLocked(ctx context.Context, payment *FiatPayment, code string, now time.Time) {
+	payment.Status = FiatPaymentReturned
+	payment.ReturnCode = code
+	payment.CompletedAt = &now
+	reason := fmt.Sprintf("returned %s: %s", code, FiatReturnCodes[code])
+
+	event := ports.AuditEvent{
+		Action:       "fiat_payment.return",
+		Outcome:      ports.AuditOutcomeFailure,
+		ResourceType: "fiat_payment",
+		ResourceID:   payment.ID,
+		Error:        reason,
+		Details:      fiatPaymentAuditDetails(payment),
+	}
+	if withdrawal, exists := s.withdrawals[payment.ResourceID]; exists && payment.Direction == FiatDirectionWithdrawal {
+		before := s.auditBalanceLocked(payment.AccountID, payment.AssetID)
+		balances := s.balances[payment.AccountID]
+		balances[payment.AssetID] += payment.Amount
+		s.creditHotWalletLocked(payment.AssetID, payment.Amount)
+		withdrawal.Status = WithdrawalStatusReturned
+		withdrawal.Reason = reason
+		withdrawal.CompletedAt = &now
+
+		s.publishBalanceChange(ctx, payment.AccountID, payment.AssetID, payment.Amount, balances[payment.AssetID], "")
+		event.Balances = []ports.AuditBalance{s.completeAuditBalanceLocked(before)}
+	}
+	s.recordAudit(ctx, event)
+
+	s.logger.WithFields(logrus.Fields{
+		"payment_id":  payment.ID,
+		"reference":   payment.Reference,
+		"direction":   payment.Direction,
+		"return_code": code,
+	}).Warn("Fiat payment returned")
+}
+
+func fiatPaymentAuditDetails(payment *FiatPayment) map[string]string {
+	details := map[string]string{
+		"payment_id":   payment.ID,
+		"reference":    payment.Reference,
+		"rail":         payment.Rail,
+		"direction":    payment.Direction,
+		"account_id":   payment.AccountID,
+		"asset_id":     payment.AssetID,
+		"amount":       formatAmount(payment.Amount),
+		"bank_account": payment.BankAccount,
+		"status":       payment.Status,
+	}
+	if payment.ReturnCode != "" {
+		details["return_code"] = payment.ReturnCode
+	}
+	return details
+}
+
+func generateFiatPaymentID() string {
+	// Simple ID generation for simulation
+	return fmt.Sprintf("FIAT_%d", nextIDNanos())
+}
@@ -0,0 +1,114 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestFiatRails verifies fiat deposits and withdrawals move in batches on business days
+// Following BDD Given/When/Then pattern
+func TestFiatRails(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ach_deposit_is_credited_on_the_next_business_day", func(t *testing.T) {
+		// Given: ACH settling one business day after its batch
+		svc := newTestService(t, withFiatRails(""))
+		calendars, _ := services.NewBusinessCalendars(testConfig(withFiatRails("")))
+		account, _ := svc.CreateAccount(ctx, "TRADING")
+
+		// When: A USD deposit arrives over ACH
+		payment, err := svc.SubmitFiatDeposit(ctx, services.FiatPayment{AccountID: account.ID, AssetID: "USD", Amount: 1000, Rail: "ach", BankAccount: "123456789"})
+		if err != nil {
+			t.Fatalf("SubmitFiatDeposit failed: %v", err)
+		}
+
+		// Then: It is queued for a batch on a business day and settles on a later one
+		if payment.Status != services.FiatPaymentQueued || !strings.HasPrefix(payment.Reference, "ACH") {
+			t.Errorf("Expected a queued ACH payment, got %+v", payment)
+		}
+		if !calendars.IsBusinessDay(payment.BatchAt, "USD") || !calendars.IsBusinessDay(payment.SettlesAt, "USD") ||
+			!payment.SettlesAt.After(payment.BatchAt) {
+			t.Errorf("Expected batch and settlement on successive business days, got %v and %v", payment.BatchAt, payment.SettlesAt)
+		}
+
+		// And: The batch sends it without crediting the account
+		svc.ProcessFiatRails(ctx, payment.BatchAt)
+		sent, _ := svc.GetFiatPayment(ctx, payment.ID)
+		if sent.Status != services.FiatPaymentSubmitted {
+			t.Errorf("Expected submitted, got %s", sent.Status)
+		}
+		assertBalance(t, svc, account.ID, "USD", 0)
+
+		// And: The settlement date credits it
+		svc.ProcessFiatRails(ctx, payment.SettlesAt)
+		assertBalance(t, svc, account.ID, "USD", 1000)
+	})
+
+	t.Run("wire_withdrawal_completes_at_its_batch", func(t *testing.T) {
+		// Given: USD withdrawals defaulting to same-day wires
+		svc := newTestService(t, withFiatRails(""))
+		from, _ := fundedPair(t, svc, "USD", 5000)
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "USD", Address: "987654321"})
+
+		// When: 2000 USD is withdrawn
+		withdrawal, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "USD", Amount: 2000, Address: "987654321"})
+		if err != nil {
+			t.Fatalf("Withdraw failed: %v", err)
+		}
+
+		// Then: It is debited and processing with a wire reference
+		if withdrawal.Status != services.WithdrawalStatusProcessing || !strings.HasPrefix(withdrawal.Reference, "FEDWIRE") {
+			t.Errorf("Expected a processing wire, got %+v", withdrawal)
+		}
+		assertBalance(t, svc, from, "USD", 3000)
+
+		// And: The batch completes it
+		payment, _ := svc.GetFiatPayment(ctx, withdrawal.PaymentID)
+		svc.ProcessFiatRails(ctx, payment.BatchAt)
+		completed, _ := svc.GetWithdrawal(ctx, withdrawal.ID)
+		if completed.Status != services.WithdrawalStatusCompleted {
+			t.Errorf("Expected completed, got %s", completed.Status)
+		}
+	})
+
+	t.Run("returned_withdrawal_is_credited_back", func(t *testing.T) {
+		// Given: A beneficiary bank account that returns payments as R03
+		svc := newTestService(t, withFiatRails("000111=R03"))
+		from, _ := fundedPair(t, svc, "USD", 5000)
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "USD", Address: "000111"})
+		withdrawal, _ := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "USD", Amount: 2000, Address: "000111"})
+
+		// When: Its batch is sent
+		payment, _ := svc.GetFiatPayment(ctx, withdrawal.PaymentID)
+		svc.ProcessFiatRails(ctx, payment.BatchAt)
+
+		// Then: The withdrawal is returned with the code and the funds are back
+		returned, _ := svc.GetWithdrawal(ctx, withdrawal.ID)
+		if returned.Status != services.WithdrawalStatusReturned || !strings.Contains(returned.Reason, "R03") {
+			t.Errorf("Expected returned with R03, got %+v", returned)
+		}
+		payment, _ = svc.GetFiatPayment(ctx, withdrawal.PaymentID)
+		if payment.ReturnCode != "R03" {
+			t.Errorf("Expected return code R03, got %q", payment.ReturnCode)
+		}
+		assertBalance(t, svc, from, "USD", 5000)
+	})
+
+	t.Run("unknown_return_codes_are_rejected", func(t *testing.T) {
+		// Given: A configured return code that does not exist
+		cfg := testConfig(withFiatRails("000111=R99"))
+
+		// When: The rails are built
+		_, err := services.NewFiatRails(cfg)
+
+		// Then: The configuration is refused
+		if err == nil {
+			t.Error("Expected an error for return code R99")
+		}
+	})
+}
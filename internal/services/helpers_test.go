@@ -0,0 +1,201 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// testOption adjusts the configuration a test service is built from
+type testOption func(*config.Config)
+
+// newTestService creates a custodian service that logs nowhere, with the calendars,
+// approval policy, transfer limits, chain simulation, fiat rails and wallet tiers
+// its configuration describes, installed as the server installs them
+func newTestService(t *testing.T, opts ...testOption) *services.CustodianService {
+	t.Helper()
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	cfg := testConfig(opts...)
+	svc := services.NewCustodianService(cfg, logger)
+	installPolicies(t, svc, cfg)
+	return svc
+}
+
+// reconfigureTestService replaces the policies of svc with those opts describe, for
+// settings that name accounts the service has created
+func reconfigureTestService(t *testing.T, svc *services.CustodianService, opts ...testOption) {
+	t.Helper()
+	installPolicies(t, svc, testConfig(opts...))
+}
+
+func testConfig(opts ...testOption) *config.Config {
+	cfg := &config.Config{ServiceName: "custodian-simulator"}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+func installPolicies(t *testing.T, svc *services.CustodianService, cfg *config.Config) {
+	t.Helper()
+
+	calendars, err := services.NewBusinessCalendars(cfg)
+	if err != nil {
+		t.Fatalf("NewBusinessCalendars failed: %v", err)
+	}
+	svc.SetBusinessCalendars(calendars)
+
+	approvals, err := services.NewApprovalPolicy(cfg)
+	if err != nil {
+		t.Fatalf("NewApprovalPolicy failed: %v", err)
+	}
+	svc.SetApprovalPolicy(approvals)
+
+	limits, err := services.NewTransferLimits(cfg)
+	if err != nil {
+		t.Fatalf("NewTransferLimits failed: %v", err)
+	}
+	svc.SetTransferLimits(limits)
+
+	chains, err := services.NewChainSimulation(cfg)
+	if err != nil {
+		t.Fatalf("NewChainSimulation failed: %v", err)
+	}
+	svc.SetChainSimulation(chains)
+
+	rails, err := services.NewFiatRails(cfg)
+	if err != nil {
+		t.Fatalf("NewFiatRails failed: %v", err)
+	}
+	svc.SetFiatRails(rails)
+
+	tiering, err := services.NewWalletTiering(cfg)
+	if err != nil {
+		t.Fatalf("NewWalletTiering failed: %v", err)
+	}
+	svc.SetWalletTiering(tiering)
+}
+
+// withApprovals requires two of alice, bob and carol to approve operations at or
+// above thresholds
+func withApprovals(thresholds string) testOption {
+	return func(cfg *config.Config) {
+		cfg.ApprovalApprovers = "alice,bob,carol"
+		cfg.ApprovalQuorum = 2
+		cfg.ApprovalThresholds = thresholds
+	}
+}
+
+// withBilateralApproval makes settlement changes wait for the other counterparty
+func withBilateralApproval() testOption {
+	return func(cfg *config.Config) {
+		cfg.SettlementBilateralApproval = true
+	}
+}
+
+// withMatching requires settlements between accounts to be matched
+func withMatching() testOption {
+	return func(cfg *config.Config) {
+		cfg.SettlementMatchingRequired = true
+		cfg.MatchingAmountTolerance = 0.0001
+	}
+}
+
+// withFailDeadline retries failed settlements until failDeadline after their date
+func withFailDeadline(failDeadline time.Duration) testOption {
+	return func(cfg *config.Config) {
+		cfg.SettlementFailDeadline = failDeadline
+	}
+}
+
+// withCoolingOff makes new withdrawal addresses wait before they can be used
+func withCoolingOff(coolingOff time.Duration) testOption {
+	return func(cfg *config.Config) {
+		cfg.WithdrawalAddressCoolingOff = coolingOff
+	}
+}
+
+// withChains simulates deterministic BTC and ETH networks
+func withChains() testOption {
+	return func(cfg *config.Config) {
+		cfg.ChainSimulationEnabled = true
+		cfg.ChainNetworks = "BTC,ETH"
+		cfg.ChainSeed = 1
+	}
+}
+
+// withFiatRails moves USD over Fedwire (same day) and ACH (next business day), with
+// returnAccounts naming bank accounts whose payments are returned
+func withFiatRails(returnAccounts string) testOption {
+	return func(cfg *config.Config) {
+		cfg.BusinessDayAssets = "USD"
+		cfg.FiatRailsEnabled = true
+		cfg.FiatRailAssets = "USD"
+		cfg.FiatRailDefault = "fedwire"
+		cfg.FiatRailBatchWindows = "fedwire=09:00,12:00,15:00,18:00;ach=10:00,16:00"
+		cfg.FiatRailSettlementDays = "fedwire=0;ach=1"
+		cfg.FiatRailReturnAccounts = returnAccounts
+		cfg.FiatRailSeed = 1
+	}
+}
+
+// withWalletTiers keeps BTC split 10/20/70 across the hot, warm and cold tiers
+func withWalletTiers() testOption {
+	return func(cfg *config.Config) {
+		cfg.WalletTieringEnabled = true
+		cfg.WalletTierAssets = "BTC"
+		cfg.WalletTierTargets = "BTC=10,20,70"
+		cfg.WalletTierTransferTimes = "hot=5m;warm=1h;cold=24h"
+		cfg.WalletTierTolerance = 0.01
+	}
+}
+
+// fundedPair creates a sender holding amount of asset and an empty receiver
+func fundedPair(t *testing.T, svc *services.CustodianService, asset string, amount float64) (string, string) {
+	t.Helper()
+
+	ctx := context.Background()
+	from, _ := svc.CreateAccount(ctx, "TRADING")
+	to, _ := svc.CreateAccount(ctx, "TRADING")
+	if _, err := svc.Deposit(ctx, from.ID, asset, amount); err != nil {
+		t.Fatalf("Deposit failed: %v", err)
+	}
+	return from.ID, to.ID
+}
+
+// matchingCounterparties funds a pair of accounts and registers SSIs naming them for
+// the DELIVERER and RECEIVER counterparties
+func matchingCounterparties(t *testing.T, svc *services.CustodianService, assetID string, amount float64) (string, string) {
+	t.Helper()
+
+	from, to := fundedPair(t, svc, assetID, amount)
+	for counterparty, account := range map[string]string{"DELIVERER": from, "RECEIVER": to} {
+		ssi := services.StandingSettlementInstruction{Counterparty: counterparty, AssetID: assetID, AccountID: account}
+		if _, err := svc.PutStandingSettlementInstruction(context.Background(), ssi, 0); err != nil {
+			t.Fatalf("PutStandingSettlementInstruction failed: %v", err)
+		}
+	}
+	return from, to
+}
+
+func assertBalance(t *testing.T, svc *services.CustodianService, accountID, assetID string, want float64) {
+	t.Helper()
+
+	got, err := svc.GetAccountBalance(context.Background(), accountID, assetID)
+	if err != nil {
+		t.Fatalf("GetAccountBalance failed: %v", err)
+	}
+	if got != want {
+		t.Errorf("Expected %s %s balance %v, got %v", accountID, assetID, want, got)
+	}
+}
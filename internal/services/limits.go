@@ -0,0 +1,226 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// TransferLimits caps the amount of an asset an account may send, per transaction,
+// per UTC day and over a rolling window. Limits are keyed by asset, with "default"
+// for other assets, or by "ACCOUNT:ASSET" to override them for one account.
+type TransferLimits struct {
+	PerTransaction map[string]float64
+	Daily          map[string]float64
+	Rolling        map[string]float64
+	RollingWindow  time.Duration
+}
+
+// LimitUsage is how much of one limit an account has used
+type LimitUsage struct {
+	Limit     float64 `json:"limit"`
+	Used      float64 `json:"used"`
+	Remaining float64 `json:"remaining"`
+}
+
+// TransferHeadroom is how much more of an asset an account may send. Nil limits do
+// not apply.
+type TransferHeadroom struct {
+	AccountID      string        `json:"account_id"`
+	AssetID        string        `json:"asset_id"`
+	PerTransaction *float64      `json:"per_transaction,omitempty"`
+	Daily          *LimitUsage   `json:"daily,omitempty"`
+	Rolling        *LimitUsage   `json:"rolling,omitempty"`
+	RollingWindow  time.Duration `json:"rolling_window,omitempty"`
+	Remaining      *float64      `json:"remaining,omitempty"` // Largest transfer allowed now
+}
+
+// outflow is one amount sent from an account, kept for velocity limits
+type outflow struct {
+	at     time.Time
+	amount float64
+}
+
+// NewTransferLimits builds the transfer limits in cfg, or returns nil when none
+// are configured
+func NewTransferLimits(cfg *config.Config) (*TransferLimits, error) {
+	limits := &TransferLimits{RollingWindow: cfg.TransferLimitRollingWindow}
+
+	var err error
+	if limits.PerTransaction, err = parseThresholds(cfg.TransferLimitPerTransaction); err != nil {
+		return nil, fmt.Errorf("invalid per-transaction transfer limits: %w", err)
+	}
+	if limits.Daily, err = parseThresholds(cfg.TransferLimitDaily); err != nil {
+		return nil, fmt.Errorf("invalid daily transfer limits: %w", err)
+	}
+	if limits.Rolling, err = parseThresholds(cfg.TransferLimitRolling); err != nil {
+		return nil, fmt.Errorf("invalid rolling transfer limits: %w", err)
+	}
+	if len(limits.Rolling) > 0 && limits.RollingWindow <= 0 {
+		return nil, fmt.Errorf("rolling transfer limits need a positive rolling window")
+	}
+
+	if len(limits.PerTransaction) == 0 && len(limits.Daily) == 0 && len(limits.Rolling) == 0 {
+		return nil, nil
+	}
+	return limits, nil
+}
+
+// SetTransferLimits registers the limits checked before funds leave an account;
+// nil removes them
+func (s *CustodianService) SetTransferLimits(limits *TransferLimits) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.transferLimits = limits
+}
+
+// TransferHeadroom returns how much more of an asset an account may send at now
+func (s *CustodianService) TransferHeadroom(ctx context.Context, accountID, assetID string, now time.Time) (*TransferHeadroom, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, exists := s.accounts[accountID]; !exists {
+		return nil, fmt.Errorf("account %s %w", accountID, ErrNotFound)
+	}
+
+	headroom := s.transferHeadroomLocked(accountID, assetID, now)
+	return &headroom, nil
+}
+
+// checkTransferLimitsLocked returns ErrLimitExceeded when sending amount from the
+// account at now would break one of its limits, auditing the refusal. Entry points
+// use it to refuse up front; the debit itself is checked again by
+// transferLimitErrorLocked, so operations held for review or approval cannot
+// exceed the limits once they execute.
+func (s *CustodianService) checkTransferLimitsLocked(ctx context.Context, accountID, assetID string, amount float64, now time.Time) error {
+	err := s.transferLimitErrorLocked(accountID, assetID, amount, now)
+	if err == nil {
+		return nil
+	}
+
+	s.recordAudit(ctx, ports.AuditEvent{
+		Action:       "transfer_limit.exceed",
+		Outcome:      ports.AuditOutcomeFailure,
+		ResourceType: "account",
+		ResourceID:   accountID,
+		Details:      map[string]string{"asset_id": assetID, "amount": formatAmount(amount)},
+		Error:        err.Error(),
+	})
+	s.logger.WithFields(logrus.Fields{
+		"account_id": accountID,
+		"asset_id":   assetID,
+		"amount":     amount,
+	}).Warn("Transfer limit exceeded")
+
+	return err
+}
+
+// transferLimitErrorLocked returns ErrLimitExceeded when sending amount from the
+// account at now would break one of its limits
+func (s *CustodianService) transferLimitErrorLocked(accountID, assetID string, amount float64, now time.Time) error {
+	if s.transferLimits == nil {
+		return nil
+	}
+
+	headroom := s.transferHeadroomLocked(accountID, assetID, now)
+	switch {
+	case headroom.PerTransaction != nil && amount > *headroom.PerTransaction:
+		return fmt.Errorf("%w: %s %s exceeds the per-transaction limit of %s for account %s",
+			ErrLimitExceeded, formatAmount(amount), assetID, formatAmount(*headroom.PerTransaction), accountID)
+	case headroom.Daily != nil && amount > headroom.Daily.Remaining:
+		return fmt.Errorf("%w: %s %s exceeds the %s remaining of the daily limit for account %s",
+			ErrLimitExceeded, formatAmount(amount), assetID, formatAmount(headroom.Daily.Remaining), accountID)
+	case headroom.Rolling != nil && amount > headroom.Rolling.Remaining:
+		return fmt.Errorf("%w: %s %s exceeds the %s remaining of the %s rolling limit for account %s",
+			ErrLimitExceeded, formatAmount(amount), assetID, formatAmount(headroom.Rolling.Remaining), headroom.RollingWindow, accountID)
+	}
+	return nil
+}
+
+// recordOutflowLocked counts amount sent from an account towards its velocity
+// limits, dropping outflows too old to count towards any of them
+func (s *CustodianService) recordOutflowLocked(accountID, assetID string, amount float64, now time.Time) {
+	if s.transferLimits == nil {
+		return
+	}
+
+	key := accountID + "/" + assetID
+	cutoff := startOfUTCDay(now)
+	if rolling := now.Add(-s.transferLimits.RollingWindow); rolling.Before(cutoff) {
+		cutoff = rolling
+	}
+
+	kept := make([]outflow, 0, len(s.outflows[key])+1)
+	for _, previous := range s.outflows[key] {
+		if !previous.at.Before(cutoff) {
+			kept = append(kept, previous)
+		}
+	}
+	s.outflows[key] = append(kept, outflow{at: now, amount: amount})
+}
+
+func (s *CustodianService) transferHeadroomLocked(accountID, assetID string, now time.Time) TransferHeadroom {
+	headroom := TransferHeadroom{AccountID: accountID, AssetID: assetID}
+	limits := s.transferLimits
+	if limits == nil {
+		return headroom
+	}
+
+	remaining := math.Inf(1)
+	if limit, ok := lookupLimit(limits.PerTransaction, accountID, assetID); ok {
+		headroom.PerTransaction = &limit
+		remaining = math.Min(remaining, limit)
+	}
+	if limit, ok := lookupLimit(limits.Daily, accountID, assetID); ok {
+		headroom.Daily = s.limitUsageLocked(accountID, assetID, limit, startOfUTCDay(now))
+		remaining = math.Min(remaining, headroom.Daily.Remaining)
+	}
+	if limit, ok := lookupLimit(limits.Rolling, accountID, assetID); ok {
+		headroom.Rolling = s.limitUsageLocked(accountID, assetID, limit, now.Add(-limits.RollingWindow))
+		headroom.RollingWindow = limits.RollingWindow
+		remaining = math.Min(remaining, headroom.Rolling.Remaining)
+	}
+	if !math.IsInf(remaining, 1) {
+		headroom.Remaining = &remaining
+	}
+	return headroom
+}
+
+// limitUsageLocked sums the outflows since since, inclusive, against limit
+func (s *CustodianService) limitUsageLocked(accountID, assetID string, limit float64, since time.Time) *LimitUsage {
+	usage := &LimitUsage{Limit: limit}
+	for _, previous := range s.outflows[accountID+"/"+assetID] {
+		if !previous.at.Before(since) {
+			usage.Used += previous.amount
+		}
+	}
+	usage.Remaining = math.Max(0, limit-usage.Used)
+	return usage
+}
+
+// lookupLimit finds the limit for an account and asset: the account's own limit
+// for the asset or its default, then the asset's limit or the default
+func lookupLimit(limits map[string]float64, accountID, assetID string) (float64, bool) {
+	accountLimits := make(map[string]float64)
+	prefix := accountID + ":"
+	for key, limit := range limits {
+		if asset, ok := strings.CutPrefix(key, prefix); ok {
+			accountLimits[asset] = limit
+		}
+	}
+	if limit, ok := lookupThreshold(accountLimits, assetID); ok {
+		return limit, true
+	}
+	return lookupThreshold(limits, assetID)
+}
+
+func startOfUTCDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,193 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestTransferLimits verifies per-transaction and velocity limits stop runaway transfers
+// Following BDD Given/When/Then pattern
+func TestTransferLimits(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("refuses_transfers_above_the_per_transaction_limit", func(t *testing.T) {
+		// Given: A 5 BTC per-transaction limit
+		svc := newTestService(t, func(cfg *config.Config) { cfg.TransferLimitPerTransaction = "BTC=5" })
+		from, to := fundedPair(t, svc, "BTC", 20)
+
+		// When: 6 BTC is transferred
+		_, err := svc.Transfer(from, to, "BTC", 6)
+
+		// Then: It is refused as limit exceeded and nothing moves
+		if !errors.Is(err, services.ErrLimitExceeded) {
+			t.Errorf("Expected ErrLimitExceeded, got %v", err)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+	})
+
+	t.Run("daily_limit_stops_a_transfer_loop", func(t *testing.T) {
+		// Given: A 10 ETH daily limit
+		svc := newTestService(t, func(cfg *config.Config) { cfg.TransferLimitDaily = "default=10" })
+		from, to := fundedPair(t, svc, "ETH", 100)
+
+		// When: A client transfers 3 ETH in a loop
+		var err error
+		transfers := 0
+		for ; transfers < 10; transfers++ {
+			if _, err = svc.Transfer(from, to, "ETH", 3); err != nil {
+				break
+			}
+		}
+
+		// Then: The fourth transfer is refused
+		if transfers != 3 || !errors.Is(err, services.ErrLimitExceeded) {
+			t.Errorf("Expected 3 transfers then ErrLimitExceeded, got %d and %v", transfers, err)
+		}
+		assertBalance(t, svc, to, "ETH", 9)
+
+		// And: The headroom shows what is left today
+		headroom, err := svc.TransferHeadroom(ctx, from, "ETH", time.Now())
+		if err != nil {
+			t.Fatalf("TransferHeadroom failed: %v", err)
+		}
+		if headroom.Daily == nil || headroom.Daily.Used != 9 || *headroom.Remaining != 1 {
+			t.Errorf("Expected 9 used and 1 remaining, got %+v", headroom)
+		}
+	})
+
+	t.Run("rolling_window_frees_up_as_outflows_age", func(t *testing.T) {
+		// Given: A 5 BTC limit per hour, fully used
+		svc := newTestService(t, func(cfg *config.Config) {
+			cfg.TransferLimitRolling = "BTC=5"
+			cfg.TransferLimitRollingWindow = time.Hour
+		})
+		from, to := fundedPair(t, svc, "BTC", 20)
+		if _, err := svc.Transfer(from, to, "BTC", 5); err != nil {
+			t.Fatalf("Transfer failed: %v", err)
+		}
+
+		// When: The headroom is checked now and after the window
+		now, _ := svc.TransferHeadroom(ctx, from, "BTC", time.Now())
+		later, _ := svc.TransferHeadroom(ctx, from, "BTC", time.Now().Add(time.Hour+time.Minute))
+
+		// Then: Nothing is left now and the full limit is back later
+		if now.Rolling.Remaining != 0 || later.Rolling.Remaining != 5 {
+			t.Errorf("Expected 0 then 5 remaining, got %v then %v", now.Rolling.Remaining, later.Rolling.Remaining)
+		}
+	})
+
+	t.Run("outflows_at_the_start_of_the_window_still_count", func(t *testing.T) {
+		// Given: A 5 BTC limit per hour, fully used
+		svc := newTestService(t, func(cfg *config.Config) {
+			cfg.TransferLimitRolling = "BTC=5"
+			cfg.TransferLimitRollingWindow = time.Hour
+		})
+		from, to := fundedPair(t, svc, "BTC", 20)
+		if _, err := svc.Transfer(from, to, "BTC", 5); err != nil {
+			t.Fatalf("Transfer failed: %v", err)
+		}
+		account, err := svc.GetAccount(ctx, from)
+		if err != nil {
+			t.Fatalf("GetAccount failed: %v", err)
+		}
+
+		// When: The headroom is checked exactly one window after the outflow
+		headroom, _ := svc.TransferHeadroom(ctx, from, "BTC", account.UpdatedAt.Add(time.Hour))
+
+		// Then: The outflow still counts against the window
+		if headroom.Rolling.Used != 5 || headroom.Rolling.Remaining != 0 {
+			t.Errorf("Expected 5 used and 0 remaining, got %+v", headroom.Rolling)
+		}
+	})
+
+	t.Run("account_overrides_apply_to_withdrawals", func(t *testing.T) {
+		// Given: A default 100 USDC limit with 10 for one account
+		svc := newTestService(t)
+		from, _ := fundedPair(t, svc, "USDC", 500)
+		reconfigureTestService(t, svc, func(cfg *config.Config) { cfg.TransferLimitPerTransaction = "USDC=100;" + from + ":USDC=10" })
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "USDC", Address: "0xabc"})
+
+		// When: The account withdraws 50 USDC
+		_, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "USDC", Amount: 50, Address: "0xabc"})
+
+		// Then: The account's own limit refuses it
+		if !errors.Is(err, services.ErrLimitExceeded) {
+			t.Errorf("Expected ErrLimitExceeded, got %v", err)
+		}
+		assertBalance(t, svc, from, "USDC", 500)
+	})
+
+	t.Run("settlement_instructions_are_limited_when_they_settle", func(t *testing.T) {
+		// Given: A 5 BTC per-transaction limit
+		svc := newTestService(t, func(cfg *config.Config) { cfg.TransferLimitPerTransaction = "BTC=5" })
+		from, to := fundedPair(t, svc, "BTC", 20)
+
+		// When: A 6 BTC instruction due now is submitted
+		settlement, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{
+			FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 6, SettlementDate: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("SubmitSettlementInstruction failed: %v", err)
+		}
+
+		// Then: It fails on the limit and nothing moves
+		if settlement.Status != services.SettlementStatusFailed || settlement.ReasonCode != services.FailReasonLimitExceeded {
+			t.Errorf("Expected failed with %s, got %s with %s", services.FailReasonLimitExceeded, settlement.Status, settlement.ReasonCode)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+	})
+
+	t.Run("counterparty_settlements_are_limited_when_they_settle", func(t *testing.T) {
+		// Given: A 100 USD daily limit and SSIs for both counterparties
+		svc := newTestService(t, func(cfg *config.Config) { cfg.TransferLimitDaily = "USD=100" })
+		from, to := fundedPair(t, svc, "USD", 1000)
+		_, _ = svc.PutStandingSettlementInstruction(ctx, services.StandingSettlementInstruction{Counterparty: "FUND_X", AssetID: "USD", AccountID: from}, 0)
+		_, _ = svc.PutStandingSettlementInstruction(ctx, services.StandingSettlementInstruction{Counterparty: "BROKER_Y", AssetID: "USD", AccountID: to}, 0)
+
+		// When: A 250 USD counterparty settlement comes due
+		settlement, err := svc.SubmitCounterpartySettlement(ctx, "FUND_X", "BROKER_Y", "USD", 250, "alice")
+		if err != nil {
+			t.Fatalf("SubmitCounterpartySettlement failed: %v", err)
+		}
+		svc.ProcessDueSettlements(ctx, settlement.SettlementDate)
+
+		// Then: It fails on the limit and nothing moves
+		processed, _ := svc.GetSettlement(ctx, settlement.ID)
+		if processed.Status != services.SettlementStatusFailed || processed.ReasonCode != services.FailReasonLimitExceeded {
+			t.Errorf("Expected failed with %s, got %s with %s", services.FailReasonLimitExceeded, processed.Status, processed.ReasonCode)
+		}
+		assertBalance(t, svc, to, "USD", 0)
+	})
+
+	t.Run("withdrawals_held_for_approval_are_checked_again_when_approved", func(t *testing.T) {
+		// Given: A 10 ETH daily limit and approval for withdrawals of 8 ETH or more
+		svc := newTestService(t, withApprovals("ETH=8"), func(cfg *config.Config) { cfg.TransferLimitDaily = "ETH=10" })
+		from, to := fundedPair(t, svc, "ETH", 100)
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "ETH", Address: "0xabc"})
+		withdrawal, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "ETH", Amount: 8, Address: "0xabc", RequestedBy: "carol"})
+		if err != nil || withdrawal.Status != services.WithdrawalStatusPendingApproval {
+			t.Fatalf("Expected a withdrawal pending approval, got %+v and %v", withdrawal, err)
+		}
+
+		// When: 5 ETH is transferred while it waits, then it is approved
+		if _, err := svc.Transfer(from, to, "ETH", 5); err != nil {
+			t.Fatalf("Transfer failed: %v", err)
+		}
+		_, _ = svc.ApproveOperation(ctx, withdrawal.ApprovalID, "alice")
+		_, _ = svc.ApproveOperation(ctx, withdrawal.ApprovalID, "bob")
+
+		// Then: The withdrawal fails on the limit and only the transfer left the account
+		approved, _ := svc.GetWithdrawal(ctx, withdrawal.ID)
+		if approved.Status != services.WithdrawalStatusFailed {
+			t.Errorf("Expected failed, got %s", approved.Status)
+		}
+		assertBalance(t, svc, from, "ETH", 95)
+	})
+}
@@ -0,0 +1,351 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Matching instruction sides
+const (
+	MatchingSideDeliver = "deliver"
+	MatchingSideReceive = "receive"
+)
+
+// Matching instruction statuses
+const (
+	MatchingStatusUnmatched = "unmatched"
+	MatchingStatusMatched   = "matched"
+)
+
+// Mismatch reasons reported on unmatched instructions
+const (
+	MismatchMissingCounterInstruction = "missing_counter_instruction"
+	MismatchAsset                     = "asset_id"
+	MismatchAccounts                  = "accounts"
+	MismatchAmount                    = "amount"
+	MismatchSettlementDate            = "settlement_date"
+)
+
+// MatchingInstruction is one counterparty's leg of a bilateral settlement. The
+// deliverer and the receiver each submit one; when they agree on trade reference,
+// asset, accounts, amount and date within the configured tolerances the custodian
+// creates the settlement.
+type MatchingInstruction struct {
+	ID              string     `json:"id"`
+	Side            string     `json:"side"`
+	TradeReference  string     `json:"trade_reference"`
+	FromAccount     string     `json:"from_account"`
+	ToAccount       string     `json:"to_account"`
+	AssetID         string     `json:"asset_id"`
+	Amount          float64    `json:"amount"`
+	SettlementDate  time.Time  `json:"settlement_date"`
+	SubmittedBy     string     `json:"submitted_by,omitempty"`
+	Status          string     `json:"status"`
+	MatchedWith     string     `json:"matched_with,omitempty"`
+	SettlementID    string     `json:"settlement_id,omitempty"`
+	MismatchReasons []string   `json:"mismatch_reasons,omitempty"` // Against the latest counter-instruction with the same trade reference
+	SubmittedAt     time.Time  `json:"submitted_at"`
+	MatchedAt       *time.Time `json:"matched_at,omitempty"`
+}
+
+// UnmatchedInstruction is an entry in the mismatch report
+type UnmatchedInstruction struct {
+	MatchingInstruction
+	Age       time.Duration `json:"age"`
+	AgeBucket string        `json:"age_bucket"`
+}
+
+// MismatchReport lists unmatched instructions, oldest first
+type MismatchReport struct {
+	GeneratedAt time.Time              `json:"generated_at"`
+	Unmatched   []UnmatchedInstruction `json:"unmatched"`
+	CountByAge  map[string]int         `json:"count_by_age"`
+}
+
+// SubmitMatchingInstruction records one side of a settlement and matches it against
+// the unmatched counter-instructions for the same trade reference. The returned copy
+// carries the settlement ID once matched. The submitter must be the counterparty
+// whose current SSI for the asset names its side's account: the from account for
+// the deliverer and the to account for the receiver. Legs from the same submitter
+// never match.
+func (s *CustodianService) SubmitMatchingInstruction(ctx context.Context, instruction MatchingInstruction) (*MatchingInstruction, error) {
+	if instruction.Side != MatchingSideDeliver && instruction.Side != MatchingSideReceive {
+		return nil, fmt.Errorf("%w: side must be %s or %s", ErrInvalidRequest, MatchingSideDeliver, MatchingSideReceive)
+	}
+	if instruction.TradeReference == "" || instruction.FromAccount == "" || instruction.ToAccount == "" || instruction.AssetID == "" {
+		return nil, fmt.Errorf("%w: trade_reference, from_account, to_account and asset_id are required", ErrInvalidRequest)
+	}
+	if instruction.Amount <= 0 {
+		return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidRequest)
+	}
+	if instruction.SubmittedBy == "" {
+		return nil, fmt.Errorf("%w: submitted_by is required", ErrInvalidRequest)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if instruction.ID == "" {
+		instruction.ID = generateMatchingInstructionID()
+	}
+	if _, exists := s.matchingInstructions[instruction.ID]; exists {
+		return nil, fmt.Errorf("matching instruction %s %w", instruction.ID, ErrAlreadyExists)
+	}
+	if err := s.checkMatchingSubmitterLocked(&instruction); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if instruction.SettlementDate.IsZero() {
+		instruction.SettlementDate = s.calendars.SettlementDate(now, 0, 0, instruction.AssetID)
+	}
+	instruction.Status = MatchingStatusUnmatched
+	instruction.MatchedWith, instruction.SettlementID, instruction.MatchedAt = "", "", nil
+	instruction.MismatchReasons = []string{MismatchMissingCounterInstruction}
+	instruction.SubmittedAt = now
+
+	stored := &instruction
+	counters := s.counterInstructionsLocked(stored)
+	var agreeing *MatchingInstruction
+	for _, counter := range counters {
+		if len(s.mismatchReasons(stored, counter)) == 0 {
+			agreeing = counter
+			break
+		}
+	}
+
+	// An instruction whose matched settlement is refused is not kept, so the
+	// counter-instruction stays unmatched and can be matched again
+	var settlement *Settlement
+	if agreeing != nil {
+		var err error
+		if settlement, err = s.submitMatchedSettlementLocked(ctx, stored, agreeing); err != nil {
+			return nil, err
+		}
+	}
+
+	s.matchingInstructions[stored.ID] = stored
+	s.recordAudit(ctx, matchingAuditEvent(stored, "matching.submit"))
+
+	for _, counter := range counters {
+		if counter == agreeing {
+			s.matchLocked(ctx, stored, counter, settlement, now)
+			break
+		}
+		reasons := s.mismatchReasons(stored, counter)
+		stored.MismatchReasons = reasons
+		counter.MismatchReasons = reasons
+	}
+
+	if stored.Status == MatchingStatusUnmatched {
+		s.logger.WithFields(logrus.Fields{
+			"instruction_id":   stored.ID,
+			"trade_reference":  stored.TradeReference,
+			"mismatch_reasons": stored.MismatchReasons,
+		}).Info("Matching instruction awaiting counter-instruction")
+	}
+
+	result := *stored
+	return &result, nil
+}
+
+// GetMatchingInstruction returns a copy of a matching instruction by ID
+func (s *CustodianService) GetMatchingInstruction(ctx context.Context, instructionID string) (*MatchingInstruction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	instruction, exists := s.matchingInstructions[instructionID]
+	if !exists {
+		return nil, fmt.Errorf("matching instruction %s %w", instructionID, ErrNotFound)
+	}
+
+	result := *instruction
+	return &result, nil
+}
+
+// MismatchReport lists the instructions still unmatched at now with their age
+func (s *CustodianService) MismatchReport(ctx context.Context, now time.Time) MismatchReport {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	report := MismatchReport{
+		GeneratedAt: now,
+		Unmatched:   []UnmatchedInstruction{},
+		CountByAge:  make(map[string]int),
+	}
+	for _, instruction := range s.matchingInstructions {
+		if instruction.Status != MatchingStatusUnmatched {
+			continue
+		}
+		age := now.Sub(instruction.SubmittedAt)
+		entry := UnmatchedInstruction{
+			MatchingInstruction: *instruction,
+			Age:                 age,
+			AgeBucket:           ageBucket(age),
+		}
+		report.Unmatched = append(report.Unmatched, entry)
+		report.CountByAge[entry.AgeBucket]++
+	}
+	sort.Slice(report.Unmatched, func(i, j int) bool {
+		if !report.Unmatched[i].SubmittedAt.Equal(report.Unmatched[j].SubmittedAt) {
+			return report.Unmatched[i].SubmittedAt.Before(report.Unmatched[j].SubmittedAt)
+		}
+		return report.Unmatched[i].ID < report.Unmatched[j].ID
+	})
+	return report
+}
+
+// submitMatchedSettlementLocked submits the settlement of two agreeing
+// instructions. The deliverer's amount applies and the later of the two dates, so
+// neither side settles before it expects to.
+func (s *CustodianService) submitMatchedSettlementLocked(ctx context.Context, instruction, counter *MatchingInstruction) (*Settlement, error) {
+	deliver, receive := matchingSides(instruction, counter)
+
+	settlementDate := deliver.SettlementDate
+	if receive.SettlementDate.After(settlementDate) {
+		settlementDate = receive.SettlementDate
+	}
+
+	return s.submitSettlementLocked(ctx, Settlement{
+		FromAccount:    deliver.FromAccount,
+		ToAccount:      deliver.ToAccount,
+		AssetID:        deliver.AssetID,
+		Amount:         deliver.Amount,
+		SettlementDate: settlementDate,
+		TradeID:        deliver.TradeReference,
+		InitiatedBy:    deliver.SubmittedBy,
+	})
+}
+
+// matchLocked pairs two agreeing instructions with their submitted settlement
+func (s *CustodianService) matchLocked(ctx context.Context, instruction, counter *MatchingInstruction, settlement *Settlement, now time.Time) {
+	deliver, receive := matchingSides(instruction, counter)
+
+	for _, matched := range []*MatchingInstruction{deliver, receive} {
+		matched.Status = MatchingStatusMatched
+		matched.SettlementID = settlement.ID
+		matched.MismatchReasons = nil
+		matchedAt := now
+		matched.MatchedAt = &matchedAt
+	}
+	deliver.MatchedWith, receive.MatchedWith = receive.ID, deliver.ID
+
+	event := matchingAuditEvent(deliver, "matching.match")
+	event.Details["matched_with"] = receive.ID
+	event.Details["settlement_id"] = settlement.ID
+	s.recordAudit(ctx, event)
+
+	s.logger.WithFields(logrus.Fields{
+		"trade_reference": deliver.TradeReference,
+		"deliver_id":      deliver.ID,
+		"receive_id":      receive.ID,
+		"settlement_id":   settlement.ID,
+	}).Info("Settlement instructions matched")
+}
+
+// matchingSides returns two paired instructions as deliverer and receiver
+func matchingSides(instruction, counter *MatchingInstruction) (deliver, receive *MatchingInstruction) {
+	if instruction.Side != MatchingSideDeliver {
+		return counter, instruction
+	}
+	return instruction, counter
+}
+
+// checkMatchingSubmitterLocked ties an instruction's submitter to its side's
+// account through the submitter's current SSI for the asset
+func (s *CustodianService) checkMatchingSubmitterLocked(instruction *MatchingInstruction) error {
+	account := instruction.FromAccount
+	if instruction.Side == MatchingSideReceive {
+		account = instruction.ToAccount
+	}
+
+	ssi, err := s.currentSSILocked(instruction.SubmittedBy, instruction.AssetID)
+	if err != nil || ssi.AccountID != account {
+		return fmt.Errorf("%w: %s has no standing settlement instruction for %s on account %s",
+			ErrInvalidRequest, instruction.SubmittedBy, instruction.AssetID, account)
+	}
+	return nil
+}
+
+// counterInstructionsLocked returns the unmatched instructions on the other side
+// with the same trade reference from another submitter, oldest first
+func (s *CustodianService) counterInstructionsLocked(instruction *MatchingInstruction) []*MatchingInstruction {
+	var counters []*MatchingInstruction
+	for _, candidate := range s.matchingInstructions {
+		if candidate.Status == MatchingStatusUnmatched && candidate.Side != instruction.Side &&
+			candidate.TradeReference == instruction.TradeReference && candidate.SubmittedBy != instruction.SubmittedBy {
+			counters = append(counters, candidate)
+		}
+	}
+	sort.Slice(counters, func(i, j int) bool {
+		return counters[i].SubmittedAt.Before(counters[j].SubmittedAt)
+	})
+	return counters
+}
+
+// mismatchReasons compares two instructions within the configured tolerances
+func (s *CustodianService) mismatchReasons(a, b *MatchingInstruction) []string {
+	var reasons []string
+	if a.AssetID != b.AssetID {
+		reasons = append(reasons, MismatchAsset)
+	}
+	if a.FromAccount != b.FromAccount || a.ToAccount != b.ToAccount {
+		reasons = append(reasons, MismatchAccounts)
+	}
+	if math.Abs(a.Amount-b.Amount) > s.config.MatchingAmountTolerance*math.Max(a.Amount, b.Amount) {
+		reasons = append(reasons, MismatchAmount)
+	}
+
+	dateTolerance := s.config.MatchingDateTolerance
+	if dateTolerance > 0 {
+		diff := a.SettlementDate.Sub(b.SettlementDate)
+		if diff > dateTolerance || -diff > dateTolerance {
+			reasons = append(reasons, MismatchSettlementDate)
+		}
+	} else if a.SettlementDate.UTC().Format(time.DateOnly) != b.SettlementDate.UTC().Format(time.DateOnly) {
+		reasons = append(reasons, MismatchSettlementDate)
+	}
+	return reasons
+}
+
+func matchingAuditEvent(instruction *MatchingInstruction, action string) ports.AuditEvent {
+	return ports.AuditEvent{
+		Action:       action,
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "matching_instruction",
+		ResourceID:   instruction.ID,
+		Details: map[string]string{
+			"side":            instruction.Side,
+			"trade_reference": instruction.TradeReference,
+			"from_account":    instruction.FromAccount,
+			"to_account":      instruction.ToAccount,
+			"asset_id":        instruction.AssetID,
+			"amount":          formatAmount(instruction.Amount),
+			"settlement_date": instruction.SettlementDate.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+// ageBucket groups unmatched instructions by how long they have waited
+func ageBucket(age time.Duration) string {
+	switch {
+	case age < 24*time.Hour:
+		return "0-1d"
+	case age < 72*time.Hour:
+		return "1-3d"
+	default:
+		return "3d+"
+	}
+}
+
+func generateMatchingInstructionID() string {
+	// Simple ID generation for simulation
+	return fmt.Sprintf("MATCH_%d", nextIDNanos())
+}
@@ -0,0 +1,209 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestSettlementMatching verifies only matched bilateral instructions settle
+// Following BDD Given/When/Then pattern
+func TestSettlementMatching(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("settles_once_both_legs_match_within_tolerance", func(t *testing.T) {
+		// Given: A deliverer's instruction for 1 BTC
+		svc := newTestService(t, withMatching())
+		from, to := matchingCounterparties(t, svc, "BTC", 2)
+		deliver, err := svc.SubmitMatchingInstruction(ctx, services.MatchingInstruction{
+			Side: services.MatchingSideDeliver, TradeReference: "TRD-1", FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1,
+			SubmittedBy: "DELIVERER",
+		})
+		if err != nil {
+			t.Fatalf("SubmitMatchingInstruction failed: %v", err)
+		}
+
+		// Then: Nothing moves while it is unmatched
+		if deliver.Status != services.MatchingStatusUnmatched {
+			t.Errorf("Expected unmatched, got %s", deliver.Status)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+
+		// When: The receiver instructs an amount within tolerance
+		receive, err := svc.SubmitMatchingInstruction(ctx, services.MatchingInstruction{
+			Side: services.MatchingSideReceive, TradeReference: "TRD-1", FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1.00005,
+			SubmittedBy: "RECEIVER",
+		})
+		if err != nil {
+			t.Fatalf("SubmitMatchingInstruction failed: %v", err)
+		}
+
+		// Then: Both legs match and the deliverer's amount settles
+		if receive.Status != services.MatchingStatusMatched || receive.MatchedWith != deliver.ID || receive.SettlementID == "" {
+			t.Fatalf("Expected a match with %s, got %+v", deliver.ID, receive)
+		}
+		settlement, _ := svc.GetSettlement(ctx, receive.SettlementID)
+		if settlement.Status != services.SettlementStatusCompleted || settlement.TradeID != "TRD-1" {
+			t.Errorf("Unexpected settlement %+v", settlement)
+		}
+		assertBalance(t, svc, to, "BTC", 1)
+	})
+
+	t.Run("legs_whose_settlement_is_refused_are_not_kept", func(t *testing.T) {
+		// Given: A deliverer's instruction, then a screener rejecting everything
+		svc := newTestService(t, withMatching())
+		from, to := matchingCounterparties(t, svc, "BTC", 2)
+		deliver, _ := svc.SubmitMatchingInstruction(ctx, services.MatchingInstruction{
+			Side: services.MatchingSideDeliver, TradeReference: "TRD-R", FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1,
+			SubmittedBy: "DELIVERER",
+		})
+		svc.SetComplianceScreener(fixedScreener{Decision: ports.ComplianceReject})
+		receive := services.MatchingInstruction{
+			ID: "MI_RECEIVE", Side: services.MatchingSideReceive, TradeReference: "TRD-R", FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1,
+			SubmittedBy: "RECEIVER",
+		}
+
+		// When: The agreeing receiver's instruction is submitted
+		_, err := svc.SubmitMatchingInstruction(ctx, receive)
+
+		// Then: It is refused, not stored, and the deliverer's leg stays unmatched
+		if !errors.Is(err, services.ErrComplianceRejected) {
+			t.Fatalf("Expected ErrComplianceRejected, got %v", err)
+		}
+		if _, err := svc.GetMatchingInstruction(ctx, "MI_RECEIVE"); !errors.Is(err, services.ErrNotFound) {
+			t.Errorf("Expected the refused leg not to be kept, got %v", err)
+		}
+		if current, _ := svc.GetMatchingInstruction(ctx, deliver.ID); current.Status != services.MatchingStatusUnmatched {
+			t.Errorf("Expected the deliverer's leg to stay unmatched, got %s", current.Status)
+		}
+
+		// And: Once screening passes, the same instruction matches
+		svc.SetComplianceScreener(nil)
+		matched, err := svc.SubmitMatchingInstruction(ctx, receive)
+		if err != nil || matched.Status != services.MatchingStatusMatched {
+			t.Fatalf("Expected a match, got %+v / %v", matched, err)
+		}
+		assertBalance(t, svc, to, "BTC", 1)
+	})
+
+	t.Run("mismatched_legs_age_in_the_mismatch_report", func(t *testing.T) {
+		// Given: Two legs disagreeing on amount and date
+		svc := newTestService(t, withMatching())
+		from, to := matchingCounterparties(t, svc, "ETH", 10)
+		_, _ = svc.SubmitMatchingInstruction(ctx, services.MatchingInstruction{
+			Side: services.MatchingSideDeliver, TradeReference: "TRD-2", FromAccount: from, ToAccount: to, AssetID: "ETH", Amount: 5,
+			SubmittedBy: "DELIVERER",
+		})
+		_, _ = svc.SubmitMatchingInstruction(ctx, services.MatchingInstruction{
+			Side: services.MatchingSideReceive, TradeReference: "TRD-2", FromAccount: from, ToAccount: to, AssetID: "ETH", Amount: 6,
+			SettlementDate: time.Now().AddDate(0, 0, 2), SubmittedBy: "RECEIVER",
+		})
+
+		// When: The mismatch report is generated two days later
+		report := svc.MismatchReport(ctx, time.Now().Add(49*time.Hour))
+
+		// Then: Both legs are listed with the reasons and their age
+		if len(report.Unmatched) != 2 || report.CountByAge["1-3d"] != 2 {
+			t.Fatalf("Expected 2 unmatched legs aged 1-3d, got %+v", report)
+		}
+		reasons := report.Unmatched[0].MismatchReasons
+		if len(reasons) != 2 || reasons[0] != services.MismatchAmount || reasons[1] != services.MismatchSettlementDate {
+			t.Errorf("Expected amount and settlement_date mismatches, got %v", reasons)
+		}
+		assertBalance(t, svc, to, "ETH", 0)
+	})
+
+	t.Run("refuses_unilateral_settlements_when_matching_is_required", func(t *testing.T) {
+		// Given: Matching is required
+		svc := newTestService(t, withMatching())
+		from, to := fundedPair(t, svc, "BTC", 1)
+
+		// When: One caller tries to move funds directly
+		_, err := svc.Transfer(from, to, "BTC", 1)
+
+		// Then: It is refused
+		if !errors.Is(err, services.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest, got %v", err)
+		}
+		assertBalance(t, svc, from, "BTC", 1)
+	})
+
+	t.Run("refuses_instructions_and_counterparty_settlements_when_matching_is_required", func(t *testing.T) {
+		// Given: Matching is required and both counterparties have SSIs
+		svc := newTestService(t, withMatching())
+		from, to := matchingCounterparties(t, svc, "BTC", 1)
+
+		// When: One side submits an instruction or a counterparty settlement alone
+		_, instructionErr := svc.SubmitSettlementInstruction(ctx, services.Settlement{FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1})
+		_, counterpartyErr := svc.SubmitCounterpartySettlement(ctx, "DELIVERER", "RECEIVER", "BTC", 1, "alice")
+
+		// Then: Both are refused
+		if !errors.Is(instructionErr, services.ErrInvalidRequest) || !errors.Is(counterpartyErr, services.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest, got %v and %v", instructionErr, counterpartyErr)
+		}
+		assertBalance(t, svc, from, "BTC", 1)
+	})
+
+	t.Run("legs_must_come_from_each_sides_counterparty", func(t *testing.T) {
+		// Given: Two counterparties with SSIs for their accounts
+		svc := newTestService(t, withMatching())
+		from, to := matchingCounterparties(t, svc, "BTC", 2)
+		leg := services.MatchingInstruction{TradeReference: "TRD-3", FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1}
+
+		// When: The deliverer also submits the receiving leg
+		deliver, receive := leg, leg
+		deliver.Side, deliver.SubmittedBy = services.MatchingSideDeliver, "DELIVERER"
+		receive.Side, receive.SubmittedBy = services.MatchingSideReceive, "DELIVERER"
+		_, _ = svc.SubmitMatchingInstruction(ctx, deliver)
+		_, err := svc.SubmitMatchingInstruction(ctx, receive)
+
+		// Then: It is refused as the deliverer's SSI does not name the to account
+		if !errors.Is(err, services.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest, got %v", err)
+		}
+
+		// And: An instruction without a submitter is refused too
+		receive.SubmittedBy = ""
+		if _, err := svc.SubmitMatchingInstruction(ctx, receive); !errors.Is(err, services.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest without a submitter, got %v", err)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+	})
+
+	t.Run("instructions_submitted_in_a_tight_loop_keep_distinct_ids", func(t *testing.T) {
+		// Given: Two counterparties with SSIs for their accounts
+		svc := newTestService(t, withMatching())
+		from, to := matchingCounterparties(t, svc, "BTC", 2)
+
+		// When: Many instructions are submitted back to back
+		ids := make(map[string]string)
+		for i := 0; i < 200; i++ {
+			reference := fmt.Sprintf("TRD-L%d", i)
+			instruction, err := svc.SubmitMatchingInstruction(ctx, services.MatchingInstruction{
+				Side: services.MatchingSideDeliver, TradeReference: reference, FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1,
+				SubmittedBy: "DELIVERER",
+			})
+			if err != nil {
+				t.Fatalf("SubmitMatchingInstruction failed: %v", err)
+			}
+			ids[instruction.ID] = reference
+		}
+
+		// Then: Each one is kept under its own ID
+		if len(ids) != 200 {
+			t.Fatalf("Expected 200 distinct IDs, got %d", len(ids))
+		}
+		for id, reference := range ids {
+			if instruction, err := svc.GetMatchingInstruction(ctx, id); err != nil || instruction.TradeReference != reference {
+				t.Errorf("Expected %s for %s, got %+v, %v", reference, id, instruction, err)
+			}
+		}
+	})
+}
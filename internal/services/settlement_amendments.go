@@ -0,0 +1,377 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Settlement change actions
+const (
+	SettlementChangeAmend  = "amend"
+	SettlementChangeCancel = "cancel"
+)
+
+// Settlement change statuses
+const (
+	SettlementChangePendingApproval = "pending_approval"
+	SettlementChangeApplied         = "applied"
+	SettlementChangeRejected        = "rejected"
+)
+
+// SettlementChange is a request to amend or cancel a settlement. Zero Amount and
+// SettlementDate leave those fields unchanged.
+type SettlementChange struct {
+	RequestedBy    string
+	Reason         string
+	Amount         float64
+	SettlementDate time.Time
+}
+
+// SettlementAmendment is one entry in a settlement's amendment history
+type SettlementAmendment struct {
+	Sequence               int        `json:"sequence"`
+	Action                 string     `json:"action"`
+	Status                 string     `json:"status"`
+	RequestedBy            string     `json:"requested_by,omitempty"`
+	ResolvedBy             string     `json:"resolved_by,omitempty"` // Approving or rejecting counterparty
+	Reason                 string     `json:"reason,omitempty"`
+	PreviousAmount         float64    `json:"previous_amount"`
+	Amount                 float64    `json:"amount"`
+	PreviousSettlementDate time.Time  `json:"previous_settlement_date"`
+	SettlementDate         time.Time  `json:"settlement_date"`
+	RequestedAt            time.Time  `json:"requested_at"`
+	ResolvedAt             *time.Time `json:"resolved_at,omitempty"`
+}
+
+// AmendSettlement changes the amount or settlement date of a settlement that has not
+// started settling, at the request of one of its counterparties. With bilateral
+// approval configured the change waits for the other counterparty; see
+// ApproveSettlementChange.
+func (s *CustodianService) AmendSettlement(ctx context.Context, settlementID string, change SettlementChange) (*Settlement, error) {
+	if change.Amount < 0 {
+		return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidRequest)
+	}
+	if change.Amount == 0 && change.SettlementDate.IsZero() {
+		return nil, fmt.Errorf("%w: an amendment must change the amount or the settlement date", ErrInvalidRequest)
+	}
+	return s.requestSettlementChange(ctx, settlementID, SettlementChangeAmend, change)
+}
+
+// CancelSettlement withdraws a settlement that has not started settling, including
+// one held for approval or compliance review, whose request is closed with it. With
+// bilateral approval configured the cancellation waits for the other counterparty.
+func (s *CustodianService) CancelSettlement(ctx context.Context, settlementID, requestedBy, reason string) (*Settlement, error) {
+	return s.requestSettlementChange(ctx, settlementID, SettlementChangeCancel, SettlementChange{
+		RequestedBy: requestedBy,
+		Reason:      reason,
+	})
+}
+
+// ApproveSettlementChange applies the change awaiting approval on a settlement.
+// The approver must be the counterparty that did not request it.
+func (s *CustodianService) ApproveSettlementChange(ctx context.Context, settlementID, approvedBy string) (*Settlement, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	settlement, pending, err := s.pendingChangeLocked(settlementID, approvedBy)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSettlementChangeable(settlement, settlement.Amendments[pending].Action); err != nil {
+		return nil, err
+	}
+
+	if err := s.applySettlementChangeLocked(ctx, settlement, pending, approvedBy); err != nil {
+		return nil, err
+	}
+
+	result := *settlement
+	return &result, nil
+}
+
+// RejectSettlementChange declines the change awaiting approval on a settlement,
+// leaving the settlement as it was
+func (s *CustodianService) RejectSettlementChange(ctx context.Context, settlementID, rejectedBy, reason string) (*Settlement, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	settlement, pending, err := s.pendingChangeLocked(settlementID, rejectedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	amendment := settlement.Amendments[pending]
+	amendment.Status = SettlementChangeRejected
+	amendment.ResolvedBy = rejectedBy
+	amendment.ResolvedAt = &now
+	if reason != "" {
+		amendment.Reason = reason
+	}
+	settlement.Amendments = replaceAmendment(settlement.Amendments, pending, amendment)
+
+	s.auditSettlementChange(ctx, settlement, amendment, "settlement.change_rejected")
+
+	result := *settlement
+	return &result, nil
+}
+
+func (s *CustodianService) requestSettlementChange(ctx context.Context, settlementID, action string, change SettlementChange) (*Settlement, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	settlement, exists := s.settlements[settlementID]
+	if !exists {
+		return nil, fmt.Errorf("settlement %s %w", settlementID, ErrNotFound)
+	}
+	if err := checkSettlementChangeable(settlement, action); err != nil {
+		return nil, err
+	}
+	if pendingAmendment(settlement) >= 0 {
+		return nil, fmt.Errorf("%w: settlement %s already has a change awaiting approval", ErrAlreadyExists, settlementID)
+	}
+
+	if change.RequestedBy == "" {
+		return nil, fmt.Errorf("%w: requested_by is required", ErrInvalidRequest)
+	}
+	if s.settlementSideLocked(settlement, change.RequestedBy) == "" {
+		return nil, fmt.Errorf("%w: %s is not a counterparty to settlement %s", ErrInvalidRequest, change.RequestedBy, settlementID)
+	}
+	bilateral := s.config.SettlementBilateralApproval
+
+	amendment := SettlementAmendment{
+		Sequence:               len(settlement.Amendments) + 1,
+		Action:                 action,
+		Status:                 SettlementChangePendingApproval,
+		RequestedBy:            change.RequestedBy,
+		Reason:                 change.Reason,
+		PreviousAmount:         settlement.Amount,
+		Amount:                 settlement.Amount,
+		PreviousSettlementDate: settlement.SettlementDate,
+		SettlementDate:         settlement.SettlementDate,
+		RequestedAt:            time.Now(),
+	}
+	if change.Amount > 0 {
+		amendment.Amount = change.Amount
+	}
+	if !change.SettlementDate.IsZero() {
+		// An amended date obeys the asset's calendar like a submitted one
+		amendment.SettlementDate = s.calendars.SettlementDate(change.SettlementDate, 0, 0, settlement.AssetID)
+	}
+	settlement.Amendments = replaceAmendment(settlement.Amendments, len(settlement.Amendments), amendment)
+
+	if bilateral {
+		s.auditSettlementChange(ctx, settlement, amendment, "settlement.change_requested")
+	} else if err := s.applySettlementChangeLocked(ctx, settlement, len(settlement.Amendments)-1, ""); err != nil {
+		return nil, err
+	}
+
+	result := *settlement
+	return &result, nil
+}
+
+// applySettlementChangeLocked applies an amendment or cancellation from the history.
+// An amendment raising the amount is screened again, and refused when screening
+// rejects it or its approval quorum is out of reach; once applied it may wait for review or approval like a new
+// settlement. Transfer limits apply to the new amount when it settles.
+func (s *CustodianService) applySettlementChangeLocked(ctx context.Context, settlement *Settlement, index int, approvedBy string) error {
+	now := time.Now()
+	amendment := settlement.Amendments[index]
+
+	raised := amendment.Action == SettlementChangeAmend && amendment.Amount > amendment.PreviousAmount
+	var review *ComplianceReview
+	if raised {
+		subject := transferComplianceSubject(settlement)
+		subject.Amount = amendment.Amount
+		err := s.checkApprovalReachableLocked(settlement.FromAccount, settlement.AssetID, amendment.Amount, settlement.InitiatedBy)
+		if err == nil {
+			review, err = s.screenLocked(ctx, subject)
+		}
+		if err != nil {
+			amendment.Status = SettlementChangeRejected
+			amendment.ResolvedBy = approvedBy
+			amendment.ResolvedAt = &now
+			amendment.Reason = err.Error()
+			settlement.Amendments = replaceAmendment(settlement.Amendments, index, amendment)
+			s.auditSettlementChange(ctx, settlement, amendment, "settlement.change_rejected")
+			return err
+		}
+	}
+
+	amendment.Status = SettlementChangeApplied
+	amendment.ResolvedBy = approvedBy
+	amendment.ResolvedAt = &now
+	settlement.Amendments = replaceAmendment(settlement.Amendments, index, amendment)
+
+	s.logger.WithFields(logrus.Fields{
+		"settlement_id": settlement.ID,
+		"action":        amendment.Action,
+		"requested_by":  amendment.RequestedBy,
+		"approved_by":   approvedBy,
+	}).Info("Settlement change applied")
+
+	if amendment.Action == SettlementChangeCancel {
+		wasFailed := settlement.Status == SettlementStatusFailed
+		settlement.Status = SettlementStatusCancelled
+		if wasFailed {
+			settlement.ResolvedAt = &now
+		}
+		s.cancelSettlementHoldsLocked(ctx, settlement, now)
+		s.publishSettlementTransition(ctx, settlement, amendment.Reason)
+		s.auditSettlementChange(ctx, settlement, amendment, "settlement.cancel")
+		return nil
+	}
+
+	settlement.Amount = amendment.Amount
+	settlement.SettlementDate = amendment.SettlementDate
+	if settlement.Status == SettlementStatusFailed {
+		// The amended instruction starts over
+		settlement.Status = SettlementStatusPending
+		settlement.Reason, settlement.ReasonCode = "", ""
+		settlement.FailedAt, settlement.FailDeadline, settlement.ResolvedAt = nil, nil, nil
+		s.publishSettlementTransition(ctx, settlement, "")
+	}
+	s.auditSettlementChange(ctx, settlement, amendment, "settlement.amend")
+
+	// The outcome is recorded on the settlement
+	if raised {
+		settlement.ApprovalID, settlement.ReviewID = "", ""
+		_ = s.admitTransferLocked(ctx, settlement, review)
+	} else if !settlement.SettlementDate.After(now) {
+		_ = s.processSettlementLocked(ctx, settlement)
+	}
+	return nil
+}
+
+// cancelSettlementHoldsLocked closes the approval request or compliance review a
+// cancelled settlement was waiting in, so neither is left open for a dead settlement
+func (s *CustodianService) cancelSettlementHoldsLocked(ctx context.Context, settlement *Settlement, now time.Time) {
+	if request, exists := s.approvals[settlement.ApprovalID]; exists && request.Status == ApprovalStatusPending {
+		s.resolveApprovalLocked(ctx, request, ApprovalStatusCancelled, now, "settlement cancelled")
+	}
+	if review, exists := s.reviews[settlement.ReviewID]; exists && review.Status == ComplianceReviewPending {
+		s.cancelComplianceReviewLocked(ctx, review, now)
+	}
+}
+
+// pendingChangeLocked finds the change awaiting approval on a settlement and checks
+// that party, who is approving or rejecting it, acts for the other side
+func (s *CustodianService) pendingChangeLocked(settlementID, party string) (*Settlement, int, error) {
+	settlement, exists := s.settlements[settlementID]
+	if !exists {
+		return nil, 0, fmt.Errorf("settlement %s %w", settlementID, ErrNotFound)
+	}
+
+	pending := pendingAmendment(settlement)
+	if pending < 0 {
+		return nil, 0, fmt.Errorf("settlement %s change awaiting approval %w", settlementID, ErrNotFound)
+	}
+	side := s.settlementSideLocked(settlement, party)
+	if side == "" || side == s.settlementSideLocked(settlement, settlement.Amendments[pending].RequestedBy) {
+		return nil, 0, fmt.Errorf("%w: only the other counterparty to settlement %s can approve or reject its change",
+			ErrInvalidRequest, settlementID)
+	}
+	return settlement, pending, nil
+}
+
+func (s *CustodianService) auditSettlementChange(ctx context.Context, settlement *Settlement, amendment SettlementAmendment, action string) {
+	event := settlementAuditEvent(settlement, nil, nil)
+	event.Action = action
+	event.Details["change"] = amendment.Action
+	event.Details["sequence"] = fmt.Sprint(amendment.Sequence)
+	event.Details["requested_by"] = amendment.RequestedBy
+	event.Details["previous_amount"] = formatAmount(amendment.PreviousAmount)
+	event.Details["previous_settlement_date"] = amendment.PreviousSettlementDate.UTC().Format(time.RFC3339)
+	event.Details["settlement_date"] = amendment.SettlementDate.UTC().Format(time.RFC3339)
+	if amendment.Action == SettlementChangeAmend {
+		event.Details["amount"] = formatAmount(amendment.Amount)
+	}
+	if amendment.ResolvedBy != "" {
+		event.Details["resolved_by"] = amendment.ResolvedBy
+	}
+	if amendment.Reason != "" {
+		event.Details["reason"] = amendment.Reason
+	}
+	s.recordAudit(ctx, event)
+}
+
+// checkSettlementChangeable rejects changes once funds have started to move or the
+// settlement has reached a final status. A settlement held for approval or review
+// can be cancelled but not amended, as the hold is for the terms it was submitted on.
+func checkSettlementChangeable(settlement *Settlement, action string) error {
+	switch {
+	case settlement.Status == SettlementStatusPending:
+		return nil
+	case settlement.Status == SettlementStatusPendingApproval || settlement.Status == SettlementStatusPendingReview:
+		if action == SettlementChangeCancel {
+			return nil
+		}
+		return fmt.Errorf("%w: settlement %s is %s and can only be cancelled", ErrSettlementInProgress, settlement.ID, settlement.Status)
+	case settlement.Status == SettlementStatusFailed && settlement.SettledAmount == 0:
+		return nil
+	case settlement.Status == SettlementStatusFailed:
+		return fmt.Errorf("%w: settlement %s is partially settled", ErrSettlementInProgress, settlement.ID)
+	default:
+		return fmt.Errorf("%w: settlement %s is %s", ErrSettlementInProgress, settlement.ID, settlement.Status)
+	}
+}
+
+// Sides of a settlement a party can act for
+const (
+	settlementSideFrom = "from"
+	settlementSideTo   = "to"
+)
+
+// settlementSideLocked returns the side of a settlement party acts for, or "" when
+// party is not a counterparty to it. A party is known by counterparty name, by a
+// current SSI for the asset pointing at one of the accounts, or by account ID. The
+// maker of a settlement between accounts instructed the delivery.
+func (s *CustodianService) settlementSideLocked(settlement *Settlement, party string) string {
+	if party == "" {
+		return ""
+	}
+
+	switch party {
+	case settlement.FromCounterparty, settlement.FromAccount:
+		return settlementSideFrom
+	case settlement.ToCounterparty, settlement.ToAccount:
+		return settlementSideTo
+	}
+	if ssi, err := s.currentSSILocked(party, settlement.AssetID); err == nil {
+		switch ssi.AccountID {
+		case settlement.FromAccount:
+			return settlementSideFrom
+		case settlement.ToAccount:
+			return settlementSideTo
+		}
+	}
+	if settlement.FromCounterparty == "" && party == settlement.InitiatedBy {
+		return settlementSideFrom
+	}
+	return ""
+}
+
+func pendingAmendment(settlement *Settlement) int {
+	for i, amendment := range settlement.Amendments {
+		if amendment.Status == SettlementChangePendingApproval {
+			return i
+		}
+	}
+	return -1
+}
+
+// replaceAmendment returns a copy of history with the entry at index set, appending
+// when index is the length. Copies of the settlement handed to callers share the
+// old slice, so it is never modified in place.
+func replaceAmendment(history []SettlementAmendment, index int, amendment SettlementAmendment) []SettlementAmendment {
+	updated := make([]SettlementAmendment, len(history), len(history)+1)
+	copy(updated, history)
+	if index == len(history) {
+		return append(updated, amendment)
+	}
+	updated[index] = amendment
+	return updated
+}
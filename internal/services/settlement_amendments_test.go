@@ -0,0 +1,316 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestSettlementAmendments verifies unsettled settlements can be amended and cancelled
+// Following BDD Given/When/Then pattern
+func TestSettlementAmendments(t *testing.T) {
+	ctx := context.Background()
+	tomorrow := time.Now().Add(24 * time.Hour)
+
+	t.Run("amends_and_records_history", func(t *testing.T) {
+		// Given: A settlement due tomorrow
+		svc := newTestService(t)
+		from, to := fundedPair(t, svc, "BTC", 5)
+		_, _ = svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_AM1", FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1, SettlementDate: tomorrow})
+
+		// When: Its amount is amended
+		settlement, err := svc.AmendSettlement(ctx, "SETTLE_AM1", services.SettlementChange{RequestedBy: from, Amount: 2, Reason: "allocation corrected"})
+		if err != nil {
+			t.Fatalf("AmendSettlement failed: %v", err)
+		}
+
+		// Then: The new amount applies and the history keeps the old one
+		if settlement.Amount != 2 || settlement.Status != services.SettlementStatusPending {
+			t.Errorf("Expected a pending settlement for 2, got %s for %v", settlement.Status, settlement.Amount)
+		}
+		if len(settlement.Amendments) != 1 {
+			t.Fatalf("Expected 1 amendment, got %d", len(settlement.Amendments))
+		}
+		amendment := settlement.Amendments[0]
+		if amendment.Status != services.SettlementChangeApplied || amendment.PreviousAmount != 1 || amendment.Amount != 2 {
+			t.Errorf("Unexpected amendment %+v", amendment)
+		}
+	})
+
+	t.Run("cancelled_settlements_never_settle", func(t *testing.T) {
+		// Given: A settlement due tomorrow
+		svc := newTestService(t)
+		from, to := fundedPair(t, svc, "ETH", 5)
+		_, _ = svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_AM2", FromAccount: from, ToAccount: to, AssetID: "ETH", Amount: 1, SettlementDate: tomorrow})
+
+		// When: It is cancelled and the settlement date arrives
+		if _, err := svc.CancelSettlement(ctx, "SETTLE_AM2", from, "trade busted"); err != nil {
+			t.Fatalf("CancelSettlement failed: %v", err)
+		}
+		svc.ProcessDueSettlements(ctx, tomorrow)
+
+		// Then: Nothing moved
+		settlement, _ := svc.GetSettlement(ctx, "SETTLE_AM2")
+		if settlement.Status != services.SettlementStatusCancelled {
+			t.Errorf("Expected cancelled, got %s", settlement.Status)
+		}
+		assertBalance(t, svc, to, "ETH", 0)
+	})
+
+	t.Run("bilateral_changes_wait_for_the_other_counterparty", func(t *testing.T) {
+		// Given: Bilateral approval and a settlement due tomorrow
+		svc := newTestService(t, withBilateralApproval())
+		from, to := fundedPair(t, svc, "BTC", 5)
+		_, _ = svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_AM3", FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1, SettlementDate: tomorrow})
+
+		// When: The deliverer requests a cancellation
+		settlement, err := svc.CancelSettlement(ctx, "SETTLE_AM3", from, "duplicate")
+		if err != nil {
+			t.Fatalf("CancelSettlement failed: %v", err)
+		}
+
+		// Then: The settlement is unchanged until approved
+		if settlement.Status != services.SettlementStatusPending || settlement.Amendments[0].Status != services.SettlementChangePendingApproval {
+			t.Errorf("Expected a pending cancellation, got %s / %+v", settlement.Status, settlement.Amendments)
+		}
+
+		// And: The requester cannot approve their own change
+		if _, err := svc.ApproveSettlementChange(ctx, "SETTLE_AM3", from); !errors.Is(err, services.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest for self-approval, got %v", err)
+		}
+
+		// And: The receiver's approval applies it
+		settlement, err = svc.ApproveSettlementChange(ctx, "SETTLE_AM3", to)
+		if err != nil {
+			t.Fatalf("ApproveSettlementChange failed: %v", err)
+		}
+		if settlement.Status != services.SettlementStatusCancelled || settlement.Amendments[0].ResolvedBy != to {
+			t.Errorf("Expected cancellation approved by %s, got %s / %+v", to, settlement.Status, settlement.Amendments[0])
+		}
+	})
+
+	t.Run("rejected_changes_leave_the_settlement_as_it_was", func(t *testing.T) {
+		// Given: Bilateral approval and a pending amendment
+		svc := newTestService(t, withBilateralApproval())
+		from, to := fundedPair(t, svc, "BTC", 5)
+		_, _ = svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_AM4", FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1, SettlementDate: tomorrow})
+		_, _ = svc.AmendSettlement(ctx, "SETTLE_AM4", services.SettlementChange{RequestedBy: to, Amount: 3})
+
+		// When: The other counterparty rejects it
+		settlement, err := svc.RejectSettlementChange(ctx, "SETTLE_AM4", from, "amount disputed")
+		if err != nil {
+			t.Fatalf("RejectSettlementChange failed: %v", err)
+		}
+
+		// Then: The amount is unchanged and the rejection is in the history
+		if settlement.Amount != 1 || settlement.Amendments[0].Status != services.SettlementChangeRejected {
+			t.Errorf("Unexpected settlement after rejection %+v", settlement)
+		}
+	})
+
+	t.Run("raised_amounts_wait_for_approval_again", func(t *testing.T) {
+		// Given: Approval for BTC transfers of 10 or more and a 1 BTC settlement due tomorrow
+		svc := newTestService(t, withApprovals("BTC=10"))
+		from, to := fundedPair(t, svc, "BTC", 20)
+		settlement, _ := svc.SubmitSettlementInstruction(ctx, services.Settlement{
+			FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1, InitiatedBy: "alice", SettlementDate: tomorrow,
+		})
+
+		// When: The amount is raised to 15 and brought forward to now
+		settlement, err := svc.AmendSettlement(ctx, settlement.ID, services.SettlementChange{RequestedBy: "alice", Amount: 15, SettlementDate: time.Now()})
+		if err != nil {
+			t.Fatalf("AmendSettlement failed: %v", err)
+		}
+
+		// Then: The amended settlement waits for approval instead of settling
+		if settlement.Status != services.SettlementStatusPendingApproval || settlement.ApprovalID == "" {
+			t.Errorf("Expected pending_approval, got %s", settlement.Status)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+	})
+
+	t.Run("raised_amounts_are_screened_again", func(t *testing.T) {
+		// Given: A 1 BTC settlement due tomorrow, then a screener rejecting everything
+		svc := newTestService(t)
+		from, to := fundedPair(t, svc, "BTC", 20)
+		settlement, _ := svc.SubmitSettlementInstruction(ctx, services.Settlement{FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1, SettlementDate: tomorrow})
+		svc.SetComplianceScreener(fixedScreener{Decision: ports.ComplianceReject, Reasons: []string{"sanctioned_entity"}})
+
+		// When: The amount is raised
+		_, err := svc.AmendSettlement(ctx, settlement.ID, services.SettlementChange{RequestedBy: from, Amount: 15})
+
+		// Then: The amendment is refused and the amount is unchanged
+		if !errors.Is(err, services.ErrComplianceRejected) {
+			t.Errorf("Expected ErrComplianceRejected, got %v", err)
+		}
+		settlement, _ = svc.GetSettlement(ctx, settlement.ID)
+		if settlement.Amount != 1 || settlement.Amendments[0].Status != services.SettlementChangeRejected {
+			t.Errorf("Expected 1 BTC with a rejected amendment, got %+v", settlement)
+		}
+	})
+
+	t.Run("raised_amounts_are_limited_when_they_settle", func(t *testing.T) {
+		// Given: A 5 BTC per-transaction limit and a 1 BTC settlement due tomorrow
+		svc := newTestService(t, func(cfg *config.Config) { cfg.TransferLimitPerTransaction = "BTC=5" })
+		from, to := fundedPair(t, svc, "BTC", 20)
+		settlement, _ := svc.SubmitSettlementInstruction(ctx, services.Settlement{FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1, SettlementDate: tomorrow})
+
+		// When: The amount is raised to 6 and brought forward to now
+		settlement, err := svc.AmendSettlement(ctx, settlement.ID, services.SettlementChange{RequestedBy: from, Amount: 6, SettlementDate: time.Now()})
+		if err != nil {
+			t.Fatalf("AmendSettlement failed: %v", err)
+		}
+
+		// Then: It fails on the limit and nothing moves
+		if settlement.Status != services.SettlementStatusFailed || settlement.ReasonCode != services.FailReasonLimitExceeded {
+			t.Errorf("Expected failed with %s, got %s with %s", services.FailReasonLimitExceeded, settlement.Status, settlement.ReasonCode)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+	})
+
+	t.Run("cancels_settlements_awaiting_approval", func(t *testing.T) {
+		// Given: A settlement waiting for approval
+		svc := newTestService(t, withApprovals("BTC=10"))
+		from, to := fundedPair(t, svc, "BTC", 20)
+		settlement, _ := svc.SubmitSettlementInstruction(ctx, services.Settlement{FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 15, InitiatedBy: "alice"})
+
+		// When: It is cancelled
+		if _, err := svc.AmendSettlement(ctx, settlement.ID, services.SettlementChange{RequestedBy: "alice", Amount: 16}); !errors.Is(err, services.ErrSettlementInProgress) {
+			t.Errorf("Expected amendments to be refused while awaiting approval, got %v", err)
+		}
+		settlement, err := svc.CancelSettlement(ctx, settlement.ID, from, "trade busted")
+		if err != nil {
+			t.Fatalf("CancelSettlement failed: %v", err)
+		}
+
+		// Then: The settlement is cancelled and its approval request closed
+		if settlement.Status != services.SettlementStatusCancelled {
+			t.Errorf("Expected cancelled, got %s", settlement.Status)
+		}
+		request, _ := svc.GetApprovalRequest(ctx, settlement.ApprovalID)
+		if request.Status != services.ApprovalStatusCancelled {
+			t.Errorf("Expected the approval request to be cancelled, got %s", request.Status)
+		}
+		if _, err := svc.ApproveOperation(ctx, settlement.ApprovalID, "bob"); !errors.Is(err, services.ErrInvalidRequest) {
+			t.Errorf("Expected the closed request to refuse approval, got %v", err)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+	})
+
+	t.Run("cancels_settlements_held_for_review", func(t *testing.T) {
+		// Given: A settlement held by compliance
+		svc := newTestService(t)
+		svc.SetComplianceScreener(fixedScreener{Decision: ports.ComplianceHold, Reasons: []string{"large_transfer"}})
+		from, to := fundedPair(t, svc, "BTC", 5)
+		settlement, _ := svc.SubmitSettlementInstruction(ctx, services.Settlement{FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1})
+
+		// When: It is cancelled
+		settlement, err := svc.CancelSettlement(ctx, settlement.ID, from, "trade busted")
+		if err != nil {
+			t.Fatalf("CancelSettlement failed: %v", err)
+		}
+
+		// Then: The review is closed and cannot release the settlement
+		review, _ := svc.GetComplianceReview(ctx, settlement.ReviewID)
+		if review.Status != services.ComplianceReviewCancelled {
+			t.Errorf("Expected the review to be cancelled, got %s", review.Status)
+		}
+		if _, err := svc.ReleaseComplianceReview(ctx, settlement.ReviewID, "officer", ""); !errors.Is(err, services.ErrInvalidRequest) {
+			t.Errorf("Expected the closed review to refuse release, got %v", err)
+		}
+		assertBalance(t, svc, to, "BTC", 0)
+	})
+
+	t.Run("only_counterparties_can_change_a_settlement", func(t *testing.T) {
+		// Given: A settlement due tomorrow and a third account
+		svc := newTestService(t)
+		from, to := fundedPair(t, svc, "BTC", 5)
+		outsider, _ := svc.CreateAccount(ctx, "TRADING")
+		settlement, _ := svc.SubmitSettlementInstruction(ctx, services.Settlement{FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1, SettlementDate: tomorrow})
+
+		// When: The outsider, and a caller naming nobody, try to cancel it
+		_, outsiderErr := svc.CancelSettlement(ctx, settlement.ID, outsider.ID, "not mine")
+		_, anonymousErr := svc.CancelSettlement(ctx, settlement.ID, "", "not mine")
+
+		// Then: Both are refused and the settlement stays pending
+		for _, err := range []error{outsiderErr, anonymousErr} {
+			if !errors.Is(err, services.ErrInvalidRequest) {
+				t.Errorf("Expected ErrInvalidRequest, got %v", err)
+			}
+		}
+		settlement, _ = svc.GetSettlement(ctx, settlement.ID)
+		if settlement.Status != services.SettlementStatusPending || len(settlement.Amendments) != 0 {
+			t.Errorf("Expected an unchanged pending settlement, got %s / %+v", settlement.Status, settlement.Amendments)
+		}
+	})
+
+	t.Run("counterparties_are_known_by_their_ssis", func(t *testing.T) {
+		// Given: Bilateral approval, SSIs naming each account's counterparty, and a settlement between the accounts
+		svc := newTestService(t, withBilateralApproval())
+		from, to := fundedPair(t, svc, "BTC", 5)
+		for counterparty, account := range map[string]string{"DEALER_A": from, "DEALER_B": to} {
+			if _, err := svc.PutStandingSettlementInstruction(ctx, services.StandingSettlementInstruction{
+				Counterparty: counterparty, AssetID: "BTC", AccountID: account,
+			}, 0); err != nil {
+				t.Fatalf("PutStandingSettlementInstruction failed: %v", err)
+			}
+		}
+		settlement, _ := svc.SubmitSettlementInstruction(ctx, services.Settlement{FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1, SettlementDate: tomorrow})
+
+		// When: DEALER_A requests a cancellation and DEALER_B approves it
+		if _, err := svc.CancelSettlement(ctx, settlement.ID, "DEALER_A", "duplicate"); err != nil {
+			t.Fatalf("CancelSettlement failed: %v", err)
+		}
+		settlement, err := svc.ApproveSettlementChange(ctx, settlement.ID, "DEALER_B")
+
+		// Then: The settlement is cancelled
+		if err != nil {
+			t.Fatalf("ApproveSettlementChange failed: %v", err)
+		}
+		if settlement.Status != services.SettlementStatusCancelled {
+			t.Errorf("Expected cancelled, got %s", settlement.Status)
+		}
+	})
+
+	t.Run("amended_dates_follow_the_business_calendar", func(t *testing.T) {
+		// Given: A settlement due tomorrow and a BTC holiday the day after
+		dayAfter := time.Now().UTC().AddDate(0, 0, 2)
+		svc := newTestService(t, func(cfg *config.Config) { cfg.SettlementHolidays = "BTC=" + dayAfter.Format(time.DateOnly) })
+		from, to := fundedPair(t, svc, "BTC", 5)
+		settlement, _ := svc.SubmitSettlementInstruction(ctx, services.Settlement{FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1, SettlementDate: tomorrow})
+
+		// When: It is moved to the holiday
+		settlement, err := svc.AmendSettlement(ctx, settlement.ID, services.SettlementChange{RequestedBy: from, SettlementDate: dayAfter})
+		if err != nil {
+			t.Fatalf("AmendSettlement failed: %v", err)
+		}
+
+		// Then: It settles on the next business day instead
+		next := time.Date(dayAfter.Year(), dayAfter.Month(), dayAfter.Day()+1, 0, 0, 0, 0, time.UTC)
+		if !settlement.SettlementDate.Equal(next) {
+			t.Errorf("Expected %v, got %v", next, settlement.SettlementDate)
+		}
+	})
+
+	t.Run("rejects_changes_once_settled", func(t *testing.T) {
+		// Given: A settlement that has completed
+		svc := newTestService(t)
+		from, to := fundedPair(t, svc, "BTC", 5)
+		_, _ = svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_AM5", FromAccount: from, ToAccount: to, AssetID: "BTC", Amount: 1})
+
+		// When: A cancellation is requested
+		_, err := svc.CancelSettlement(ctx, "SETTLE_AM5", from, "too late")
+
+		// Then: It is rejected as in progress
+		if !errors.Is(err, services.ErrSettlementInProgress) {
+			t.Errorf("Expected ErrSettlementInProgress, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Reason codes recorded on failed settlements
+const (
+	FailReasonInsufficientBalance = "INSUFFICIENT_BALANCE"
+	FailReasonAccountInactive     = "ACCOUNT_INACTIVE"
+	FailReasonAccountNotFound     = "ACCOUNT_NOT_FOUND"
+	FailReasonLimitExceeded       = "LIMIT_EXCEEDED"
+	FailReasonInvalidInstruction  = "INVALID_INSTRUCTION"
+	FailReasonUnknown             = "UNKNOWN"
+)
+
+// FailsReport summarises the settlements that were failing at any time on one UTC day
+type FailsReport struct {
+	Date             time.Time          `json:"date"`
+	GeneratedAt      time.Time          `json:"generated_at"`
+	Fails            []Settlement       `json:"fails"`
+	CountByReason    map[string]int     `json:"count_by_reason"`
+	UnsettledByAsset map[string]float64 `json:"unsettled_by_asset"` // Outstanding on fails not yet completed
+}
+
+// RetryFailedSettlements retries every failed settlement that has a FailDeadline,
+// oldest first. Settlements still failing after their deadline expire instead.
+func (s *CustodianService) RetryFailedSettlements(ctx context.Context, now time.Time) (retried, expired int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var failed []*Settlement
+	for _, settlement := range s.settlements {
+		if settlement.Status == SettlementStatusFailed && settlement.FailDeadline != nil {
+			failed = append(failed, settlement)
+		}
+	}
+	sortSettlements(failed)
+
+	for _, settlement := range failed {
+		if now.After(*settlement.FailDeadline) {
+			s.expireSettlementLocked(ctx, settlement, now)
+			expired++
+			continue
+		}
+		// The outcome is recorded on the settlement
+		_ = s.processSettlementLocked(ctx, settlement)
+		retried++
+	}
+	return retried, expired
+}
+
+// FailsReport returns the settlements that failed on or before date's UTC day and
+// were still unresolved at some point during it
+func (s *CustodianService) FailsReport(ctx context.Context, date time.Time) FailsReport {
+	date = date.UTC()
+	dayStart := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var fails []*Settlement
+	for _, settlement := range s.settlements {
+		if settlement.FailedAt == nil || !settlement.FailedAt.Before(dayEnd) {
+			continue
+		}
+		if settlement.ResolvedAt != nil && settlement.ResolvedAt.Before(dayStart) {
+			continue
+		}
+		fails = append(fails, settlement)
+	}
+	sortSettlements(fails)
+
+	report := FailsReport{
+		Date:             dayStart,
+		GeneratedAt:      time.Now(),
+		Fails:            make([]Settlement, 0, len(fails)),
+		CountByReason:    make(map[string]int),
+		UnsettledByAsset: make(map[string]float64),
+	}
+	for _, settlement := range fails {
+		report.Fails = append(report.Fails, *settlement)
+		report.CountByReason[settlement.ReasonCode]++
+		if settlement.Status != SettlementStatusCompleted {
+			report.UnsettledByAsset[settlement.AssetID] += settlement.Amount - settlement.SettledAmount
+		}
+	}
+	return report
+}
+
+// settlePartiallyLocked moves whatever is available when a settlement that allows
+// partial settlement fails for lack of funds, and reports whether anything moved
+func (s *CustodianService) settlePartiallyLocked(ctx context.Context, settlement *Settlement, err error) bool {
+	if !settlement.AllowPartial || !errors.Is(err, ErrInsufficientBalance) {
+		return false
+	}
+
+	available := s.availableBalanceLocked(settlement.FromAccount, settlement.AssetID)
+	if available <= 0 || s.applySettlementLocked(ctx, settlement, available) != nil {
+		return false
+	}
+	settlement.SettledAmount += available
+	return true
+}
+
+// failSettlementLocked records a failed attempt. The first failure of a retryable
+// kind sets FailDeadline, SettlementFailDeadline after the settlement date, and
+// RetryFailedSettlements retries it until then; any other failure is resolved when
+// it happens. The failure is published and audited when it is new, its reason code
+// changes or part of it settled, so retries that change nothing stay quiet.
+func (s *CustodianService) failSettlementLocked(ctx context.Context, settlement *Settlement, err error, partial bool, balances []ports.AuditBalance) error {
+	now := time.Now()
+	code := failReasonCode(err)
+	changed := settlement.Status != SettlementStatusFailed || settlement.ReasonCode != code || partial
+
+	settlement.Status = SettlementStatusFailed
+	settlement.Reason = err.Error()
+	settlement.ReasonCode = code
+	if settlement.FailedAt == nil {
+		settlement.FailedAt = &now
+		if window := s.config.SettlementFailDeadline; window > 0 && retryableFailReason(code) {
+			base := settlement.SettlementDate
+			if base.IsZero() {
+				base = now
+			}
+			deadline := base.Add(window)
+			settlement.FailDeadline = &deadline
+		} else {
+			// Nothing retries it, so the fail is final and reported only today
+			settlement.ResolvedAt = &now
+		}
+	}
+
+	if changed {
+		s.publishSettlementTransition(ctx, settlement, err.Error())
+		s.recordAudit(ctx, settlementAuditEvent(settlement, balances, err))
+	}
+
+	if settlement.FailDeadline != nil {
+		return fmt.Errorf("settlement %s failed, retrying until %s: %w",
+			settlement.ID, settlement.FailDeadline.UTC().Format(time.RFC3339), err)
+	}
+	return err
+}
+
+func (s *CustodianService) expireSettlementLocked(ctx context.Context, settlement *Settlement, now time.Time) {
+	settlement.Status = SettlementStatusExpired
+	settlement.ResolvedAt = &now
+	reason := "fail deadline passed: " + settlement.Reason
+
+	s.publishSettlementTransition(ctx, settlement, reason)
+	event := settlementAuditEvent(settlement, nil, errors.New(reason))
+	event.Action = "settlement.expire"
+	s.recordAudit(ctx, event)
+
+	s.logger.WithFields(logrus.Fields{
+		"settlement_id":  settlement.ID,
+		"reason_code":    settlement.ReasonCode,
+		"attempts":       settlement.Attempts,
+		"settled_amount": settlement.SettledAmount,
+		"amount":         settlement.Amount,
+	}).Warn("Settlement expired at its fail deadline")
+}
+
+// failReasonCode classifies a settlement error
+func failReasonCode(err error) string {
+	switch {
+	case errors.Is(err, ErrInsufficientBalance):
+		return FailReasonInsufficientBalance
+	case errors.Is(err, ErrAccountInactive):
+		return FailReasonAccountInactive
+	case errors.Is(err, ErrNotFound):
+		return FailReasonAccountNotFound
+	case errors.Is(err, ErrLimitExceeded):
+		return FailReasonLimitExceeded
+	case errors.Is(err, ErrInvalidRequest):
+		return FailReasonInvalidInstruction
+	default:
+		return FailReasonUnknown
+	}
+}
+
+// retryableFailReason reports whether a failure can clear without changing the instruction
+func retryableFailReason(code string) bool {
+	return code == FailReasonInsufficientBalance || code == FailReasonAccountInactive || code == FailReasonLimitExceeded
+}
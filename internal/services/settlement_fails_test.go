@@ -0,0 +1,226 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestSettlementScheduler verifies the scheduler reports whether it is running
+// Following BDD Given/When/Then pattern
+func TestSettlementScheduler(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reports_running_only_between_start_and_stop", func(t *testing.T) {
+		// Given: A scheduler that has not been started
+		logger := logrus.New()
+		logger.SetOutput(io.Discard)
+		scheduler := services.NewSettlementScheduler(newTestService(t), 10*time.Millisecond, logger)
+		if err := scheduler.CheckRunning(ctx); err == nil {
+			t.Error("Expected an unstarted scheduler to report it is not running")
+		}
+
+		// When: It is started and has had time to run
+		scheduler.Start()
+		time.Sleep(50 * time.Millisecond)
+
+		// Then: It reports running until stopped
+		if err := scheduler.CheckRunning(ctx); err != nil {
+			t.Errorf("Expected a running scheduler, got %v", err)
+		}
+		scheduler.Stop()
+		if err := scheduler.CheckRunning(ctx); err == nil {
+			t.Error("Expected a stopped scheduler to report it is not running")
+		}
+	})
+}
+
+// TestSettlementFails verifies failed settlements are kept, retried, expired and reported
+// Following BDD Given/When/Then pattern
+func TestSettlementFails(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retries_failed_settlements_until_funded", func(t *testing.T) {
+		// Given: A settlement that fails for lack of funds
+		svc := newTestService(t, withFailDeadline(time.Hour))
+		from, _ := svc.CreateAccount(ctx, "TRADING")
+		to, _ := svc.CreateAccount(ctx, "TRADING")
+		if _, err := svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_F1", FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 1}); err != nil {
+			t.Fatalf("SubmitSettlementInstruction failed: %v", err)
+		}
+
+		// Then: It is retained with a reason code and a fail deadline
+		failed, _ := svc.GetSettlement(ctx, "SETTLE_F1")
+		if failed.Status != services.SettlementStatusFailed || failed.ReasonCode != services.FailReasonInsufficientBalance || failed.FailDeadline == nil {
+			t.Fatalf("Unexpected failed settlement %+v", failed)
+		}
+
+		// When: The account is funded and the next settlement run retries
+		_, _ = svc.Deposit(ctx, from.ID, "BTC", 1)
+		retried, expired := svc.RetryFailedSettlements(ctx, time.Now())
+
+		// Then: The settlement completes
+		if retried != 1 || expired != 0 {
+			t.Errorf("Expected 1 retried and 0 expired, got %d and %d", retried, expired)
+		}
+		settled, _ := svc.GetSettlement(ctx, "SETTLE_F1")
+		if settled.Status != services.SettlementStatusCompleted || settled.Attempts != 2 || settled.ResolvedAt == nil {
+			t.Errorf("Unexpected retried settlement %+v", settled)
+		}
+		assertBalance(t, svc, to.ID, "BTC", 1)
+	})
+
+	t.Run("processes_settlement_objects_directly", func(t *testing.T) {
+		// Given: An undated settlement from an account short of funds
+		svc := newTestService(t, withFailDeadline(time.Hour))
+		from, _ := svc.CreateAccount(ctx, "TRADING")
+		to, _ := svc.CreateAccount(ctx, "TRADING")
+		settlement := &services.Settlement{FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 1}
+
+		// When: It is processed
+		err := svc.ProcessSettlement(ctx, settlement)
+
+		// Then: It is stored failed for retry, with the outcome written back
+		if !errors.Is(err, services.ErrInsufficientBalance) {
+			t.Errorf("Expected ErrInsufficientBalance, got %v", err)
+		}
+		if settlement.ID == "" || settlement.Status != services.SettlementStatusFailed || settlement.FailDeadline == nil {
+			t.Fatalf("Unexpected settlement %+v", settlement)
+		}
+
+		// And: A funded settlement settles at once
+		_, _ = svc.Deposit(ctx, from.ID, "BTC", 2)
+		funded := &services.Settlement{FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 1}
+		if err := svc.ProcessSettlement(ctx, funded); err != nil {
+			t.Fatalf("ProcessSettlement failed: %v", err)
+		}
+		if funded.Status != services.SettlementStatusCompleted {
+			t.Errorf("Expected completed, got %s", funded.Status)
+		}
+		assertBalance(t, svc, to.ID, "BTC", 1)
+	})
+
+	t.Run("transfers_kept_for_retry_are_not_errors", func(t *testing.T) {
+		// Given: An account short of funds
+		svc := newTestService(t, withFailDeadline(time.Hour))
+		from, _ := svc.CreateAccount(ctx, "TRADING")
+		to, _ := svc.CreateAccount(ctx, "TRADING")
+
+		// When: A transfer is made
+		id, err := svc.Transfer(from.ID, to.ID, "BTC", 1)
+
+		// Then: It is accepted, and the failure is reported on the settlement kept for retry
+		if err != nil || id == "" {
+			t.Fatalf("Expected an accepted transfer, got %q and %v", id, err)
+		}
+		settlement, _ := svc.GetSettlement(ctx, id)
+		if settlement.Status != services.SettlementStatusFailed || settlement.FailDeadline == nil || settlement.Reason == "" {
+			t.Errorf("Unexpected settlement %+v", settlement)
+		}
+	})
+
+	t.Run("expires_at_the_fail_deadline", func(t *testing.T) {
+		// Given: A failing settlement
+		svc := newTestService(t, withFailDeadline(time.Hour))
+		from, _ := svc.CreateAccount(ctx, "TRADING")
+		to, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_F2", FromAccount: from.ID, ToAccount: to.ID, AssetID: "ETH", Amount: 3})
+
+		// When: A settlement run happens after the deadline
+		_, expired := svc.RetryFailedSettlements(ctx, time.Now().Add(2*time.Hour))
+
+		// Then: It expires and is no longer retried
+		settlement, _ := svc.GetSettlement(ctx, "SETTLE_F2")
+		if expired != 1 || settlement.Status != services.SettlementStatusExpired {
+			t.Errorf("Expected the settlement to expire, got %s", settlement.Status)
+		}
+		if retried, _ := svc.RetryFailedSettlements(ctx, time.Now()); retried != 0 {
+			t.Errorf("Expected no retries after expiry, got %d", retried)
+		}
+	})
+
+	t.Run("settles_partially_when_allowed", func(t *testing.T) {
+		// Given: An account holding 0.4 of a 1 BTC settlement that allows partial settlement
+		svc := newTestService(t, withFailDeadline(time.Hour))
+		from, _ := svc.CreateAccount(ctx, "TRADING")
+		to, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.Deposit(ctx, from.ID, "BTC", 0.4)
+
+		// When: The settlement is processed
+		_, _ = svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_F3", FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 1, AllowPartial: true})
+
+		// Then: The available amount moves and the rest stays failed
+		settlement, _ := svc.GetSettlement(ctx, "SETTLE_F3")
+		if settlement.Status != services.SettlementStatusFailed || settlement.SettledAmount != 0.4 {
+			t.Errorf("Expected a partially settled failure, got %s with %v settled", settlement.Status, settlement.SettledAmount)
+		}
+		assertBalance(t, svc, to.ID, "BTC", 0.4)
+
+		// And: The remainder settles on a later run
+		_, _ = svc.Deposit(ctx, from.ID, "BTC", 1)
+		_, _ = svc.RetryFailedSettlements(ctx, time.Now())
+		assertBalance(t, svc, to.ID, "BTC", 1)
+		assertBalance(t, svc, from.ID, "BTC", 0.4)
+	})
+
+	t.Run("does_not_retry_invalid_instructions", func(t *testing.T) {
+		// Given: A settlement from an account that does not exist
+		svc := newTestService(t, withFailDeadline(time.Hour))
+		to, _ := svc.CreateAccount(ctx, "TRADING")
+
+		// When: It is processed
+		_, _ = svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_F4", FromAccount: "ACCT_missing", ToAccount: to.ID, AssetID: "BTC", Amount: 1})
+
+		// Then: It fails without a fail deadline
+		settlement, _ := svc.GetSettlement(ctx, "SETTLE_F4")
+		if settlement.ReasonCode != services.FailReasonAccountNotFound || settlement.FailDeadline != nil {
+			t.Errorf("Unexpected settlement %+v", settlement)
+		}
+
+		// And: The fail is resolved, so it is reported today but not tomorrow
+		if settlement.ResolvedAt == nil {
+			t.Fatal("Expected the fail to be resolved")
+		}
+		if today := svc.FailsReport(ctx, time.Now()); len(today.Fails) != 1 {
+			t.Errorf("Expected 1 fail today, got %d", len(today.Fails))
+		}
+		if tomorrow := svc.FailsReport(ctx, time.Now().AddDate(0, 0, 1)); len(tomorrow.Fails) != 0 {
+			t.Errorf("Expected no fails tomorrow, got %d", len(tomorrow.Fails))
+		}
+	})
+
+	t.Run("summarises_the_days_fails", func(t *testing.T) {
+		// Given: One settlement still failing and one that failed and then settled
+		svc := newTestService(t, withFailDeadline(time.Hour))
+		from, _ := svc.CreateAccount(ctx, "TRADING")
+		to, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_A", FromAccount: from.ID, ToAccount: to.ID, AssetID: "USD", Amount: 500})
+		_, _ = svc.SubmitSettlementInstruction(ctx, services.Settlement{ID: "SETTLE_B", FromAccount: from.ID, ToAccount: to.ID, AssetID: "BTC", Amount: 1})
+		_, _ = svc.Deposit(ctx, from.ID, "BTC", 1)
+		_, _ = svc.RetryFailedSettlements(ctx, time.Now())
+
+		// When: Today's fails report is generated
+		report := svc.FailsReport(ctx, time.Now())
+
+		// Then: Both fails are listed, with only the open one outstanding
+		if len(report.Fails) != 2 || report.CountByReason[services.FailReasonInsufficientBalance] != 2 {
+			t.Errorf("Expected 2 insufficient balance fails, got %+v", report)
+		}
+		if report.UnsettledByAsset["USD"] != 500 || report.UnsettledByAsset["BTC"] != 0 {
+			t.Errorf("Unexpected unsettled amounts %v", report.UnsettledByAsset)
+		}
+
+		// And: Yesterday's report is empty
+		if yesterday := svc.FailsReport(ctx, time.Now().AddDate(0, 0, -1)); len(yesterday.Fails) != 0 {
+			t.Errorf("Expected no fails yesterday, got %d", len(yesterday.Fails))
+		}
+	})
+}
@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SubmitSettlementInstruction records a settlement to be made on its SettlementDate.
+// Without a SettlementDate the instruction settles on the asset's current business
+// day, or the next one when submitted after cut-off. Instructions already due are
+// processed immediately; the returned copy carries the outcome. A settlement
+// failure is recorded on the instruction and not returned as an error, since the
+// instruction itself was accepted. Instructions the compliance screener holds wait
+// in pending_review, and those the approval policy qualifies in pending_approval;
+// see ReleaseComplianceReview and ApproveOperation. Like unilateral transfers,
+// instructions are refused when settlement matching is required.
+func (s *CustodianService) SubmitSettlementInstruction(ctx context.Context, settlement Settlement) (*Settlement, error) {
+	if err := s.checkUnilateralSettlement(); err != nil {
+		return nil, err
+	}
+	if settlement.Amount <= 0 {
+		return nil, fmt.Errorf("%w: settlement amount must be positive", ErrInvalidRequest)
+	}
+	if settlement.FromAccount == "" || settlement.ToAccount == "" || settlement.AssetID == "" {
+		return nil, fmt.Errorf("%w: from_account, to_account and asset_id are required", ErrInvalidRequest)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.submitSettlementLocked(ctx, settlement)
+}
+
+// submitSettlementLocked stores and admits a settlement, recording the outcome of
+// processing it on the settlement, and returns a copy
+func (s *CustodianService) submitSettlementLocked(ctx context.Context, settlement Settlement) (*Settlement, error) {
+	stored, review, err := s.storeSettlementLocked(ctx, settlement)
+	if err != nil {
+		return nil, err
+	}
+
+	// The outcome is recorded on the settlement
+	_ = s.admitTransferLocked(ctx, stored, review)
+
+	result := *stored
+	return &result, nil
+}
+
+// storeSettlementLocked dates, screens and stores a new pending settlement, and
+// returns it with the review screening opened for it, if any, for
+// admitTransferLocked. Every settlement enters through here.
+func (s *CustodianService) storeSettlementLocked(ctx context.Context, settlement Settlement) (*Settlement, *ComplianceReview, error) {
+	if settlement.ID == "" {
+		settlement.ID = generateSettlementID()
+	}
+	if _, exists := s.settlements[settlement.ID]; exists {
+		return nil, nil, fmt.Errorf("settlement %s %w", settlement.ID, ErrAlreadyExists)
+	}
+
+	now := time.Now()
+	if settlement.CreatedAt.IsZero() {
+		settlement.CreatedAt = now
+	}
+	if settlement.SettlementDate.IsZero() {
+		settlement.SettlementDate = s.calendars.SettlementDate(now, 0, 0, settlement.AssetID)
+	}
+	settlement.Status = SettlementStatusPending
+	settlement.Reason = ""
+	settlement.ApprovalID, settlement.ReviewID = "", ""
+
+	if err := s.checkApprovalReachableLocked(settlement.FromAccount, settlement.AssetID, settlement.Amount, settlement.InitiatedBy); err != nil {
+		return nil, nil, err
+	}
+	review, err := s.screenLocked(ctx, transferComplianceSubject(&settlement))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stored := &settlement
+	s.settlements[stored.ID] = stored
+	s.publishSettlementTransition(ctx, stored, "")
+	submitted := settlementAuditEvent(stored, nil, nil)
+	submitted.Action = "settlement.submit"
+	submitted.Details["settlement_date"] = stored.SettlementDate.UTC().Format(time.RFC3339)
+	s.recordAudit(ctx, submitted)
+
+	return stored, review, nil
+}
+
+// SettlementDate returns the settlement date of an instruction between the assets
+// submitted at submittedAt on a T+cycleDays cycle, honouring their business
+// calendars and cut-offs
+func (s *CustodianService) SettlementDate(submittedAt time.Time, cycleDays int, assets ...string) time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.calendars.SettlementDate(submittedAt, cycleDays, 0, assets...)
+}
+
+// ProcessDueSettlements settles every pending instruction whose SettlementDate is at
+// or before now, oldest first, and returns how many were processed
+func (s *CustodianService) ProcessDueSettlements(ctx context.Context, now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*Settlement
+	for _, settlement := range s.settlements {
+		if settlement.Status == SettlementStatusPending && !settlement.SettlementDate.After(now) {
+			due = append(due, settlement)
+		}
+	}
+	sortSettlements(due)
+
+	for _, settlement := range due {
+		_ = s.processSettlementLocked(ctx, settlement)
+	}
+	return len(due)
+}
+
+// GetSettlement returns a copy of a settlement by ID
+func (s *CustodianService) GetSettlement(ctx context.Context, settlementID string) (*Settlement, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	settlement, exists := s.settlements[settlementID]
+	if !exists {
+		return nil, fmt.Errorf("settlement %s %w", settlementID, ErrNotFound)
+	}
+
+	result := *settlement
+	return &result, nil
+}
+
+// ListSettlements returns settlements oldest first, filtered by status unless status is empty
+func (s *CustodianService) ListSettlements(ctx context.Context, status string) []Settlement {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	list := make([]*Settlement, 0, len(s.settlements))
+	for _, settlement := range s.settlements {
+		if status == "" || settlement.Status == status {
+			list = append(list, settlement)
+		}
+	}
+	sortSettlements(list)
+
+	result := make([]Settlement, 0, len(list))
+	for _, settlement := range list {
+		result = append(result, *settlement)
+	}
+	return result
+}
+
+func sortSettlements(list []*Settlement) {
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].SettlementDate.Equal(list[j].SettlementDate) {
+			return list[i].SettlementDate.Before(list[j].SettlementDate)
+		}
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+}
+
+// SettlementScheduler periodically settles instructions that have come due, retries
+// failed settlements, expires pending approvals and logs the fails report for each UTC day as it ends
+type SettlementScheduler struct {
+	custodian *CustodianService
+	interval  time.Duration
+	logger    *logrus.Logger
+
+	reportedDay time.Time // Start of the UTC day whose fails report is next due
+
+	heartbeat atomic.Int64 // Unix nanoseconds when the loop was last ready for a tick; 0 until started
+	stopped   atomic.Bool
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+	once sync.Once
+}
+
+func NewSettlementScheduler(custodian *CustodianService, interval time.Duration, logger *logrus.Logger) *SettlementScheduler {
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	return &SettlementScheduler{
+		custodian: custodian,
+		interval:  interval,
+		logger:    logger,
+		stop:      make(chan struct{}),
+	}
+}
+
+func (s *SettlementScheduler) Start() {
+	s.heartbeat.Store(time.Now().UnixNano())
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.stop:
+				return
+			case now := <-ticker.C:
+				s.run(now)
+				s.heartbeat.Store(time.Now().UnixNano())
+			}
+		}
+	}()
+}
+
+// CheckRunning reports an error unless the scheduler is started, not stopped and
+// finishing its runs; a run still going after a few intervals counts as stuck
+func (s *SettlementScheduler) CheckRunning(ctx context.Context) error {
+	heartbeat := s.heartbeat.Load()
+	switch {
+	case heartbeat == 0:
+		return fmt.Errorf("settlement scheduler not started")
+	case s.stopped.Load():
+		return fmt.Errorf("settlement scheduler stopped")
+	}
+	if since := time.Since(time.Unix(0, heartbeat)); since > 3*s.interval {
+		return fmt.Errorf("settlement scheduler has not completed a run for %s", since.Round(time.Millisecond))
+	}
+	return nil
+}
+
+// run is one settlement run
+func (s *SettlementScheduler) run(now time.Time) {
+	ctx := context.Background()
+
+	if processed := s.custodian.ProcessDueSettlements(ctx, now); processed > 0 {
+		s.logger.WithField("processed", processed).Info("Processed due settlements")
+	}
+	if retried, expired := s.custodian.RetryFailedSettlements(ctx, now); retried > 0 || expired > 0 {
+		s.logger.WithFields(logrus.Fields{
+			"retried": retried,
+			"expired": expired,
+		}).Debug("Retried failed settlements")
+	}
+	if expired := s.custodian.ExpireApprovalRequests(ctx, now); expired > 0 {
+		s.logger.WithField("expired", expired).Info("Expired pending approvals")
+	}
+	if mined := s.custodian.AdvanceChains(ctx, now); mined > 0 {
+		s.logger.WithField("blocks", mined).Debug("Mined simulated blocks")
+	}
+	if payments := s.custodian.ProcessFiatRails(ctx, now); payments > 0 {
+		s.logger.WithField("payments", payments).Info("Processed fiat rail payments")
+	}
+	if changed := s.custodian.ProcessWalletTiers(ctx, now); changed > 0 {
+		s.logger.WithField("changed", changed).Info("Processed wallet tiers")
+	}
+
+	today := now.UTC().Truncate(24 * time.Hour)
+	if s.reportedDay.IsZero() {
+		s.reportedDay = today
+	}
+	for s.reportedDay.Before(today) {
+		s.logFailsReport(ctx, s.reportedDay)
+		s.reportedDay = s.reportedDay.AddDate(0, 0, 1)
+	}
+}
+
+func (s *SettlementScheduler) logFailsReport(ctx context.Context, day time.Time) {
+	report := s.custodian.FailsReport(ctx, day)
+	s.logger.WithFields(logrus.Fields{
+		"date":               day.Format(time.DateOnly),
+		"fails":              len(report.Fails),
+		"count_by_reason":    report.CountByReason,
+		"unsettled_by_asset": report.UnsettledByAsset,
+	}).Info("Daily settlement fails report")
+}
+
+func (s *SettlementScheduler) Stop() {
+	s.once.Do(func() {
+		s.stopped.Store(true)
+		close(s.stop)
+		s.wg.Wait()
+	})
+}
@@ -0,0 +1,267 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Settlement networks. A chain is named after its native asset, as simulated chains
+// and withdrawals name it; fiat moves on the payment rail it names.
+const (
+	NetworkInternal = "internal" // A book transfer between custody accounts; every asset supports it
+	NetworkBitcoin  = "BTC"
+	NetworkEthereum = "ETH"
+	NetworkTron     = "TRX"
+	NetworkFedwire  = "fedwire"
+	NetworkACH      = "ach"
+)
+
+// assetNetworks lists the settlement networks accepted for well-known assets
+// Assets not listed accept any network
+var assetNetworks = map[string][]string{
+	"BTC":  {NetworkInternal, NetworkBitcoin},
+	"ETH":  {NetworkInternal, NetworkEthereum},
+	"USDT": {NetworkInternal, NetworkEthereum, NetworkTron},
+	"USD":  {NetworkInternal, NetworkFedwire, NetworkACH},
+}
+
+// StandingSettlementInstruction says where and how a counterparty settles one asset.
+// Changes create a new version; settlements keep the version they were created with.
+type StandingSettlementInstruction struct {
+	Counterparty  string     `json:"counterparty"`
+	AssetID       string     `json:"asset_id"`
+	AccountID     string     `json:"account_id"`
+	Network       string     `json:"network"`
+	CycleDays     int        `json:"cycle_days"`        // T+N
+	CutOff        string     `json:"cut_off,omitempty"` // "HH:MM" UTC; later submissions count from the next business day
+	Version       int        `json:"version"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	SupersededAt  *time.Time `json:"superseded_at,omitempty"`
+}
+
+// PutStandingSettlementInstruction validates and stores a new version of the SSI for
+// its counterparty and asset. A non-zero expectedVersion must match the current
+// version, so concurrent editors cannot silently overwrite each other.
+func (s *CustodianService) PutStandingSettlementInstruction(ctx context.Context, ssi StandingSettlementInstruction, expectedVersion int) (*StandingSettlementInstruction, error) {
+	if ssi.Counterparty == "" || ssi.AssetID == "" || ssi.AccountID == "" {
+		return nil, fmt.Errorf("%w: counterparty, asset_id and account_id are required", ErrInvalidRequest)
+	}
+	if ssi.Network == "" {
+		ssi.Network = NetworkInternal
+	}
+	if err := validateNetwork(ssi.AssetID, ssi.Network); err != nil {
+		return nil, err
+	}
+	if ssi.CycleDays < 0 {
+		return nil, fmt.Errorf("%w: cycle_days must not be negative", ErrInvalidRequest)
+	}
+	if ssi.CutOff != "" {
+		if _, err := parseCutOff(ssi.CutOff); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, exists := s.accounts[ssi.AccountID]
+	if !exists {
+		return nil, fmt.Errorf("account %s %w", ssi.AccountID, ErrNotFound)
+	}
+	if account.Status != AccountStatusActive {
+		return nil, fmt.Errorf("%w: account %s is %s", ErrAccountInactive, ssi.AccountID, account.Status)
+	}
+
+	key := ssiKey(ssi.Counterparty, ssi.AssetID)
+	versions := s.ssis[key]
+	currentVersion := 0
+	if len(versions) > 0 {
+		currentVersion = versions[len(versions)-1].Version
+	}
+	if expectedVersion != 0 && expectedVersion != currentVersion {
+		return nil, fmt.Errorf("%w: SSI for %s/%s is at version %d, not %d",
+			ErrVersionConflict, ssi.Counterparty, ssi.AssetID, currentVersion, expectedVersion)
+	}
+
+	now := time.Now()
+	if len(versions) > 0 {
+		versions[len(versions)-1].SupersededAt = &now
+	}
+	ssi.Version = currentVersion + 1
+	ssi.EffectiveFrom = now
+	ssi.SupersededAt = nil
+	stored := ssi
+	s.ssis[key] = append(versions, &stored)
+
+	s.recordAudit(ctx, ports.AuditEvent{
+		Action:       "ssi.put",
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "ssi",
+		ResourceID:   key,
+		Details: map[string]string{
+			"account_id": ssi.AccountID,
+			"network":    ssi.Network,
+			"cycle_days": strconv.Itoa(ssi.CycleDays),
+			"cut_off":    ssi.CutOff,
+			"version":    strconv.Itoa(ssi.Version),
+		},
+	})
+
+	result := stored
+	return &result, nil
+}
+
+// GetStandingSettlementInstruction returns the current SSI for a counterparty and asset
+func (s *CustodianService) GetStandingSettlementInstruction(ctx context.Context, counterparty, assetID string) (*StandingSettlementInstruction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ssi, err := s.currentSSILocked(counterparty, assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := *ssi
+	return &result, nil
+}
+
+// StandingSettlementInstructionHistory returns every version of an SSI, oldest first
+func (s *CustodianService) StandingSettlementInstructionHistory(ctx context.Context, counterparty, assetID string) ([]StandingSettlementInstruction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	versions := s.ssis[ssiKey(counterparty, assetID)]
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("SSI for %s/%s %w", counterparty, assetID, ErrNotFound)
+	}
+
+	history := make([]StandingSettlementInstruction, 0, len(versions))
+	for _, ssi := range versions {
+		history = append(history, *ssi)
+	}
+	return history, nil
+}
+
+// ListStandingSettlementInstructions returns the current SSIs, for one counterparty
+// unless counterparty is empty, sorted by counterparty and asset
+func (s *CustodianService) ListStandingSettlementInstructions(ctx context.Context, counterparty string) []StandingSettlementInstruction {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var list []StandingSettlementInstruction
+	for _, versions := range s.ssis {
+		current := versions[len(versions)-1]
+		if counterparty == "" || current.Counterparty == counterparty {
+			list = append(list, *current)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Counterparty != list[j].Counterparty {
+			return list[i].Counterparty < list[j].Counterparty
+		}
+		return list[i].AssetID < list[j].AssetID
+	})
+	return list
+}
+
+// SubmitCounterpartySettlement creates a settlement instruction between two
+// counterparties, resolving accounts, cycle and cut-off from their current SSIs
+// for the asset. The longer cycle and the earliest of the two SSI cut-offs and the
+// asset's calendar cut-off apply, and the cycle counts business days. requestedBy is
+// the maker for the approval policy.
+func (s *CustodianService) SubmitCounterpartySettlement(ctx context.Context, fromCounterparty, toCounterparty, assetID string, amount float64, requestedBy string) (*Settlement, error) {
+	s.mu.RLock()
+	from, fromErr := s.currentSSILocked(fromCounterparty, assetID)
+	to, toErr := s.currentSSILocked(toCounterparty, assetID)
+	var fromSSI, toSSI StandingSettlementInstruction
+	if fromErr == nil && toErr == nil {
+		fromSSI, toSSI = *from, *to
+	}
+	s.mu.RUnlock()
+
+	if fromErr != nil {
+		return nil, fromErr
+	}
+	if toErr != nil {
+		return nil, toErr
+	}
+	return s.submitWithSSIs(ctx, &fromSSI, &toSSI, amount, requestedBy)
+}
+
+func (s *CustodianService) submitWithSSIs(ctx context.Context, from, to *StandingSettlementInstruction, amount float64, requestedBy string) (*Settlement, error) {
+	cycle := from.CycleDays
+	if to.CycleDays > cycle {
+		cycle = to.CycleDays
+	}
+	cutOff := earliestCutOff(from.CutOff, to.CutOff)
+
+	s.mu.RLock()
+	settlementDate := s.calendars.SettlementDate(time.Now(), cycle, cutOff, from.AssetID)
+	s.mu.RUnlock()
+
+	return s.SubmitSettlementInstruction(ctx, Settlement{
+		FromAccount:      from.AccountID,
+		ToAccount:        to.AccountID,
+		AssetID:          from.AssetID,
+		Amount:           amount,
+		SettlementDate:   settlementDate,
+		FromCounterparty: from.Counterparty,
+		ToCounterparty:   to.Counterparty,
+		FromSSIVersion:   from.Version,
+		ToSSIVersion:     to.Version,
+		InitiatedBy:      requestedBy,
+	})
+}
+
+func (s *CustodianService) currentSSILocked(counterparty, assetID string) (*StandingSettlementInstruction, error) {
+	versions := s.ssis[ssiKey(counterparty, assetID)]
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("SSI for %s/%s %w", counterparty, assetID, ErrNotFound)
+	}
+	return versions[len(versions)-1], nil
+}
+
+func earliestCutOff(cutOffs ...string) time.Duration {
+	var earliest time.Duration
+	for _, c := range cutOffs {
+		if c == "" {
+			continue
+		}
+		d, _ := parseCutOff(c)
+		if earliest == 0 || d < earliest {
+			earliest = d
+		}
+	}
+	return earliest
+}
+
+// parseCutOff parses "HH:MM" into an offset from midnight
+func parseCutOff(cutOff string) (time.Duration, error) {
+	t, err := time.Parse("15:04", cutOff)
+	if err != nil || t.Hour() == 0 && t.Minute() == 0 {
+		return 0, fmt.Errorf("%w: cut_off %q must be HH:MM after 00:00", ErrInvalidRequest, cutOff)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func validateNetwork(assetID, network string) error {
+	networks, known := assetNetworks[assetID]
+	if !known {
+		return nil
+	}
+	for _, n := range networks {
+		if n == network {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s cannot settle over %s (supported: %v)", ErrInvalidRequest, assetID, network, networks)
+}
+
+func ssiKey(counterparty, assetID string) string {
+	return counterparty + "/" + assetID
+}
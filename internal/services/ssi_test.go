@@ -0,0 +1,137 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestStandingSettlementInstructions verifies SSI versioning and counterparty settlement
+// Following BDD Given/When/Then pattern
+func TestStandingSettlementInstructions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("changes_create_new_versions", func(t *testing.T) {
+		// Given: A counterparty with a BTC SSI
+		svc := newTestService(t)
+		first, _ := svc.CreateAccount(ctx, "CUSTODY")
+		second, _ := svc.CreateAccount(ctx, "CUSTODY")
+		v1, err := svc.PutStandingSettlementInstruction(ctx, services.StandingSettlementInstruction{
+			Counterparty: "BANK_A", AssetID: "BTC", AccountID: first.ID, Network: services.NetworkBitcoin,
+		}, 0)
+		if err != nil {
+			t.Fatalf("PutStandingSettlementInstruction failed: %v", err)
+		}
+
+		// When: The SSI is moved to another account
+		v2, err := svc.PutStandingSettlementInstruction(ctx, services.StandingSettlementInstruction{
+			Counterparty: "BANK_A", AssetID: "BTC", AccountID: second.ID, Network: services.NetworkBitcoin,
+		}, v1.Version)
+		if err != nil {
+			t.Fatalf("PutStandingSettlementInstruction failed: %v", err)
+		}
+
+		// Then: The new version is current and the old one is kept as superseded
+		current, _ := svc.GetStandingSettlementInstruction(ctx, "BANK_A", "BTC")
+		if v2.Version != 2 || current.AccountID != second.ID {
+			t.Errorf("Expected version 2 on %s, got %+v", second.ID, current)
+		}
+		history, _ := svc.StandingSettlementInstructionHistory(ctx, "BANK_A", "BTC")
+		if len(history) != 2 || history[0].AccountID != first.ID || history[0].SupersededAt == nil {
+			t.Errorf("Expected superseded version 1 in history, got %+v", history)
+		}
+	})
+
+	t.Run("rejects_stale_expected_version", func(t *testing.T) {
+		// Given: An SSI already at version 2
+		svc := newTestService(t)
+		account, _ := svc.CreateAccount(ctx, "CUSTODY")
+		ssi := services.StandingSettlementInstruction{Counterparty: "BANK_A", AssetID: "ETH", AccountID: account.ID}
+		_, _ = svc.PutStandingSettlementInstruction(ctx, ssi, 0)
+		_, _ = svc.PutStandingSettlementInstruction(ctx, ssi, 1)
+
+		// When: An editor still holding version 1 saves
+		_, err := svc.PutStandingSettlementInstruction(ctx, ssi, 1)
+
+		// Then: The update is rejected as a conflict
+		if !errors.Is(err, services.ErrVersionConflict) {
+			t.Errorf("Expected ErrVersionConflict, got %v", err)
+		}
+	})
+
+	t.Run("validates_network_cut_off_and_account", func(t *testing.T) {
+		// Given: An active account
+		svc := newTestService(t)
+		account, _ := svc.CreateAccount(ctx, "CUSTODY")
+
+		cases := map[string]services.StandingSettlementInstruction{
+			"unsupported network": {Counterparty: "BANK_A", AssetID: "BTC", AccountID: account.ID, Network: services.NetworkEthereum},
+			"bad cut-off":         {Counterparty: "BANK_A", AssetID: "BTC", AccountID: account.ID, CutOff: "25:00"},
+			"unknown account":     {Counterparty: "BANK_A", AssetID: "BTC", AccountID: "ACC_missing"},
+		}
+		for name, ssi := range cases {
+			// When: An invalid SSI is submitted
+			_, err := svc.PutStandingSettlementInstruction(ctx, ssi, 0)
+
+			// Then: It is rejected
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+		}
+		if list := svc.ListStandingSettlementInstructions(ctx, ""); len(list) != 0 {
+			t.Errorf("Expected no SSIs stored, got %+v", list)
+		}
+	})
+
+	t.Run("counterparty_settlement_uses_current_ssis", func(t *testing.T) {
+		// Given: Two counterparties with USD SSIs, one on a T+1 cycle
+		svc := newTestService(t)
+		payer, _ := svc.CreateAccount(ctx, "CUSTODY")
+		payee, _ := svc.CreateAccount(ctx, "CUSTODY")
+		_, _ = svc.Deposit(ctx, payer.ID, "USD", 1000)
+		_, _ = svc.PutStandingSettlementInstruction(ctx, services.StandingSettlementInstruction{
+			Counterparty: "FUND_X", AssetID: "USD", AccountID: payer.ID, Network: services.NetworkFedwire,
+		}, 0)
+		_, _ = svc.PutStandingSettlementInstruction(ctx, services.StandingSettlementInstruction{
+			Counterparty: "BROKER_Y", AssetID: "USD", AccountID: payee.ID, Network: services.NetworkFedwire, CycleDays: 1,
+		}, 0)
+
+		// When: A settlement is submitted by counterparty
+		submittedAt := time.Now()
+		settlement, err := svc.SubmitCounterpartySettlement(ctx, "FUND_X", "BROKER_Y", "USD", 250, "alice")
+		if err != nil {
+			t.Fatalf("SubmitCounterpartySettlement failed: %v", err)
+		}
+
+		// Then: Accounts come from the SSIs and the longer cycle applies
+		if settlement.FromAccount != payer.ID || settlement.ToAccount != payee.ID {
+			t.Errorf("Expected %s -> %s, got %s -> %s", payer.ID, payee.ID, settlement.FromAccount, settlement.ToAccount)
+		}
+		if settlement.Status != services.SettlementStatusPending || !settlement.SettlementDate.After(submittedAt) {
+			t.Errorf("Expected a pending T+1 settlement, got %s at %v", settlement.Status, settlement.SettlementDate)
+		}
+
+		// And: The SSI versions used are recorded
+		if settlement.FromSSIVersion != 1 || settlement.ToSSIVersion != 1 {
+			t.Errorf("Expected SSI versions 1/1, got %d/%d", settlement.FromSSIVersion, settlement.ToSSIVersion)
+		}
+	})
+
+	t.Run("counterparty_settlement_requires_ssis", func(t *testing.T) {
+		// Given: No SSIs
+		svc := newTestService(t)
+
+		// When: A settlement is submitted by counterparty
+		_, err := svc.SubmitCounterpartySettlement(ctx, "FUND_X", "BROKER_Y", "USD", 250, "alice")
+
+		// Then: It fails as not found
+		if !errors.Is(err, services.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
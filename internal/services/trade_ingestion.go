@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// tradeIngestionMaker is recorded as the maker of settlements created from trades,
+// for the approval policy
+const tradeIngestionMaker = "trade-ingestion"
+
+// TradeSettlementIngestor implements ports.TradeEventHandler. Each trade becomes a
+// delivery-versus-payment pair of settlement instructions: the seller delivers the
+// base asset to the buyer and the buyer pays the quote asset to the seller. Both
+// legs share one settlement date, the longer of the two assets' settlement cycles
+// in business days on which both assets settle, counted from the trade date.
+type TradeSettlementIngestor struct {
+	custodian *CustodianService
+	accounts  map[string]string // Exchange account -> custodian account
+	cycles    map[string]int    // Asset -> settlement cycle in days
+	logger    *logrus.Logger
+
+	// Recently handled trade IDs, oldest first, bounded by dedupeWindow
+	mu           sync.Mutex
+	seen         map[string]bool
+	seenOrder    []string
+	dedupeWindow int
+}
+
+func NewTradeSettlementIngestor(custodian *CustodianService, cfg *config.Config, logger *logrus.Logger) (*TradeSettlementIngestor, error) {
+	accounts, err := parsePairs(cfg.TradeAccountMap)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trade account map: %w", err)
+	}
+	cycles, err := ParseSettlementCycles(cfg.TradeSettlementCycles)
+	if err != nil {
+		return nil, err
+	}
+
+	window := cfg.TradeDedupeWindow
+	if window <= 0 {
+		window = 100000
+	}
+
+	return &TradeSettlementIngestor{
+		custodian:    custodian,
+		accounts:     accounts,
+		cycles:       cycles,
+		logger:       logger,
+		seen:         make(map[string]bool),
+		dedupeWindow: window,
+	}, nil
+}
+
+// HandleTradeEvent creates the settlement instructions for a trade, returning an
+// error wrapping ports.ErrDuplicateTrade if the trade was already handled
+func (i *TradeSettlementIngestor) HandleTradeEvent(ctx context.Context, trade ports.TradeEvent) error {
+	base, quote, err := splitSymbol(trade.Symbol)
+	if err != nil {
+		return err
+	}
+	if trade.TradeID == "" || trade.BuyerAccountID == "" || trade.SellerAccountID == "" {
+		return fmt.Errorf("%w: trade_id, buyer_account_id and seller_account_id are required", ErrInvalidRequest)
+	}
+	if trade.Quantity <= 0 || trade.Price <= 0 {
+		return fmt.Errorf("%w: trade %s has non-positive quantity or price", ErrInvalidRequest, trade.TradeID)
+	}
+
+	buyer, seller := i.account(trade.BuyerAccountID), i.account(trade.SellerAccountID)
+	if buyer == seller {
+		return fmt.Errorf("%w: trade %s has the same buyer and seller account", ErrInvalidRequest, trade.TradeID)
+	}
+
+	if !i.markSeen(trade.TradeID) {
+		return fmt.Errorf("trade %s: %w", trade.TradeID, ports.ErrDuplicateTrade)
+	}
+
+	executedAt := trade.ExecutedAt
+	if executedAt.IsZero() {
+		executedAt = time.Now()
+	}
+	settlementDate := i.custodian.SettlementDate(executedAt, i.cycle(base, quote), base, quote)
+
+	legs := []Settlement{
+		{
+			ID:             tradeSettlementID(trade.TradeID, "DLV"),
+			FromAccount:    seller,
+			ToAccount:      buyer,
+			AssetID:        base,
+			Amount:         trade.Quantity,
+			SettlementDate: settlementDate,
+			TradeID:        trade.TradeID,
+			InitiatedBy:    tradeIngestionMaker,
+		},
+		{
+			ID:             tradeSettlementID(trade.TradeID, "PAY"),
+			FromAccount:    buyer,
+			ToAccount:      seller,
+			AssetID:        quote,
+			Amount:         trade.Quantity * trade.Price,
+			SettlementDate: settlementDate,
+			TradeID:        trade.TradeID,
+			InitiatedBy:    tradeIngestionMaker,
+		},
+	}
+
+	for _, leg := range legs {
+		settlement, err := i.custodian.SubmitSettlementInstruction(ctx, leg)
+		if errors.Is(err, ErrAlreadyExists) {
+			// Handled before the dedupe window forgot it
+			return fmt.Errorf("trade %s: %w", trade.TradeID, ports.ErrDuplicateTrade)
+		}
+		if err != nil {
+			return err
+		}
+
+		i.logger.WithFields(logrus.Fields{
+			"trade_id":        trade.TradeID,
+			"settlement_id":   settlement.ID,
+			"asset_id":        settlement.AssetID,
+			"amount":          settlement.Amount,
+			"settlement_date": settlement.SettlementDate,
+			"status":          settlement.Status,
+		}).Info("Settlement instruction created from trade")
+	}
+
+	return nil
+}
+
+// markSeen records a trade ID and reports whether it was new
+func (i *TradeSettlementIngestor) markSeen(tradeID string) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.seen[tradeID] {
+		return false
+	}
+
+	i.seen[tradeID] = true
+	i.seenOrder = append(i.seenOrder, tradeID)
+	if len(i.seenOrder) > i.dedupeWindow {
+		delete(i.seen, i.seenOrder[0])
+		i.seenOrder = i.seenOrder[1:]
+	}
+	return true
+}
+
+func (i *TradeSettlementIngestor) account(exchangeAccountID string) string {
+	if mapped, ok := i.accounts[exchangeAccountID]; ok {
+		return mapped
+	}
+	return exchangeAccountID
+}
+
+func (i *TradeSettlementIngestor) cycle(assets ...string) int {
+	days := i.cycles["default"]
+	for _, asset := range assets {
+		if d, ok := i.cycles[asset]; ok && d > days {
+			days = d
+		}
+	}
+	return days
+}
+
+// ParseSettlementCycles parses "ASSET=days;..." into settlement cycles; the
+// "default" entry applies to assets without their own and defaults to 0 (T+0)
+func ParseSettlementCycles(spec string) (map[string]int, error) {
+	pairs, err := parsePairs(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid settlement cycles: %w", err)
+	}
+
+	cycles := map[string]int{"default": 0}
+	for asset, value := range pairs {
+		days, err := strconv.Atoi(value)
+		if err != nil || days < 0 {
+			return nil, fmt.Errorf("invalid settlement cycle %q for %s", value, asset)
+		}
+		cycles[asset] = days
+	}
+	return cycles, nil
+}
+
+// parsePairs parses "key=value;key=value"
+func parsePairs(spec string) (map[string]string, error) {
+	pairs := make(map[string]string)
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(entry, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" || value == "" {
+			return nil, fmt.Errorf("invalid entry %q, expected key=value", entry)
+		}
+		pairs[key] = value
+	}
+	return pairs, nil
+}
+
+// splitSymbol splits "BASE/QUOTE" (or BASE-QUOTE, BASE_QUOTE) into its assets
+func splitSymbol(symbol string) (string, string, error) {
+	for _, sep := range []string{"/", "-", "_"} {
+		if base, quote, ok := strings.Cut(symbol, sep); ok && base != "" && quote != "" {
+			return strings.ToUpper(base), strings.ToUpper(quote), nil
+		}
+	}
+	return "", "", fmt.Errorf("%w: symbol %q is not BASE/QUOTE", ErrInvalidRequest, symbol)
+}
+
+func tradeSettlementID(tradeID, leg string) string {
+	return fmt.Sprintf("SETTLE_%s_%s", tradeID, leg)
+}
@@ -0,0 +1,145 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestTradeSettlementIngestor verifies exchange trades become settlement instructions
+// Following BDD Given/When/Then pattern
+func TestTradeSettlementIngestor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("settles_both_legs_of_a_t0_trade", func(t *testing.T) {
+		// Given: A buyer holding USD and a seller holding BTC, known to the exchange by other IDs
+		svc := newTestService(t)
+		buyer, _ := svc.CreateAccount(ctx, "TRADING")
+		seller, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.Deposit(ctx, buyer.ID, "USD", 100000)
+		_, _ = svc.Deposit(ctx, seller.ID, "BTC", 2)
+		ingestor := newTestIngestor(t, svc, &config.Config{
+			TradeAccountMap: "EX_BUYER=" + buyer.ID + ";EX_SELLER=" + seller.ID,
+		})
+
+		// When: A trade of 0.5 BTC at 60000 is ingested
+		err := ingestor.HandleTradeEvent(ctx, ports.TradeEvent{
+			TradeID:         "T1",
+			Symbol:          "BTC/USD",
+			Quantity:        0.5,
+			Price:           60000,
+			BuyerAccountID:  "EX_BUYER",
+			SellerAccountID: "EX_SELLER",
+			ExecutedAt:      time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("HandleTradeEvent failed: %v", err)
+		}
+
+		// Then: BTC moved to the buyer and USD to the seller
+		assertBalance(t, svc, buyer.ID, "BTC", 0.5)
+		assertBalance(t, svc, buyer.ID, "USD", 70000)
+		assertBalance(t, svc, seller.ID, "BTC", 1.5)
+		assertBalance(t, svc, seller.ID, "USD", 30000)
+
+		// And: Both instructions are recorded against the trade
+		delivery, err := svc.GetSettlement(ctx, "SETTLE_T1_DLV")
+		if err != nil || delivery.TradeID != "T1" || delivery.Status != services.SettlementStatusCompleted {
+			t.Errorf("Unexpected delivery leg %+v (%v)", delivery, err)
+		}
+	})
+
+	t.Run("ignores_redelivered_trades", func(t *testing.T) {
+		// Given: A trade that has already been ingested
+		svc := newTestService(t)
+		buyer, _ := svc.CreateAccount(ctx, "TRADING")
+		seller, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.Deposit(ctx, buyer.ID, "USD", 1000)
+		_, _ = svc.Deposit(ctx, seller.ID, "ETH", 10)
+		ingestor := newTestIngestor(t, svc, &config.Config{})
+		trade := ports.TradeEvent{TradeID: "T2", Symbol: "ETH-USD", Quantity: 1, Price: 100, BuyerAccountID: buyer.ID, SellerAccountID: seller.ID}
+		if err := ingestor.HandleTradeEvent(ctx, trade); err != nil {
+			t.Fatalf("HandleTradeEvent failed: %v", err)
+		}
+
+		// When: The same trade arrives again
+		err := ingestor.HandleTradeEvent(ctx, trade)
+
+		// Then: It is reported as a duplicate and settled only once
+		if !errors.Is(err, ports.ErrDuplicateTrade) {
+			t.Errorf("Expected ErrDuplicateTrade, got %v", err)
+		}
+		assertBalance(t, svc, buyer.ID, "ETH", 1)
+	})
+
+	t.Run("schedules_trades_on_the_longer_settlement_cycle", func(t *testing.T) {
+		// Given: USD settling T+1 and crypto T+0
+		svc := newTestService(t)
+		buyer, _ := svc.CreateAccount(ctx, "TRADING")
+		seller, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.Deposit(ctx, buyer.ID, "USD", 1000)
+		_, _ = svc.Deposit(ctx, seller.ID, "BTC", 1)
+		ingestor := newTestIngestor(t, svc, &config.Config{TradeSettlementCycles: "default=0;USD=1"})
+		executedAt := time.Now()
+
+		// When: A BTC/USD trade is ingested
+		err := ingestor.HandleTradeEvent(ctx, ports.TradeEvent{
+			TradeID: "T3", Symbol: "BTC/USD", Quantity: 0.01, Price: 50000,
+			BuyerAccountID: buyer.ID, SellerAccountID: seller.ID, ExecutedAt: executedAt,
+		})
+		if err != nil {
+			t.Fatalf("HandleTradeEvent failed: %v", err)
+		}
+
+		// Then: Both legs wait for T+1
+		for _, id := range []string{"SETTLE_T3_DLV", "SETTLE_T3_PAY"} {
+			settlement, _ := svc.GetSettlement(ctx, id)
+			if settlement.Status != services.SettlementStatusPending || !settlement.SettlementDate.Equal(executedAt.AddDate(0, 0, 1)) {
+				t.Errorf("Expected %s pending until T+1, got %s at %v", id, settlement.Status, settlement.SettlementDate)
+			}
+		}
+		assertBalance(t, svc, buyer.ID, "BTC", 0)
+
+		// And: They settle once due
+		if processed := svc.ProcessDueSettlements(ctx, executedAt.AddDate(0, 0, 1)); processed != 2 {
+			t.Errorf("Expected 2 settlements processed, got %d", processed)
+		}
+		assertBalance(t, svc, buyer.ID, "BTC", 0.01)
+		assertBalance(t, svc, seller.ID, "USD", 500)
+	})
+
+	t.Run("rejects_unparseable_symbols", func(t *testing.T) {
+		// Given: An ingestor
+		ingestor := newTestIngestor(t, newTestService(t), &config.Config{})
+
+		// When: A trade with a symbol lacking a quote asset arrives
+		err := ingestor.HandleTradeEvent(ctx, ports.TradeEvent{TradeID: "T4", Symbol: "BTC", Quantity: 1, Price: 1, BuyerAccountID: "A", SellerAccountID: "B"})
+
+		// Then: It is rejected as invalid
+		if !errors.Is(err, services.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest, got %v", err)
+		}
+	})
+}
+
+func newTestIngestor(t *testing.T, svc *services.CustodianService, cfg *config.Config) *services.TradeSettlementIngestor {
+	t.Helper()
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	ingestor, err := services.NewTradeSettlementIngestor(svc, cfg, logger)
+	if err != nil {
+		t.Fatalf("NewTradeSettlementIngestor failed: %v", err)
+	}
+	return ingestor
+}
@@ -0,0 +1,454 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Wallet tiers, hottest first
+const (
+	WalletTierHot  = "hot"
+	WalletTierWarm = "warm"
+	WalletTierCold = "cold"
+)
+
+var walletTiers = []string{WalletTierHot, WalletTierWarm, WalletTierCold}
+
+// rebalanceDust is the smallest amount worth moving between tiers
+const rebalanceDust = 1e-9
+
+// Rebalance operation statuses and reasons
+const (
+	RebalanceInTransit = "in_transit"
+	RebalanceCompleted = "completed"
+	RebalanceCancelled = "cancelled" // Voided when the tiers were set up again
+
+	RebalanceReasonDrift = "drift"             // A tier drifted from its target
+	RebalanceReasonTopUp = "hot_wallet_top_up" // Withdrawals are waiting for the hot wallet
+)
+
+// WalletTierTargets are the fractions of an asset's holdings each tier should hold
+type WalletTierTargets struct {
+	Hot  float64 `json:"hot"`
+	Warm float64 `json:"warm"`
+	Cold float64 `json:"cold"`
+}
+
+// WalletTiering configures which assets are held across hot, warm and cold wallets.
+// Deposits land in the hot wallet and withdrawals are paid from it.
+type WalletTiering struct {
+	Targets       map[string]WalletTierTargets // By asset
+	TransferTimes map[string]time.Duration     // How long a move out of each tier takes
+	Tolerance     float64                      // Drift, as a fraction of holdings, that triggers a rebalance
+}
+
+// RebalanceOperation moves an asset between wallet tiers. Funds in transit are in
+// neither tier until the operation completes.
+type RebalanceOperation struct {
+	ID          string     `json:"id"`
+	AssetID     string     `json:"asset_id"`
+	FromTier    string     `json:"from_tier"`
+	ToTier      string     `json:"to_tier"`
+	Amount      float64    `json:"amount"`
+	Reason      string     `json:"reason"`
+	Status      string     `json:"status"`
+	StartedAt   time.Time  `json:"started_at"`
+	CompletesAt time.Time  `json:"completes_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// WalletTierStatus describes one asset's holdings across the tiers
+type WalletTierStatus struct {
+	AssetID             string               `json:"asset_id"`
+	Balances            map[string]float64   `json:"balances"`
+	Targets             WalletTierTargets    `json:"targets"`
+	InTransit           float64              `json:"in_transit"`
+	AwaitingWithdrawals int                  `json:"awaiting_withdrawals"`
+	Operations          []RebalanceOperation `json:"operations"` // In transit, oldest first
+}
+
+// NewWalletTiering builds the wallet tiers in cfg, or returns nil when tiering is
+// disabled
+func NewWalletTiering(cfg *config.Config) (*WalletTiering, error) {
+	if !cfg.WalletTieringEnabled {
+		return nil, nil
+	}
+
+	specs, err := parsePairs(cfg.WalletTierTargets)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wallet tier targets: %w", err)
+	}
+	targets := make(map[string]WalletTierTargets, len(specs))
+	for key, spec := range specs {
+		if targets[key], err = parseTierTargets(spec); err != nil {
+			return nil, fmt.Errorf("invalid wallet tier targets for %s: %w", key, err)
+		}
+	}
+
+	times, err := parsePairs(cfg.WalletTierTransferTimes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wallet tier transfer times: %w", err)
+	}
+	tiering := &WalletTiering{
+		Targets:       make(map[string]WalletTierTargets),
+		TransferTimes: make(map[string]time.Duration),
+		Tolerance:     cfg.WalletTierTolerance,
+	}
+	for _, tier := range walletTiers {
+		value, ok := times[tier]
+		if !ok {
+			continue
+		}
+		if tiering.TransferTimes[tier], err = time.ParseDuration(value); err != nil || tiering.TransferTimes[tier] < 0 {
+			return nil, fmt.Errorf("invalid transfer time %q for the %s tier", value, tier)
+		}
+	}
+
+	for _, asset := range strings.Split(cfg.WalletTierAssets, ",") {
+		if asset = strings.TrimSpace(asset); asset == "" {
+			continue
+		}
+		target, ok := targets[asset]
+		if !ok {
+			if target, ok = targets["default"]; !ok {
+				return nil, fmt.Errorf("no wallet tier targets for %s", asset)
+			}
+		}
+		tiering.Targets[asset] = target
+	}
+	return tiering, nil
+}
+
+// SetWalletTiering registers the wallet tiers; current holdings of each tiered asset
+// are split across the tiers at their targets, and rebalance operations still in
+// transit are cancelled, as their funds are part of the new split. nil removes
+// tiering and withdrawals are paid at once again, including those already waiting
+// for an asset's hot wallet that is no longer tiered.
+func (s *CustodianService) SetWalletTiering(tiering *WalletTiering) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, operation := range s.rebalances {
+		if operation.Status == RebalanceInTransit {
+			operation.Status = RebalanceCancelled
+			s.recordAudit(context.Background(), rebalanceAuditEvent(operation))
+		}
+	}
+
+	s.walletTiering = tiering
+	s.walletBalances = make(map[string]map[string]float64)
+	if tiering != nil {
+		s.splitWalletTiersLocked(tiering)
+	}
+
+	untiered := make(map[string]bool)
+	for _, withdrawal := range s.withdrawals {
+		if _, tiered := s.walletBalances[withdrawal.AssetID]; !tiered && withdrawal.Status == WithdrawalStatusAwaitingHot {
+			untiered[withdrawal.AssetID] = true
+		}
+	}
+	now := time.Now()
+	for asset := range untiered {
+		for _, withdrawal := range s.awaitingHotWalletLocked(asset) {
+			s.releaseWithdrawalLocked(context.Background(), withdrawal, now)
+		}
+	}
+}
+
+// splitWalletTiersLocked places current holdings of each tiered asset in the tiers
+// at their targets
+func (s *CustodianService) splitWalletTiersLocked(tiering *WalletTiering) {
+	for asset, targets := range tiering.Targets {
+		total := 0.0
+		for _, balances := range s.balances {
+			total += balances[asset]
+		}
+		s.walletBalances[asset] = map[string]float64{
+			WalletTierHot:  total * targets.Hot,
+			WalletTierWarm: total * targets.Warm,
+			WalletTierCold: total * targets.Cold,
+		}
+	}
+}
+
+// WalletTierStatus returns an asset's holdings across the tiers and the rebalance
+// operations in transit
+func (s *CustodianService) WalletTierStatus(ctx context.Context, assetID string) (*WalletTierStatus, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	balances, tiered := s.walletBalances[assetID]
+	if !tiered {
+		return nil, fmt.Errorf("wallet tiers for %s %w", assetID, ErrNotFound)
+	}
+
+	status := &WalletTierStatus{
+		AssetID:    assetID,
+		Balances:   make(map[string]float64, len(balances)),
+		Targets:    s.walletTiering.Targets[assetID],
+		Operations: make([]RebalanceOperation, 0),
+	}
+	for tier, balance := range balances {
+		status.Balances[tier] = balance
+	}
+	for _, operation := range s.rebalanceOperationsLocked(assetID) {
+		status.InTransit += operation.Amount
+		status.Operations = append(status.Operations, *operation)
+	}
+	status.AwaitingWithdrawals = len(s.awaitingHotWalletLocked(assetID))
+	return status, nil
+}
+
+// ProcessWalletTiers completes rebalance operations that have arrived, pays
+// withdrawals waiting for the hot wallet strictly in order, and starts operations
+// for assets with none in transit whose tiers drifted or whose withdrawals are
+// waiting. It returns how many operations and withdrawals changed status.
+func (s *CustodianService) ProcessWalletTiers(ctx context.Context, now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.walletTiering == nil {
+		return 0
+	}
+
+	assets := make([]string, 0, len(s.walletBalances))
+	for asset := range s.walletBalances {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+
+	changed := 0
+	for _, asset := range assets {
+		for _, operation := range s.rebalanceOperationsLocked(asset) {
+			if operation.CompletesAt.After(now) {
+				continue
+			}
+			s.walletBalances[asset][operation.ToTier] += operation.Amount
+			operation.Status = RebalanceCompleted
+			operation.CompletedAt = &now
+			s.recordAudit(ctx, rebalanceAuditEvent(operation))
+			changed++
+		}
+
+		// Strictly first in, first out: a later, smaller withdrawal never jumps one
+		// the hot wallet cannot cover yet, so large withdrawals are not starved
+		for _, withdrawal := range s.awaitingHotWalletLocked(asset) {
+			if !s.drawHotWalletLocked(asset, withdrawal.Amount+withdrawal.Fee) {
+				break
+			}
+			s.releaseWithdrawalLocked(ctx, withdrawal, now)
+			changed++
+		}
+
+		if len(s.rebalanceOperationsLocked(asset)) == 0 {
+			changed += s.rebalanceLocked(ctx, asset, now)
+		}
+	}
+	return changed
+}
+
+// releaseWithdrawalLocked sends a withdrawal that was waiting for the hot wallet
+func (s *CustodianService) releaseWithdrawalLocked(ctx context.Context, withdrawal *Withdrawal, now time.Time) {
+	s.sendWithdrawalLocked(withdrawal, now)
+	event := withdrawalAuditEvent(withdrawal)
+	event.Action = "withdrawal.release"
+	event.Details["status"] = withdrawal.Status
+	s.recordAudit(ctx, event)
+}
+
+// rebalanceLocked starts the moves that bring an asset's tiers to their targets,
+// raising the hot target by what waiting withdrawals need. Moves into a tier are
+// taken from the fastest tier with a surplus first.
+func (s *CustodianService) rebalanceLocked(ctx context.Context, assetID string, now time.Time) int {
+	balances := s.walletBalances[assetID]
+	targets := s.walletTiering.Targets[assetID]
+	total := balances[WalletTierHot] + balances[WalletTierWarm] + balances[WalletTierCold]
+
+	needed := 0.0
+	for _, withdrawal := range s.awaitingHotWalletLocked(assetID) {
+		needed += withdrawal.Amount + withdrawal.Fee
+	}
+
+	want := map[string]float64{
+		WalletTierHot:  total * targets.Hot,
+		WalletTierWarm: total * targets.Warm,
+		WalletTierCold: total * targets.Cold,
+	}
+	reason := RebalanceReasonDrift
+	if needed > 0 {
+		reason = RebalanceReasonTopUp
+		want[WalletTierHot] = math.Min(total, want[WalletTierHot]+needed)
+		if rest := targets.Warm + targets.Cold; rest > 0 {
+			want[WalletTierWarm] = (total - want[WalletTierHot]) * targets.Warm / rest
+			want[WalletTierCold] = (total - want[WalletTierHot]) * targets.Cold / rest
+		}
+	} else {
+		drifted := false
+		for _, tier := range walletTiers {
+			if math.Abs(balances[tier]-want[tier]) > s.walletTiering.Tolerance*total {
+				drifted = true
+			}
+		}
+		if !drifted {
+			return 0
+		}
+	}
+
+	surplus := make(map[string]float64)
+	for _, tier := range walletTiers {
+		surplus[tier] = balances[tier] - want[tier]
+	}
+
+	started := 0
+	for _, to := range walletTiers {
+		for _, from := range walletTiers {
+			if from == to || surplus[to] >= -rebalanceDust || surplus[from] <= rebalanceDust {
+				continue
+			}
+			amount := math.Min(surplus[from], -surplus[to])
+			surplus[from] -= amount
+			surplus[to] += amount
+			balances[from] -= amount
+
+			operation := &RebalanceOperation{
+				ID:          generateRebalanceID(),
+				AssetID:     assetID,
+				FromTier:    from,
+				ToTier:      to,
+				Amount:      amount,
+				Reason:      reason,
+				Status:      RebalanceInTransit,
+				StartedAt:   now,
+				CompletesAt: now.Add(s.walletTiering.TransferTimes[from]),
+			}
+			// Moves started together can share a timestamp
+			for s.rebalances[operation.ID] != nil {
+				operation.ID = generateRebalanceID()
+			}
+			s.rebalances[operation.ID] = operation
+			s.recordAudit(ctx, rebalanceAuditEvent(operation))
+			started++
+
+			s.logger.WithFields(logrus.Fields{
+				"rebalance_id": operation.ID,
+				"asset_id":     assetID,
+				"from_tier":    from,
+				"to_tier":      to,
+				"amount":       amount,
+				"completes_at": operation.CompletesAt,
+			}).Info("Wallet rebalance started")
+		}
+	}
+	return started
+}
+
+// creditHotWalletLocked adds funds arriving in custody to the hot wallet
+func (s *CustodianService) creditHotWalletLocked(assetID string, amount float64) {
+	if balances, tiered := s.walletBalances[assetID]; tiered {
+		balances[WalletTierHot] += amount
+	}
+}
+
+// drawHotWalletLocked pays funds leaving custody from the hot wallet, reporting
+// false when it holds too little. Untiered assets are always paid.
+func (s *CustodianService) drawHotWalletLocked(assetID string, amount float64) bool {
+	balances, tiered := s.walletBalances[assetID]
+	if !tiered {
+		return true
+	}
+	if balances[WalletTierHot] < amount {
+		return false
+	}
+	balances[WalletTierHot] -= amount
+	return true
+}
+
+// awaitingHotWalletLocked returns the withdrawals of an asset waiting for the hot
+// wallet, oldest first
+func (s *CustodianService) awaitingHotWalletLocked(assetID string) []*Withdrawal {
+	var waiting []*Withdrawal
+	for _, withdrawal := range s.withdrawals {
+		if withdrawal.AssetID == assetID && withdrawal.Status == WithdrawalStatusAwaitingHot {
+			waiting = append(waiting, withdrawal)
+		}
+	}
+	sort.Slice(waiting, func(i, j int) bool {
+		if !waiting[i].CreatedAt.Equal(waiting[j].CreatedAt) {
+			return waiting[i].CreatedAt.Before(waiting[j].CreatedAt)
+		}
+		return waiting[i].ID < waiting[j].ID
+	})
+	return waiting
+}
+
+// rebalanceOperationsLocked returns an asset's operations in transit, oldest first
+func (s *CustodianService) rebalanceOperationsLocked(assetID string) []*RebalanceOperation {
+	var operations []*RebalanceOperation
+	for _, operation := range s.rebalances {
+		if operation.AssetID == assetID && operation.Status == RebalanceInTransit {
+			operations = append(operations, operation)
+		}
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		if !operations[i].StartedAt.Equal(operations[j].StartedAt) {
+			return operations[i].StartedAt.Before(operations[j].StartedAt)
+		}
+		return operations[i].ID < operations[j].ID
+	})
+	return operations
+}
+
+// parseTierTargets parses "hot,warm,cold" percentages summing to 100
+func parseTierTargets(spec string) (WalletTierTargets, error) {
+	parts := strings.Split(spec, ",")
+	if len(parts) != 3 {
+		return WalletTierTargets{}, fmt.Errorf("expected hot,warm,cold percentages, got %q", spec)
+	}
+
+	var percents [3]float64
+	sum := 0.0
+	for i, part := range parts {
+		percent, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || percent < 0 {
+			return WalletTierTargets{}, fmt.Errorf("invalid percentage %q", part)
+		}
+		percents[i] = percent
+		sum += percent
+	}
+	if math.Abs(sum-100) > 1e-9 {
+		return WalletTierTargets{}, fmt.Errorf("percentages %q add up to %v, not 100", spec, sum)
+	}
+	return WalletTierTargets{Hot: percents[0] / 100, Warm: percents[1] / 100, Cold: percents[2] / 100}, nil
+}
+
+func rebalanceAuditEvent(operation *RebalanceOperation) ports.AuditEvent {
+	return ports.AuditEvent{
+		Action:       "wallet.rebalance",
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "rebalance",
+		ResourceID:   operation.ID,
+		Details: map[string]string{
+			"asset_id":  operation.AssetID,
+			"from_tier": operation.FromTier,
+			"to_tier":   operation.ToTier,
+			"amount":    formatAmount(operation.Amount),
+			"reason":    operation.Reason,
+			"status":    operation.Status,
+		},
+	}
+}
+
+func generateRebalanceID() string {
+	// Simple ID generation for simulation
+	return fmt.Sprintf("REBAL_%d", nextIDNanos())
+}
@@ -0,0 +1,188 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/config"
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestWalletTiers verifies holdings spread across hot, warm and cold wallets over time
+// Following BDD Given/When/Then pattern
+func TestWalletTiers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deposits_land_hot_and_are_swept_to_their_targets", func(t *testing.T) {
+		// Given: BTC targeted at 10% hot, 20% warm and 70% cold
+		svc := newTestService(t, withWalletTiers())
+		account, _ := svc.CreateAccount(ctx, "TRADING")
+
+		// When: 100 BTC is deposited
+		_, _ = svc.Deposit(ctx, account.ID, "BTC", 100)
+
+		// Then: It all lands in the hot wallet
+		assertTiers(t, svc, "BTC", 100, 0, 0)
+
+		// And: Rebalancing moves the surplus out, arriving once the moves complete
+		now := time.Now()
+		if started := svc.ProcessWalletTiers(ctx, now); started != 2 {
+			t.Errorf("Expected 2 rebalance operations, got %d", started)
+		}
+		status, _ := svc.WalletTierStatus(ctx, "BTC")
+		if status.InTransit != 90 || len(status.Operations) != 2 {
+			t.Errorf("Expected 90 BTC in transit in 2 operations, got %+v", status)
+		}
+		svc.ProcessWalletTiers(ctx, now.Add(5*time.Minute))
+		assertTiers(t, svc, "BTC", 10, 20, 70)
+	})
+
+	t.Run("withdrawal_waits_while_the_hot_wallet_is_topped_up", func(t *testing.T) {
+		// Given: 100 BTC in custody at its targets
+		svc := newTestService(t, withWalletTiers())
+		from, _ := fundedPair(t, svc, "BTC", 100)
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "BTC", Address: "bc1qdest"})
+		now := time.Now()
+		svc.ProcessWalletTiers(ctx, now)
+		svc.ProcessWalletTiers(ctx, now.Add(5*time.Minute))
+
+		// When: 30 BTC is withdrawn with only 10 BTC hot
+		withdrawal, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "BTC", Amount: 30, Address: "bc1qdest"})
+		if err != nil {
+			t.Fatalf("Withdraw failed: %v", err)
+		}
+
+		// Then: The account is debited and the withdrawal waits for the hot wallet
+		if withdrawal.Status != services.WithdrawalStatusAwaitingHot {
+			t.Errorf("Expected awaiting_hot_wallet, got %s", withdrawal.Status)
+		}
+		assertBalance(t, svc, from, "BTC", 70)
+
+		// And: It still waits once the warm top-up arrives
+		start := now.Add(10 * time.Minute)
+		svc.ProcessWalletTiers(ctx, start)
+		svc.ProcessWalletTiers(ctx, start.Add(time.Hour))
+		waiting, _ := svc.GetWithdrawal(ctx, withdrawal.ID)
+		if waiting.Status != services.WithdrawalStatusAwaitingHot {
+			t.Errorf("Expected still awaiting_hot_wallet after the warm top-up, got %s", waiting.Status)
+		}
+
+		// And: It completes once the cold top-up arrives
+		svc.ProcessWalletTiers(ctx, start.Add(24*time.Hour))
+		completed, _ := svc.GetWithdrawal(ctx, withdrawal.ID)
+		if completed.Status != services.WithdrawalStatusCompleted {
+			t.Errorf("Expected completed, got %s", completed.Status)
+		}
+
+		// And: The tiers and transfers in transit still hold the 70 BTC in custody
+		status, _ := svc.WalletTierStatus(ctx, "BTC")
+		held := status.InTransit
+		for _, balance := range status.Balances {
+			held += balance
+		}
+		if math.Abs(held-70) > 1e-9 {
+			t.Errorf("Expected 70 BTC held across the tiers, got %v", held)
+		}
+	})
+
+	t.Run("waiting_withdrawals_are_paid_in_order", func(t *testing.T) {
+		// Given: 10 BTC hot and a 30 BTC withdrawal waiting for the hot wallet
+		svc := newTestService(t, withWalletTiers())
+		from, _ := fundedPair(t, svc, "BTC", 100)
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "BTC", Address: "bc1qdest"})
+		now := time.Now()
+		svc.ProcessWalletTiers(ctx, now)
+		svc.ProcessWalletTiers(ctx, now.Add(5*time.Minute))
+		large, _ := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "BTC", Amount: 30, Address: "bc1qdest"})
+
+		// When: A 5 BTC withdrawal the hot wallet could cover follows
+		small, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "BTC", Amount: 5, Address: "bc1qdest"})
+		if err != nil {
+			t.Fatalf("Withdraw failed: %v", err)
+		}
+
+		// Then: It waits behind the earlier one
+		if large.Status != services.WithdrawalStatusAwaitingHot || small.Status != services.WithdrawalStatusAwaitingHot {
+			t.Errorf("Expected both to await the hot wallet, got %s and %s", large.Status, small.Status)
+		}
+		assertTiers(t, svc, "BTC", 10, 20, 70)
+	})
+
+	t.Run("removing_tiering_releases_waiting_withdrawals", func(t *testing.T) {
+		// Given: A withdrawal waiting for the hot wallet
+		svc := newTestService(t, withWalletTiers())
+		from, _ := fundedPair(t, svc, "BTC", 100)
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "BTC", Address: "bc1qdest"})
+		now := time.Now()
+		svc.ProcessWalletTiers(ctx, now)
+		svc.ProcessWalletTiers(ctx, now.Add(5*time.Minute))
+		withdrawal, _ := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "BTC", Amount: 30, Address: "bc1qdest"})
+		if withdrawal.Status != services.WithdrawalStatusAwaitingHot {
+			t.Fatalf("Expected awaiting_hot_wallet, got %s", withdrawal.Status)
+		}
+
+		// When: Tiering is removed
+		svc.SetWalletTiering(nil)
+
+		// Then: The withdrawal is paid at once
+		released, _ := svc.GetWithdrawal(ctx, withdrawal.ID)
+		if released.Status != services.WithdrawalStatusCompleted {
+			t.Errorf("Expected completed, got %s", released.Status)
+		}
+	})
+
+	t.Run("setting_the_tiers_again_cancels_moves_in_transit", func(t *testing.T) {
+		// Given: 100 BTC deposited hot with 90 BTC on its way to the warm and cold tiers
+		svc := newTestService(t, withWalletTiers())
+		account, _ := svc.CreateAccount(ctx, "TRADING")
+		_, _ = svc.Deposit(ctx, account.ID, "BTC", 100)
+		now := time.Now()
+		svc.ProcessWalletTiers(ctx, now)
+
+		// When: The tiers are set up again
+		reconfigureTestService(t, svc, withWalletTiers())
+
+		// Then: Holdings are split afresh and nothing is left in transit
+		status, _ := svc.WalletTierStatus(ctx, "BTC")
+		if status.InTransit != 0 || len(status.Operations) != 0 {
+			t.Errorf("Expected nothing in transit, got %+v", status)
+		}
+		assertTiers(t, svc, "BTC", 10, 20, 70)
+
+		// And: The cancelled moves never arrive
+		svc.ProcessWalletTiers(ctx, now.Add(48*time.Hour))
+		assertTiers(t, svc, "BTC", 10, 20, 70)
+	})
+
+	t.Run("targets_must_add_up_to_100", func(t *testing.T) {
+		// Given: Targets adding up to 90%
+		cfg := &config.Config{WalletTieringEnabled: true, WalletTierAssets: "BTC", WalletTierTargets: "BTC=10,10,70"}
+
+		// When: The tiers are built
+		_, err := services.NewWalletTiering(cfg)
+
+		// Then: The configuration is refused
+		if err == nil {
+			t.Error("Expected an error for targets adding up to 90")
+		}
+	})
+}
+
+func assertTiers(t *testing.T, svc *services.CustodianService, assetID string, hot, warm, cold float64) {
+	t.Helper()
+
+	status, err := svc.WalletTierStatus(context.Background(), assetID)
+	if err != nil {
+		t.Fatalf("WalletTierStatus failed: %v", err)
+	}
+	want := map[string]float64{services.WalletTierHot: hot, services.WalletTierWarm: warm, services.WalletTierCold: cold}
+	for tier, amount := range want {
+		if got := status.Balances[tier]; math.Abs(got-amount) > 1e-9 {
+			t.Errorf("Expected %v %s in the %s tier, got %v", amount, assetID, tier, got)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Withdrawal address statuses
+const (
+	WithdrawalAddressStatusCoolingOff = "cooling_off"
+	WithdrawalAddressStatusActive     = "active"
+	WithdrawalAddressStatusRemoved    = "removed"
+)
+
+// WithdrawalAddress is a whitelisted destination in an account's address book.
+// Withdrawals may only go to an active address for the same asset and network;
+// new addresses become active once the cooling-off period has passed.
+type WithdrawalAddress struct {
+	ID         string     `json:"id"`
+	AccountID  string     `json:"account_id"`
+	AssetID    string     `json:"asset_id"`
+	Network    string     `json:"network"` // Defaults to the asset ID
+	Address    string     `json:"address"`
+	Label      string     `json:"label,omitempty"`
+	AddedBy    string     `json:"added_by,omitempty"`
+	Status     string     `json:"status"` // As of the call that returned it
+	AddedAt    time.Time  `json:"added_at"`
+	ActiveFrom time.Time  `json:"active_from"`
+	RemovedAt  *time.Time `json:"removed_at,omitempty"`
+}
+
+// AddWithdrawalAddress whitelists a destination for an account. It becomes usable
+// after the configured cooling-off period.
+func (s *CustodianService) AddWithdrawalAddress(ctx context.Context, address WithdrawalAddress) (*WithdrawalAddress, error) {
+	address.Address = strings.TrimSpace(address.Address)
+	if address.AccountID == "" || address.AssetID == "" || address.Address == "" {
+		return nil, fmt.Errorf("%w: account_id, asset_id and address are required", ErrInvalidRequest)
+	}
+	if address.Network == "" {
+		address.Network = address.AssetID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.accounts[address.AccountID]; !exists {
+		return nil, fmt.Errorf("account %s %w", address.AccountID, ErrNotFound)
+	}
+	for _, existing := range s.withdrawalAddresses[address.AccountID] {
+		if existing.RemovedAt == nil && existing.AssetID == address.AssetID &&
+			existing.Network == address.Network && existing.Address == address.Address {
+			return nil, fmt.Errorf("withdrawal address %s for %s on %s %w", address.Address, address.AssetID, address.Network, ErrAlreadyExists)
+		}
+	}
+
+	now := time.Now()
+	address.ID = generateWithdrawalAddressID()
+	address.AddedAt = now
+	address.ActiveFrom = now.Add(s.config.WithdrawalAddressCoolingOff)
+	address.RemovedAt = nil
+
+	stored := &address
+	s.withdrawalAddresses[address.AccountID] = append(s.withdrawalAddresses[address.AccountID], stored)
+	s.recordAudit(ctx, withdrawalAddressAuditEvent(stored, "withdrawal_address.add"))
+
+	s.logger.WithFields(logrus.Fields{
+		"address_id":  stored.ID,
+		"account_id":  stored.AccountID,
+		"asset_id":    stored.AssetID,
+		"network":     stored.Network,
+		"active_from": stored.ActiveFrom,
+	}).Info("Withdrawal address added")
+
+	return withdrawalAddressAt(stored, now), nil
+}
+
+// RemoveWithdrawalAddress takes a destination off an account's whitelist
+func (s *CustodianService) RemoveWithdrawalAddress(ctx context.Context, accountID, addressID string) (*WithdrawalAddress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var address *WithdrawalAddress
+	for _, candidate := range s.withdrawalAddresses[accountID] {
+		if candidate.ID == addressID {
+			address = candidate
+		}
+	}
+	if address == nil {
+		return nil, fmt.Errorf("withdrawal address %s %w", addressID, ErrNotFound)
+	}
+
+	now := time.Now()
+	if address.RemovedAt == nil {
+		address.RemovedAt = &now
+		s.recordAudit(ctx, withdrawalAddressAuditEvent(address, "withdrawal_address.remove"))
+	}
+
+	return withdrawalAddressAt(address, now), nil
+}
+
+// ListWithdrawalAddresses returns an account's address book, oldest first,
+// including removed addresses
+func (s *CustodianService) ListWithdrawalAddresses(ctx context.Context, accountID string) ([]WithdrawalAddress, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, exists := s.accounts[accountID]; !exists {
+		return nil, fmt.Errorf("account %s %w", accountID, ErrNotFound)
+	}
+
+	now := time.Now()
+	list := make([]WithdrawalAddress, 0, len(s.withdrawalAddresses[accountID]))
+	for _, address := range s.withdrawalAddresses[accountID] {
+		list = append(list, *withdrawalAddressAt(address, now))
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].AddedAt.Before(list[j].AddedAt)
+	})
+	return list, nil
+}
+
+// checkWithdrawalAddressLocked returns ErrAddressNotWhitelisted unless the withdrawal
+// goes to an address active at now for its account, asset and network
+func (s *CustodianService) checkWithdrawalAddressLocked(withdrawal *Withdrawal, now time.Time) error {
+	coolingOff := false
+	for _, address := range s.withdrawalAddresses[withdrawal.AccountID] {
+		if address.AssetID != withdrawal.AssetID || address.Network != withdrawal.Network || address.Address != withdrawal.Address {
+			continue
+		}
+		switch withdrawalAddressAt(address, now).Status {
+		case WithdrawalAddressStatusActive:
+			return nil
+		case WithdrawalAddressStatusCoolingOff:
+			coolingOff = true
+		}
+	}
+
+	if coolingOff {
+		return fmt.Errorf("%w: %s is still in its cooling-off period", ErrAddressNotWhitelisted, withdrawal.Address)
+	}
+	return fmt.Errorf("%w: %s is not in the address book of %s for %s on %s",
+		ErrAddressNotWhitelisted, withdrawal.Address, withdrawal.AccountID, withdrawal.AssetID, withdrawal.Network)
+}
+
+// withdrawalAddressAt returns a copy of address with its status as of now
+func withdrawalAddressAt(address *WithdrawalAddress, now time.Time) *WithdrawalAddress {
+	result := *address
+	switch {
+	case address.RemovedAt != nil:
+		result.Status = WithdrawalAddressStatusRemoved
+	case now.Before(address.ActiveFrom):
+		result.Status = WithdrawalAddressStatusCoolingOff
+	default:
+		result.Status = WithdrawalAddressStatusActive
+	}
+	return &result
+}
+
+func withdrawalAddressAuditEvent(address *WithdrawalAddress, action string) ports.AuditEvent {
+	return ports.AuditEvent{
+		Action:       action,
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "withdrawal_address",
+		ResourceID:   address.ID,
+		Details: map[string]string{
+			"account_id":  address.AccountID,
+			"asset_id":    address.AssetID,
+			"network":     address.Network,
+			"address":     address.Address,
+			"added_by":    address.AddedBy,
+			"active_from": address.ActiveFrom.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+func generateWithdrawalAddressID() string {
+	// Simple ID generation for simulation
+	return fmt.Sprintf("ADDR_%d", nextIDNanos())
+}
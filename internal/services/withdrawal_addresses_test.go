@@ -0,0 +1,127 @@
+//go:build unit
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/services"
+)
+
+// TestWithdrawalAddresses verifies withdrawals only go to active whitelisted addresses
+// Following BDD Given/When/Then pattern
+func TestWithdrawalAddresses(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rejects_addresses_outside_the_address_book", func(t *testing.T) {
+		// Given: An account with no whitelisted addresses
+		svc := newTestService(t)
+		from, _ := fundedPair(t, svc, "BTC", 5)
+
+		// When: A withdrawal is requested
+		_, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "BTC", Amount: 1, Address: "bc1qexample"})
+
+		// Then: It is rejected and the balance is unchanged
+		if !errors.Is(err, services.ErrAddressNotWhitelisted) {
+			t.Errorf("Expected ErrAddressNotWhitelisted, got %v", err)
+		}
+		assertBalance(t, svc, from, "BTC", 5)
+	})
+
+	t.Run("new_addresses_wait_for_the_cooling_off_period", func(t *testing.T) {
+		// Given: A one-hour cooling-off period and a newly added address
+		svc := newTestService(t, withCoolingOff(time.Hour))
+		from, _ := fundedPair(t, svc, "BTC", 5)
+		address, err := svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "BTC", Address: "bc1qexample", AddedBy: "ops"})
+		if err != nil {
+			t.Fatalf("AddWithdrawalAddress failed: %v", err)
+		}
+
+		// Then: It is cooling off until an hour from now
+		if address.Status != services.WithdrawalAddressStatusCoolingOff || address.Network != "BTC" {
+			t.Errorf("Expected a cooling_off BTC address, got %+v", address)
+		}
+
+		// When: A withdrawal to it is requested straight away
+		_, err = svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "BTC", Amount: 1, Address: "bc1qexample"})
+
+		// Then: It is rejected
+		if !errors.Is(err, services.ErrAddressNotWhitelisted) {
+			t.Errorf("Expected ErrAddressNotWhitelisted, got %v", err)
+		}
+	})
+
+	t.Run("withdraws_to_an_active_address_for_the_same_asset_and_network", func(t *testing.T) {
+		// Given: An active USDC address on ETH
+		svc := newTestService(t)
+		from, _ := fundedPair(t, svc, "USDC", 100)
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "USDC", Network: services.NetworkEthereum, Address: "0xabc"})
+
+		// When: A withdrawal names another network
+		_, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "USDC", Network: "SOL", Amount: 10, Address: "0xabc"})
+
+		// Then: It is rejected
+		if !errors.Is(err, services.ErrAddressNotWhitelisted) {
+			t.Errorf("Expected ErrAddressNotWhitelisted, got %v", err)
+		}
+
+		// When: The whitelisted network is used
+		withdrawal, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "USDC", Network: services.NetworkEthereum, Amount: 10, Address: "0xabc"})
+		if err != nil {
+			t.Fatalf("Withdraw failed: %v", err)
+		}
+
+		// Then: The funds leave the account
+		if withdrawal.Status != services.WithdrawalStatusCompleted {
+			t.Errorf("Expected completed, got %s", withdrawal.Status)
+		}
+		assertBalance(t, svc, from, "USDC", 90)
+	})
+
+	t.Run("withdrawals_short_of_funds_are_returned_failed", func(t *testing.T) {
+		// Given: An active address and 5 ETH
+		svc := newTestService(t)
+		from, _ := fundedPair(t, svc, "ETH", 5)
+		_, _ = svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "ETH", Address: "0xabc"})
+
+		// When: 10 ETH is withdrawn
+		withdrawal, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "ETH", Amount: 10, Address: "0xabc"})
+
+		// Then: The stored withdrawal is returned failed with its reason rather than as an error
+		if err != nil {
+			t.Fatalf("Expected a failed withdrawal, got %v", err)
+		}
+		if withdrawal.Status != services.WithdrawalStatusFailed || withdrawal.Reason == "" {
+			t.Errorf("Expected failed with a reason, got %s / %q", withdrawal.Status, withdrawal.Reason)
+		}
+		if stored, err := svc.GetWithdrawal(ctx, withdrawal.ID); err != nil || stored.Status != services.WithdrawalStatusFailed {
+			t.Errorf("Expected the failed withdrawal to be kept, got %+v / %v", stored, err)
+		}
+		assertBalance(t, svc, from, "ETH", 5)
+	})
+
+	t.Run("removed_addresses_can_no_longer_be_used", func(t *testing.T) {
+		// Given: An active address that is then removed
+		svc := newTestService(t)
+		from, _ := fundedPair(t, svc, "ETH", 5)
+		address, _ := svc.AddWithdrawalAddress(ctx, services.WithdrawalAddress{AccountID: from, AssetID: "ETH", Address: "0xdef"})
+		if _, err := svc.RemoveWithdrawalAddress(ctx, from, address.ID); err != nil {
+			t.Fatalf("RemoveWithdrawalAddress failed: %v", err)
+		}
+
+		// When: A withdrawal to it is requested
+		_, err := svc.Withdraw(ctx, services.Withdrawal{AccountID: from, AssetID: "ETH", Amount: 1, Address: "0xdef"})
+
+		// Then: It is rejected and the address book shows it removed
+		if !errors.Is(err, services.ErrAddressNotWhitelisted) {
+			t.Errorf("Expected ErrAddressNotWhitelisted, got %v", err)
+		}
+		addresses, _ := svc.ListWithdrawalAddresses(ctx, from)
+		if len(addresses) != 1 || addresses[0].Status != services.WithdrawalAddressStatusRemoved {
+			t.Errorf("Expected one removed address, got %+v", addresses)
+		}
+	})
+}
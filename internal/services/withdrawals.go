@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quantfidential/trading-ecosystem/custodian-simulator-go/internal/domain/ports"
+)
+
+// Withdrawal statuses
+const (
+	WithdrawalStatusPendingReview   = "pending_review"
+	WithdrawalStatusPendingApproval = "pending_approval"
+	WithdrawalStatusBroadcast       = "broadcast"           // On a simulated chain, awaiting confirmations
+	WithdrawalStatusProcessing      = "processing"          // On a fiat rail, awaiting its batch and settlement date
+	WithdrawalStatusReturned        = "returned"            // Returned by the receiving bank and credited back
+	WithdrawalStatusAwaitingHot     = "awaiting_hot_wallet" // Debited, waiting for the hot wallet to be topped up
+	WithdrawalStatusCompleted       = "completed"
+	WithdrawalStatusFailed          = "failed"
+	WithdrawalStatusRejected        = "rejected"
+	WithdrawalStatusExpired         = "expired"
+)
+
+// Withdrawal moves an asset out of custody to an external address
+type Withdrawal struct {
+	ID          string                `json:"id"`
+	AccountID   string                `json:"account_id"`
+	AssetID     string                `json:"asset_id"`
+	Amount      float64               `json:"amount"`
+	Network     string                `json:"network"` // Defaults to the asset ID
+	Address     string                `json:"address"`
+	RequestedBy string                `json:"requested_by,omitempty"`
+	Status      string                `json:"status"`
+	Reason      string                `json:"reason,omitempty"`      // Why the withdrawal failed or was not approved
+	ApprovalID  string                `json:"approval_id,omitempty"` // Set when the approval policy applied
+	ReviewID    string                `json:"review_id,omitempty"`   // Set when compliance screening held it
+	TravelRule  *ports.TravelRuleData `json:"travel_rule,omitempty"`
+	// Set when the network is simulated; see SetChainSimulation
+	TxHash        string  `json:"tx_hash,omitempty"`
+	Fee           float64 `json:"fee,omitempty"`
+	Confirmations int     `json:"confirmations,omitempty"`
+	// Set when the withdrawal moves on a fiat rail; see SetFiatRails
+	PaymentID   string     `json:"payment_id,omitempty"`
+	Reference   string     `json:"reference,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// Withdraw debits an account for a withdrawal to an active whitelisted address; see
+// AddWithdrawalAddress. Withdrawals the compliance screener holds wait in
+// pending_review, and those the approval policy qualifies in pending_approval; see
+// ReleaseComplianceReview and ApproveOperation. On a simulated network the account is
+// also charged the network fee and the withdrawal completes once its transaction
+// is final. Fiat assets on a simulated rail complete on the settlement date, or are
+// returned and credited back. Tiered assets wait in awaiting_hot_wallet while the
+// hot wallet is topped up; see SetWalletTiering. A withdrawal that cannot be
+// executed, as when the balance is short, is returned failed with its reason.
+func (s *CustodianService) Withdraw(ctx context.Context, withdrawal Withdrawal) (*Withdrawal, error) {
+	if withdrawal.AccountID == "" || withdrawal.AssetID == "" || withdrawal.Address == "" {
+		return nil, fmt.Errorf("%w: account_id, asset_id and address are required", ErrInvalidRequest)
+	}
+	if withdrawal.Amount <= 0 {
+		return nil, fmt.Errorf("%w: withdrawal amount must be positive", ErrInvalidRequest)
+	}
+	if withdrawal.Network == "" {
+		withdrawal.Network = withdrawal.AssetID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.accounts[withdrawal.AccountID]; !exists {
+		return nil, fmt.Errorf("account %s %w", withdrawal.AccountID, ErrNotFound)
+	}
+
+	withdrawal.ID = generateWithdrawalID()
+	withdrawal.Status = ""
+	withdrawal.Reason, withdrawal.ApprovalID, withdrawal.ReviewID, withdrawal.CompletedAt = "", "", "", nil
+	withdrawal.TxHash, withdrawal.Fee, withdrawal.Confirmations = "", 0, 0
+	withdrawal.PaymentID, withdrawal.Reference = "", ""
+	withdrawal.CreatedAt = time.Now()
+
+	if err := s.checkTransferLimitsLocked(ctx, withdrawal.AccountID, withdrawal.AssetID, withdrawal.Amount, withdrawal.CreatedAt); err != nil {
+		return nil, err
+	}
+	if err := s.checkWithdrawalAddressLocked(&withdrawal, withdrawal.CreatedAt); err != nil {
+		event := withdrawalAuditEvent(&withdrawal)
+		event.Outcome = ports.AuditOutcomeFailure
+		event.Error = err.Error()
+		s.recordAudit(ctx, event)
+		return nil, err
+	}
+	if err := s.checkApprovalReachableLocked(withdrawal.AccountID, withdrawal.AssetID, withdrawal.Amount, withdrawal.RequestedBy); err != nil {
+		return nil, err
+	}
+
+	review, err := s.screenLocked(ctx, withdrawalComplianceSubject(&withdrawal))
+	if err != nil {
+		return nil, err
+	}
+
+	stored := &withdrawal
+	s.withdrawals[stored.ID] = stored
+
+	if review != nil {
+		stored.Status = WithdrawalStatusPendingReview
+		stored.ReviewID = review.ID
+	} else {
+		// The outcome is recorded on the withdrawal
+		_ = s.continueWithdrawalLocked(ctx, stored)
+	}
+
+	result := *stored
+	return &result, nil
+}
+
+// continueWithdrawalLocked holds a screened withdrawal for approval when the policy
+// requires it, and otherwise executes it
+func (s *CustodianService) continueWithdrawalLocked(ctx context.Context, withdrawal *Withdrawal) error {
+	if request := s.requireApprovalLocked(ctx, ApprovalOperationWithdrawal, withdrawal.ID, withdrawal.AccountID, withdrawal.AssetID, withdrawal.Amount, withdrawal.RequestedBy); request != nil {
+		withdrawal.Status = WithdrawalStatusPendingApproval
+		withdrawal.ApprovalID = request.ID
+		return nil
+	}
+
+	return s.executeWithdrawalLocked(ctx, withdrawal)
+}
+
+// GetWithdrawal returns a copy of a withdrawal by ID
+func (s *CustodianService) GetWithdrawal(ctx context.Context, withdrawalID string) (*Withdrawal, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	withdrawal, exists := s.withdrawals[withdrawalID]
+	if !exists {
+		return nil, fmt.Errorf("withdrawal %s %w", withdrawalID, ErrNotFound)
+	}
+
+	result := *withdrawal
+	return &result, nil
+}
+
+// executeWithdrawalLocked debits the account, or records why it could not. The
+// address and transfer limits are checked again as they may have changed while
+// the withdrawal was held for review or approval.
+// On a simulated network the transaction is broadcast, and on a fiat rail the
+// payment queued, rather than completed.
+func (s *CustodianService) executeWithdrawalLocked(ctx context.Context, withdrawal *Withdrawal) error {
+	before := s.auditBalanceLocked(withdrawal.AccountID, withdrawal.AssetID)
+	chain := s.chains[withdrawal.Network]
+	fee := 0.0
+	if chain != nil {
+		fee = chain.Fee
+	}
+
+	now := time.Now()
+	err := s.checkWithdrawalAddressLocked(withdrawal, now)
+	account := s.accounts[withdrawal.AccountID]
+	switch {
+	case err != nil:
+	case account.Status != AccountStatusActive:
+		err = fmt.Errorf("%w: account %s is %s", ErrAccountInactive, withdrawal.AccountID, account.Status)
+	case s.availableBalanceLocked(withdrawal.AccountID, withdrawal.AssetID) < withdrawal.Amount+fee:
+		err = fmt.Errorf("%w in account %s for asset %s", ErrInsufficientBalance, withdrawal.AccountID, withdrawal.AssetID)
+	default:
+		err = s.transferLimitErrorLocked(withdrawal.AccountID, withdrawal.AssetID, withdrawal.Amount, now)
+	}
+
+	if err != nil {
+		event := withdrawalAuditEvent(withdrawal)
+		withdrawal.Status = WithdrawalStatusFailed
+		withdrawal.Reason = err.Error()
+		event.Details["status"] = withdrawal.Status
+		event.Outcome = ports.AuditOutcomeFailure
+		event.Error = err.Error()
+		s.recordAudit(ctx, event)
+		return err
+	}
+
+	balances := s.balances[withdrawal.AccountID]
+	balances[withdrawal.AssetID] -= withdrawal.Amount + fee
+	account.UpdatedAt = now
+	s.recordOutflowLocked(withdrawal.AccountID, withdrawal.AssetID, withdrawal.Amount, now)
+	withdrawal.Fee = fee
+	// Withdrawals already waiting for the hot wallet are paid first
+	if len(s.awaitingHotWalletLocked(withdrawal.AssetID)) == 0 && s.drawHotWalletLocked(withdrawal.AssetID, withdrawal.Amount+fee) {
+		s.sendWithdrawalLocked(withdrawal, now)
+	} else {
+		withdrawal.Status = WithdrawalStatusAwaitingHot
+	}
+
+	s.publishBalanceChange(ctx, withdrawal.AccountID, withdrawal.AssetID, -(withdrawal.Amount + fee), balances[withdrawal.AssetID], "")
+	event := withdrawalAuditEvent(withdrawal)
+	event.Details["status"] = withdrawal.Status
+	event.Balances = []ports.AuditBalance{s.completeAuditBalanceLocked(before)}
+	s.recordAudit(ctx, event)
+
+	s.logger.WithFields(logrus.Fields{
+		"withdrawal_id": withdrawal.ID,
+		"account_id":    withdrawal.AccountID,
+		"asset_id":      withdrawal.AssetID,
+		"amount":        withdrawal.Amount,
+		"status":        withdrawal.Status,
+	}).Info("Withdrawal executed")
+
+	return nil
+}
+
+// sendWithdrawalLocked moves a debited withdrawal out of custody: broadcast on a
+// simulated network, queued on a fiat rail, or completed at once
+func (s *CustodianService) sendWithdrawalLocked(withdrawal *Withdrawal, now time.Time) {
+	rail, onRail := s.fiatRails.railFor(withdrawal.Network, withdrawal.AssetID)
+	if chain := s.chains[withdrawal.Network]; chain != nil {
+		tx := s.broadcastLocked(chain, ChainTransaction{
+			Direction:  ChainDirectionWithdrawal,
+			ResourceID: withdrawal.ID,
+			AccountID:  withdrawal.AccountID,
+			AssetID:    withdrawal.AssetID,
+			Amount:     withdrawal.Amount,
+			Fee:        withdrawal.Fee,
+			Address:    withdrawal.Address,
+		}, now)
+		withdrawal.Status = WithdrawalStatusBroadcast
+		withdrawal.TxHash = tx.Hash
+	} else if onRail {
+		payment := s.queueFiatPaymentLocked(rail, FiatPayment{
+			Direction:   FiatDirectionWithdrawal,
+			ResourceID:  withdrawal.ID,
+			AccountID:   withdrawal.AccountID,
+			AssetID:     withdrawal.AssetID,
+			Amount:      withdrawal.Amount,
+			BankAccount: withdrawal.Address,
+		}, now)
+		withdrawal.Status = WithdrawalStatusProcessing
+		withdrawal.PaymentID, withdrawal.Reference = payment.ID, payment.Reference
+	} else {
+		withdrawal.Status = WithdrawalStatusCompleted
+		withdrawal.CompletedAt = &now
+	}
+}
+
+func (s *CustodianService) resolveWithdrawalApprovalLocked(ctx context.Context, withdrawal *Withdrawal, status, reason string) {
+	if withdrawal.Status != WithdrawalStatusPendingApproval {
+		return
+	}
+
+	switch status {
+	case ApprovalStatusApproved:
+		// The outcome is recorded on the withdrawal
+		_ = s.executeWithdrawalLocked(ctx, withdrawal)
+	case ApprovalStatusRejected:
+		withdrawal.Status = WithdrawalStatusRejected
+		withdrawal.Reason = reason
+	case ApprovalStatusExpired:
+		withdrawal.Status = WithdrawalStatusExpired
+		withdrawal.Reason = reason
+	}
+}
+
+func withdrawalAuditEvent(withdrawal *Withdrawal) ports.AuditEvent {
+	details := map[string]string{
+		"account_id": withdrawal.AccountID,
+		"asset_id":   withdrawal.AssetID,
+		"amount":     formatAmount(withdrawal.Amount),
+		"network":    withdrawal.Network,
+		"address":    withdrawal.Address,
+	}
+	if withdrawal.ApprovalID != "" {
+		details["approval_id"] = withdrawal.ApprovalID
+	}
+	if withdrawal.TxHash != "" {
+		details["tx_hash"] = withdrawal.TxHash
+		details["fee"] = formatAmount(withdrawal.Fee)
+	}
+	if withdrawal.Reference != "" {
+		details["reference"] = withdrawal.Reference
+	}
+	return ports.AuditEvent{
+		Action:       "withdrawal.process",
+		Outcome:      ports.AuditOutcomeSuccess,
+		ResourceType: "withdrawal",
+		ResourceID:   withdrawal.ID,
+		Details:      details,
+	}
+}
+
+func generateWithdrawalID() string {
+	// Simple ID generation for simulation
+	return fmt.Sprintf("WDR_%d", nextIDNanos())
+}